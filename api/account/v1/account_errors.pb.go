@@ -46,3 +46,279 @@ func IsMismatchUsernamePassword(err error) bool {
 func ErrorMismatchUsernamePassword(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, ErrorReason_MISMATCH_USERNAME_PASSWORD.String(), fmt.Sprintf(format, args...))
 }
+
+func IsHumanOnlyOperation(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_HUMAN_ONLY_OPERATION.String() && e.Code == 403
+}
+
+func ErrorHumanOnlyOperation(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_HUMAN_ONLY_OPERATION.String(), fmt.Sprintf(format, args...))
+}
+
+func IsServiceAccountOnlyOperation(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SERVICE_ACCOUNT_ONLY_OPERATION.String() && e.Code == 403
+}
+
+func ErrorServiceAccountOnlyOperation(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_SERVICE_ACCOUNT_ONLY_OPERATION.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidApiKey(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_API_KEY.String() && e.Code == 401
+}
+
+func ErrorInvalidApiKey(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_INVALID_API_KEY.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidOwner(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_OWNER.String() && e.Code == 400
+}
+
+func ErrorInvalidOwner(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_OWNER.String(), fmt.Sprintf(format, args...))
+}
+
+func IsExternallyManagedUser(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EXTERNALLY_MANAGED_USER.String() && e.Code == 403
+}
+
+func ErrorExternallyManagedUser(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_EXTERNALLY_MANAGED_USER.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidToken(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_TOKEN.String() && e.Code == 400
+}
+
+func ErrorInvalidToken(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_TOKEN.String(), fmt.Sprintf(format, args...))
+}
+
+func IsEmailNotSet(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EMAIL_NOT_SET.String() && e.Code == 400
+}
+
+func ErrorEmailNotSet(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_EMAIL_NOT_SET.String(), fmt.Sprintf(format, args...))
+}
+
+func IsEmailAlreadyVerified(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EMAIL_ALREADY_VERIFIED.String() && e.Code == 409
+}
+
+func ErrorEmailAlreadyVerified(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_EMAIL_ALREADY_VERIFIED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsWeakPassword(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_WEAK_PASSWORD.String() && e.Code == 400
+}
+
+func ErrorWeakPassword(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_WEAK_PASSWORD.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvitationNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVITATION_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorInvitationNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_INVITATION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvitationNotPending(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVITATION_NOT_PENDING.String() && e.Code == 409
+}
+
+func ErrorInvitationNotPending(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_INVITATION_NOT_PENDING.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidGroup(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_GROUP.String() && e.Code == 400
+}
+
+func ErrorInvalidGroup(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_GROUP.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidProfile(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_PROFILE.String() && e.Code == 400
+}
+
+func ErrorInvalidProfile(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_PROFILE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidUsername(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_USERNAME.String() && e.Code == 400
+}
+
+func ErrorInvalidUsername(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_USERNAME.String(), fmt.Sprintf(format, args...))
+}
+
+func IsBatchAborted(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_BATCH_ABORTED.String() && e.Code == 409
+}
+
+func ErrorBatchAborted(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_BATCH_ABORTED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidImport(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_IMPORT.String() && e.Code == 400
+}
+
+func ErrorInvalidImport(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_IMPORT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidArgument(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_ARGUMENT.String() && e.Code == 400
+}
+
+func ErrorInvalidArgument(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_ARGUMENT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsResourceNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RESOURCE_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorResourceNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_RESOURCE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsResourceAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RESOURCE_ALREADY_EXISTS.String() && e.Code == 409
+}
+
+func ErrorResourceAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_RESOURCE_ALREADY_EXISTS.String(), fmt.Sprintf(format, args...))
+}
+
+func IsRequestCanceled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_REQUEST_CANCELED.String() && e.Code == 499
+}
+
+func ErrorRequestCanceled(format string, args ...interface{}) *errors.Error {
+	return errors.New(499, ErrorReason_REQUEST_CANCELED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsDeadlineExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_DEADLINE_EXCEEDED.String() && e.Code == 504
+}
+
+func ErrorDeadlineExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(504, ErrorReason_DEADLINE_EXCEEDED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInternal(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INTERNAL.String() && e.Code == 500
+}
+
+func ErrorInternal(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_INTERNAL.String(), fmt.Sprintf(format, args...))
+}
+
+func IsEmailAlreadyExisted(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EMAIL_ALREADY_EXISTED.String() && e.Code == 409
+}
+
+func ErrorEmailAlreadyExisted(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_EMAIL_ALREADY_EXISTED.String(), fmt.Sprintf(format, args...))
+}
@@ -18,13 +18,28 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 type AccountHTTPServer interface {
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*User, error)
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	BatchCreateUsers(context.Context, *BatchCreateUsersRequest) (*BatchUsersResponse, error)
+	BatchDeleteUsers(context.Context, *BatchDeleteUsersRequest) (*BatchUsersResponse, error)
+	BatchGetUsers(context.Context, *BatchGetUsersRequest) (*BatchUsersResponse, error)
+	BatchUpdateUsers(context.Context, *BatchUpdateUsersRequest) (*BatchUsersResponse, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*Invitation, error)
 	CreateUser(context.Context, *CreateUserRequest) (*User, error)
+	DeleteAPIKey(context.Context, *DeleteAPIKeyRequest) (*DeleteAPIKeyResponse, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
 	GetUser(context.Context, *GetUserRequest) (*User, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error)
+	SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*SendVerificationEmailResponse, error)
 	SetUserPassword(context.Context, *SetUserPasswordRequest) (*SetUserPasswordResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*User, error)
 }
 
 func RegisterAccountHTTPServer(s *http.Server, srv AccountHTTPServer) {
@@ -35,7 +50,22 @@ func RegisterAccountHTTPServer(s *http.Server, srv AccountHTTPServer) {
 	r.DELETE("/account/v1/users/{id}", _Account_DeleteUser0_HTTP_Handler(srv))
 	r.GET("/account/v1/users/{id}", _Account_GetUser0_HTTP_Handler(srv))
 	r.GET("/account/v1/users", _Account_ListUsers0_HTTP_Handler(srv))
+	r.POST("/account/v1/users:batch-create", _Account_BatchCreateUsers0_HTTP_Handler(srv))
+	r.GET("/account/v1/users:batch-get", _Account_BatchGetUsers0_HTTP_Handler(srv))
+	r.POST("/account/v1/users:batch-update", _Account_BatchUpdateUsers0_HTTP_Handler(srv))
+	r.POST("/account/v1/users:batch-delete", _Account_BatchDeleteUsers0_HTTP_Handler(srv))
 	r.POST("/account/v1/users:authenticate", _Account_Authenticate0_HTTP_Handler(srv))
+	r.POST("/account/v1/users/{id}:send-verification-email", _Account_SendVerificationEmail0_HTTP_Handler(srv))
+	r.POST("/account/v1/users:verify-email", _Account_VerifyEmail0_HTTP_Handler(srv))
+	r.POST("/account/v1/users:request-password-reset", _Account_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/account/v1/users:reset-password", _Account_ResetPassword0_HTTP_Handler(srv))
+	r.POST("/account/v1/invitations", _Account_CreateInvitation0_HTTP_Handler(srv))
+	r.GET("/account/v1/invitations", _Account_ListInvitations0_HTTP_Handler(srv))
+	r.POST("/account/v1/invitations/{id}:revoke", _Account_RevokeInvitation0_HTTP_Handler(srv))
+	r.POST("/account/v1/invitations:accept", _Account_AcceptInvitation0_HTTP_Handler(srv))
+	r.POST("/account/v1/users/{user_id}/api-keys", _Account_CreateAPIKey0_HTTP_Handler(srv))
+	r.GET("/account/v1/users/{user_id}/api-keys", _Account_ListAPIKeys0_HTTP_Handler(srv))
+	r.DELETE("/account/v1/users/{user_id}/api-keys/{id}", _Account_DeleteAPIKey0_HTTP_Handler(srv))
 }
 
 func _Account_CreateUser0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
@@ -161,6 +191,82 @@ func _Account_ListUsers0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Conte
 	}
 }
 
+func _Account_BatchCreateUsers0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchCreateUsersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/BatchCreateUsers")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchCreateUsers(ctx, req.(*BatchCreateUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchUsersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_BatchGetUsers0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchGetUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/BatchGetUsers")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchGetUsers(ctx, req.(*BatchGetUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchUsersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_BatchUpdateUsers0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchUpdateUsersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/BatchUpdateUsers")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchUpdateUsers(ctx, req.(*BatchUpdateUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchUsersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_BatchDeleteUsers0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchDeleteUsersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/BatchDeleteUsers")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchDeleteUsers(ctx, req.(*BatchDeleteUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchUsersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Account_Authenticate0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AuthenticateRequest
@@ -180,14 +286,253 @@ func _Account_Authenticate0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Co
 	}
 }
 
+func _Account_SendVerificationEmail0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SendVerificationEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/SendVerificationEmail")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SendVerificationEmail(ctx, req.(*SendVerificationEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SendVerificationEmailResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_VerifyEmail0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/VerifyEmail")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyEmail(ctx, req.(*VerifyEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*User)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_RequestPasswordReset0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/RequestPasswordReset")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestPasswordResetResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_ResetPassword0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/ResetPassword")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetPasswordResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_CreateInvitation0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/CreateInvitation")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateInvitation(ctx, req.(*CreateInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Invitation)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_ListInvitations0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListInvitationsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/ListInvitations")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListInvitations(ctx, req.(*ListInvitationsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListInvitationsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_RevokeInvitation0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/RevokeInvitation")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeInvitationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_AcceptInvitation0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/AcceptInvitation")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*User)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_CreateAPIKey0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateAPIKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/CreateAPIKey")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateAPIKeyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_ListAPIKeys0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAPIKeysRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/ListAPIKeys")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAPIKeysResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Account_DeleteAPIKey0_HTTP_Handler(srv AccountHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteAPIKeyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.account.v1.Account/DeleteAPIKey")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAPIKey(ctx, req.(*DeleteAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteAPIKeyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AccountHTTPClient interface {
+	AcceptInvitation(ctx context.Context, req *AcceptInvitationRequest, opts ...http.CallOption) (rsp *User, err error)
 	Authenticate(ctx context.Context, req *AuthenticateRequest, opts ...http.CallOption) (rsp *AuthenticateResponse, err error)
+	BatchCreateUsers(ctx context.Context, req *BatchCreateUsersRequest, opts ...http.CallOption) (rsp *BatchUsersResponse, err error)
+	BatchDeleteUsers(ctx context.Context, req *BatchDeleteUsersRequest, opts ...http.CallOption) (rsp *BatchUsersResponse, err error)
+	BatchGetUsers(ctx context.Context, req *BatchGetUsersRequest, opts ...http.CallOption) (rsp *BatchUsersResponse, err error)
+	BatchUpdateUsers(ctx context.Context, req *BatchUpdateUsersRequest, opts ...http.CallOption) (rsp *BatchUsersResponse, err error)
+	CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest, opts ...http.CallOption) (rsp *CreateAPIKeyResponse, err error)
+	CreateInvitation(ctx context.Context, req *CreateInvitationRequest, opts ...http.CallOption) (rsp *Invitation, err error)
 	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...http.CallOption) (rsp *User, err error)
+	DeleteAPIKey(ctx context.Context, req *DeleteAPIKeyRequest, opts ...http.CallOption) (rsp *DeleteAPIKeyResponse, err error)
 	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...http.CallOption) (rsp *DeleteUserResponse, err error)
 	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *User, err error)
+	ListAPIKeys(ctx context.Context, req *ListAPIKeysRequest, opts ...http.CallOption) (rsp *ListAPIKeysResponse, err error)
+	ListInvitations(ctx context.Context, req *ListInvitationsRequest, opts ...http.CallOption) (rsp *ListInvitationsResponse, err error)
 	ListUsers(ctx context.Context, req *ListUsersRequest, opts ...http.CallOption) (rsp *ListUsersResponse, err error)
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest, opts ...http.CallOption) (rsp *RequestPasswordResetResponse, err error)
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *ResetPasswordResponse, err error)
+	RevokeInvitation(ctx context.Context, req *RevokeInvitationRequest, opts ...http.CallOption) (rsp *RevokeInvitationResponse, err error)
+	SendVerificationEmail(ctx context.Context, req *SendVerificationEmailRequest, opts ...http.CallOption) (rsp *SendVerificationEmailResponse, err error)
 	SetUserPassword(ctx context.Context, req *SetUserPasswordRequest, opts ...http.CallOption) (rsp *SetUserPasswordResponse, err error)
 	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *User, err error)
+	VerifyEmail(ctx context.Context, req *VerifyEmailRequest, opts ...http.CallOption) (rsp *User, err error)
 }
 
 type AccountHTTPClientImpl struct {
@@ -198,6 +543,19 @@ func NewAccountHTTPClient(client *http.Client) AccountHTTPClient {
 	return &AccountHTTPClientImpl{client}
 }
 
+func (c *AccountHTTPClientImpl) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...http.CallOption) (*User, error) {
+	var out User
+	pattern := "/account/v1/invitations:accept"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.account.v1.Account/AcceptInvitation"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AccountHTTPClientImpl) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...http.CallOption) (*AuthenticateResponse, error) {
 	var out AuthenticateResponse
 	pattern := "/account/v1/users:authenticate"
@@ -211,6 +569,84 @@ func (c *AccountHTTPClientImpl) Authenticate(ctx context.Context, in *Authentica
 	return &out, err
 }
 
+func (c *AccountHTTPClientImpl) BatchCreateUsers(ctx context.Context, in *BatchCreateUsersRequest, opts ...http.CallOption) (*BatchUsersResponse, error) {
+	var out BatchUsersResponse
+	pattern := "/account/v1/users:batch-create"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.account.v1.Account/BatchCreateUsers"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AccountHTTPClientImpl) BatchDeleteUsers(ctx context.Context, in *BatchDeleteUsersRequest, opts ...http.CallOption) (*BatchUsersResponse, error) {
+	var out BatchUsersResponse
+	pattern := "/account/v1/users:batch-delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.account.v1.Account/BatchDeleteUsers"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AccountHTTPClientImpl) BatchGetUsers(ctx context.Context, in *BatchGetUsersRequest, opts ...http.CallOption) (*BatchUsersResponse, error) {
+	var out BatchUsersResponse
+	pattern := "/account/v1/users:batch-get"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.account.v1.Account/BatchGetUsers"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AccountHTTPClientImpl) BatchUpdateUsers(ctx context.Context, in *BatchUpdateUsersRequest, opts ...http.CallOption) (*BatchUsersResponse, error) {
+	var out BatchUsersResponse
+	pattern := "/account/v1/users:batch-update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.account.v1.Account/BatchUpdateUsers"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AccountHTTPClientImpl) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...http.CallOption) (*CreateAPIKeyResponse, error) {
+	var out CreateAPIKeyResponse
+	pattern := "/account/v1/users/{user_id}/api-keys"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.account.v1.Account/CreateAPIKey"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AccountHTTPClientImpl) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...http.CallOption) (*Invitation, error) {
+	var out Invitation
+	pattern := "/account/v1/invitations"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.account.v1.Account/CreateInvitation"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AccountHTTPClientImpl) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...http.CallOption) (*User, error) {
 	var out User
 	pattern := "/account/v1/users"
@@ -224,6 +660,19 @@ func (c *AccountHTTPClientImpl) CreateUser(ctx context.Context, in *CreateUserRe
 	return &out, err
 }
 
+func (c *AccountHTTPClientImpl) DeleteAPIKey(ctx context.Context, in *DeleteAPIKeyRequest, opts ...http.CallOption) (*DeleteAPIKeyResponse, error) {
+	var out DeleteAPIKeyResponse
+	pattern := "/account/v1/users/{user_id}/api-keys/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.account.v1.Account/DeleteAPIKey"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AccountHTTPClientImpl) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...http.CallOption) (*DeleteUserResponse, error) {
 	var out DeleteUserResponse
 	pattern := "/account/v1/users/{id}"
@@ -250,6 +699,32 @@ func (c *AccountHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequest,
 	return &out, err
 }
 
+func (c *AccountHTTPClientImpl) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...http.CallOption) (*ListAPIKeysResponse, error) {
+	var out ListAPIKeysResponse
+	pattern := "/account/v1/users/{user_id}/api-keys"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.account.v1.Account/ListAPIKeys"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AccountHTTPClientImpl) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...http.CallOption) (*ListInvitationsResponse, error) {
+	var out ListInvitationsResponse
+	pattern := "/account/v1/invitations"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.account.v1.Account/ListInvitations"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AccountHTTPClientImpl) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...http.CallOption) (*ListUsersResponse, error) {
 	var out ListUsersResponse
 	pattern := "/account/v1/users"
@@ -263,6 +738,58 @@ func (c *AccountHTTPClientImpl) ListUsers(ctx context.Context, in *ListUsersRequ
 	return &out, err
 }
 
+func (c *AccountHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...http.CallOption) (*RequestPasswordResetResponse, error) {
+	var out RequestPasswordResetResponse
+	pattern := "/account/v1/users:request-password-reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.account.v1.Account/RequestPasswordReset"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AccountHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*ResetPasswordResponse, error) {
+	var out ResetPasswordResponse
+	pattern := "/account/v1/users:reset-password"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.account.v1.Account/ResetPassword"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AccountHTTPClientImpl) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...http.CallOption) (*RevokeInvitationResponse, error) {
+	var out RevokeInvitationResponse
+	pattern := "/account/v1/invitations/{id}:revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.account.v1.Account/RevokeInvitation"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AccountHTTPClientImpl) SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...http.CallOption) (*SendVerificationEmailResponse, error) {
+	var out SendVerificationEmailResponse
+	pattern := "/account/v1/users/{id}:send-verification-email"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.account.v1.Account/SendVerificationEmail"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AccountHTTPClientImpl) SetUserPassword(ctx context.Context, in *SetUserPasswordRequest, opts ...http.CallOption) (*SetUserPasswordResponse, error) {
 	var out SetUserPasswordResponse
 	pattern := "/account/v1/users:set-password"
@@ -288,3 +815,16 @@ func (c *AccountHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserRe
 	}
 	return &out, err
 }
+
+func (c *AccountHTTPClientImpl) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...http.CallOption) (*User, error) {
+	var out User
+	pattern := "/account/v1/users:verify-email"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.account.v1.Account/VerifyEmail"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
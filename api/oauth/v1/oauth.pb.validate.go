@@ -0,0 +1,948 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: oauth/v1/oauth.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Client with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Client) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Client with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ClientMultiError, or nil if none found.
+func (m *Client) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Client) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ClientId
+
+	// no validation rules for Name
+
+	// no validation rules for Public
+
+	// no validation rules for ServiceAccountId
+
+	// no validation rules for CreateTime
+
+	// no validation rules for UpdateTime
+
+	if len(errors) > 0 {
+		return ClientMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClientMultiError is an error wrapping multiple validation errors returned by
+// Client.ValidateAll() if the designated constraints aren't met.
+type ClientMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClientMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClientMultiError) AllErrors() []error { return m }
+
+// ClientValidationError is the validation error returned by Client.Validate if
+// the designated constraints aren't met.
+type ClientValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClientValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClientValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClientValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClientValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClientValidationError) ErrorName() string { return "ClientValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClientValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClient.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClientValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClientValidationError{}
+
+// Validate checks the field values on CreateClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateClientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateClientRequestMultiError, or nil if none found.
+func (m *CreateClientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateClientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateClientRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Public
+
+	// no validation rules for ServiceAccountId
+
+	if len(errors) > 0 {
+		return CreateClientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateClientRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateClientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateClientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateClientRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateClientRequestMultiError) AllErrors() []error { return m }
+
+// CreateClientRequestValidationError is the validation error returned by
+// CreateClientRequest.Validate if the designated constraints aren't met.
+type CreateClientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateClientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateClientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateClientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateClientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateClientRequestValidationError) ErrorName() string {
+	return "CreateClientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateClientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateClientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateClientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateClientRequestValidationError{}
+
+// Validate checks the field values on CreateClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateClientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateClientResponseMultiError, or nil if none found.
+func (m *CreateClientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateClientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateClientResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateClientResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateClientResponseValidationError{
+				field:  "Client",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClientSecret
+
+	if len(errors) > 0 {
+		return CreateClientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateClientResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateClientResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateClientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateClientResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateClientResponseMultiError) AllErrors() []error { return m }
+
+// CreateClientResponseValidationError is the validation error returned by
+// CreateClientResponse.Validate if the designated constraints aren't met.
+type CreateClientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateClientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateClientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateClientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateClientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateClientResponseValidationError) ErrorName() string {
+	return "CreateClientResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateClientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateClientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateClientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateClientResponseValidationError{}
+
+// Validate checks the field values on GetClientRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetClientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClientRequestMultiError, or nil if none found.
+func (m *GetClientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetClientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClientRequestMultiError is an error wrapping multiple validation errors
+// returned by GetClientRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetClientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClientRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClientRequestMultiError) AllErrors() []error { return m }
+
+// GetClientRequestValidationError is the validation error returned by
+// GetClientRequest.Validate if the designated constraints aren't met.
+type GetClientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClientRequestValidationError) ErrorName() string { return "GetClientRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetClientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClientRequestValidationError{}
+
+// Validate checks the field values on ListClientsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListClientsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClientsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClientsRequestMultiError, or nil if none found.
+func (m *ListClientsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClientsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListClientsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClientsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListClientsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListClientsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClientsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClientsRequestMultiError) AllErrors() []error { return m }
+
+// ListClientsRequestValidationError is the validation error returned by
+// ListClientsRequest.Validate if the designated constraints aren't met.
+type ListClientsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClientsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClientsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClientsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClientsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClientsRequestValidationError) ErrorName() string {
+	return "ListClientsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClientsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClientsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClientsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClientsRequestValidationError{}
+
+// Validate checks the field values on ListClientsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListClientsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClientsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClientsResponseMultiError, or nil if none found.
+func (m *ListClientsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClientsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClients() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListClientsResponseValidationError{
+						field:  fmt.Sprintf("Clients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListClientsResponseValidationError{
+						field:  fmt.Sprintf("Clients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListClientsResponseValidationError{
+					field:  fmt.Sprintf("Clients[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListClientsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClientsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListClientsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListClientsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClientsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClientsResponseMultiError) AllErrors() []error { return m }
+
+// ListClientsResponseValidationError is the validation error returned by
+// ListClientsResponse.Validate if the designated constraints aren't met.
+type ListClientsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClientsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClientsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClientsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClientsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClientsResponseValidationError) ErrorName() string {
+	return "ListClientsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClientsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClientsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClientsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClientsResponseValidationError{}
+
+// Validate checks the field values on DeleteClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteClientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteClientRequestMultiError, or nil if none found.
+func (m *DeleteClientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteClientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteClientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteClientRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteClientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteClientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteClientRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteClientRequestMultiError) AllErrors() []error { return m }
+
+// DeleteClientRequestValidationError is the validation error returned by
+// DeleteClientRequest.Validate if the designated constraints aren't met.
+type DeleteClientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteClientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteClientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteClientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteClientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteClientRequestValidationError) ErrorName() string {
+	return "DeleteClientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteClientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteClientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteClientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteClientRequestValidationError{}
+
+// Validate checks the field values on DeleteClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteClientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteClientResponseMultiError, or nil if none found.
+func (m *DeleteClientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteClientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteClientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteClientResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteClientResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteClientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteClientResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteClientResponseMultiError) AllErrors() []error { return m }
+
+// DeleteClientResponseValidationError is the validation error returned by
+// DeleteClientResponse.Validate if the designated constraints aren't met.
+type DeleteClientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteClientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteClientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteClientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteClientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteClientResponseValidationError) ErrorName() string {
+	return "DeleteClientResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteClientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteClientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteClientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteClientResponseValidationError{}
@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// protoc-gen-go-http v2.2.1
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+type OAuthHTTPServer interface {
+	CreateClient(context.Context, *CreateClientRequest) (*CreateClientResponse, error)
+	DeleteClient(context.Context, *DeleteClientRequest) (*DeleteClientResponse, error)
+	GetClient(context.Context, *GetClientRequest) (*Client, error)
+	ListClients(context.Context, *ListClientsRequest) (*ListClientsResponse, error)
+}
+
+func RegisterOAuthHTTPServer(s *http.Server, srv OAuthHTTPServer) {
+	r := s.Route("/")
+	r.POST("/oauth/v1/clients", _OAuth_CreateClient0_HTTP_Handler(srv))
+	r.GET("/oauth/v1/clients/{id}", _OAuth_GetClient0_HTTP_Handler(srv))
+	r.GET("/oauth/v1/clients", _OAuth_ListClients0_HTTP_Handler(srv))
+	r.DELETE("/oauth/v1/clients/{id}", _OAuth_DeleteClient0_HTTP_Handler(srv))
+}
+
+func _OAuth_CreateClient0_HTTP_Handler(srv OAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateClientRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.oauth.v1.OAuth/CreateClient")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateClient(ctx, req.(*CreateClientRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateClientResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OAuth_GetClient0_HTTP_Handler(srv OAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetClientRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.oauth.v1.OAuth/GetClient")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetClient(ctx, req.(*GetClientRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Client)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OAuth_ListClients0_HTTP_Handler(srv OAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListClientsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.oauth.v1.OAuth/ListClients")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListClients(ctx, req.(*ListClientsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListClientsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OAuth_DeleteClient0_HTTP_Handler(srv OAuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteClientRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.oauth.v1.OAuth/DeleteClient")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteClient(ctx, req.(*DeleteClientRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteClientResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type OAuthHTTPClient interface {
+	CreateClient(ctx context.Context, req *CreateClientRequest, opts ...http.CallOption) (rsp *CreateClientResponse, err error)
+	DeleteClient(ctx context.Context, req *DeleteClientRequest, opts ...http.CallOption) (rsp *DeleteClientResponse, err error)
+	GetClient(ctx context.Context, req *GetClientRequest, opts ...http.CallOption) (rsp *Client, err error)
+	ListClients(ctx context.Context, req *ListClientsRequest, opts ...http.CallOption) (rsp *ListClientsResponse, err error)
+}
+
+type OAuthHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewOAuthHTTPClient(client *http.Client) OAuthHTTPClient {
+	return &OAuthHTTPClientImpl{client}
+}
+
+func (c *OAuthHTTPClientImpl) CreateClient(ctx context.Context, in *CreateClientRequest, opts ...http.CallOption) (*CreateClientResponse, error) {
+	var out CreateClientResponse
+	pattern := "/oauth/v1/clients"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.oauth.v1.OAuth/CreateClient"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *OAuthHTTPClientImpl) DeleteClient(ctx context.Context, in *DeleteClientRequest, opts ...http.CallOption) (*DeleteClientResponse, error) {
+	var out DeleteClientResponse
+	pattern := "/oauth/v1/clients/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.oauth.v1.OAuth/DeleteClient"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *OAuthHTTPClientImpl) GetClient(ctx context.Context, in *GetClientRequest, opts ...http.CallOption) (*Client, error) {
+	var out Client
+	pattern := "/oauth/v1/clients/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.oauth.v1.OAuth/GetClient"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *OAuthHTTPClientImpl) ListClients(ctx context.Context, in *ListClientsRequest, opts ...http.CallOption) (*ListClientsResponse, error) {
+	var out ListClientsResponse
+	pattern := "/oauth/v1/clients"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.oauth.v1.OAuth/ListClients"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
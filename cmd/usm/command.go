@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"usm/internal/biz/repo"
+	acctuc "usm/internal/biz/usecase/account"
+	"usm/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `Usage: %s [flags] [command]
+
+Commands:
+  rotate-keys   publish a new OAuth signing key, run "rotate-keys -h" for details
+  import-users  import users from a CSV or NDJSON file, run "import-users -h" for details
+  export-users  export users to a CSV or NDJSON file, run "export-users -h" for details
+
+Flags:
+`, os.Args[0])
+	flag.PrintDefaults()
+}
+
+// runCommand 执行命令行子命令，不启动服务，args[0] 为子命令名
+func runCommand(args []string, bc *conf.Bootstrap, logger log.Logger) error {
+	switch cmd := args[0]; cmd {
+	case "rotate-keys":
+		return rotateKeys(args, bc, logger)
+	case "import-users":
+		return importUsers(args, bc, logger)
+	case "export-users":
+		return exportUsers(args, bc, logger)
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+}
+
+func rotateKeys(args []string, bc *conf.Bootstrap, logger log.Logger) error {
+	fs := flag.NewFlagSet(args[0], flag.ExitOnError)
+	force := fs.Bool("force", false, "activate the new key immediately, relying parties with a cached JWKS reject new tokens until the cache expires")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s [flags] rotate-keys [flags]\n\n"+
+			"Publish a new signing key in JWKS, the server activates it once it has been published long enough\n"+
+			"for relying parties to refresh their JWKS cache.\n\nFlags:\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args[1:])
+	uc, cleanup, err := wireOAuthUsecase(bc.Data, bc.Auth, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	key, err := uc.RotateSigningKey(context.Background(), *force)
+	if err != nil {
+		return err
+	}
+	if key.Status == repo.SigningKeyStatusActive {
+		fmt.Printf("signing key %s activated\n", key.KID)
+		return nil
+	}
+	fmt.Printf("signing key %s published, it will be activated %s after creation\n", key.KID, uc.KeyPublishDelay())
+	return nil
+}
+
+// mappingFlag 可重复的 -map source:field 参数
+type mappingFlag map[string]string
+
+func (m mappingFlag) String() string {
+	return fmt.Sprint(map[string]string(m))
+}
+
+func (m mappingFlag) Set(v string) error {
+	i := strings.LastIndex(v, ":")
+	if i < 0 {
+		return fmt.Errorf("expected source:field")
+	}
+	m[v[:i]] = v[i+1:]
+	return nil
+}
+
+func importUsers(args []string, bc *conf.Bootstrap, logger log.Logger) error {
+	mapping := mappingFlag{}
+	fs := flag.NewFlagSet(args[0], flag.ExitOnError)
+	format := fs.String("format", "csv", "file format, csv or ndjson")
+	dryRun := fs.Bool("dry-run", false, "validate only, do not write")
+	upsert := fs.Bool("upsert", false, "update existing users matched by username")
+	fs.Var(mapping, "map", "map a source column to a user field, eg: -map login:username -map dept:attributes.department")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s [flags] import-users [flags] <file|->\n\nFlags:\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args[1:])
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("missing input file")
+	}
+	f, err := acctuc.ParseTransferFormat(*format)
+	if err != nil {
+		return err
+	}
+	var r io.Reader = os.Stdin
+	if name := fs.Arg(0); name != "-" {
+		file, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+	uc, cleanup, err := wireAccountUsecase(bc.Data, bc.Auth, bc.Account, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	report, err := uc.ImportUsers(context.Background(), r, &acctuc.ImportOptions{
+		Format:  f,
+		Mapping: mapping,
+		DryRun:  *dryRun,
+		Upsert:  *upsert,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("total=%d created=%d updated=%d failed=%d dry_run=%v\n",
+		report.Total, report.Created, report.Updated, report.Failed, report.DryRun)
+	for _, e := range report.Errors {
+		fmt.Printf("line %d (%s): %v\n", e.Line, e.Username, e.Err)
+	}
+	return nil
+}
+
+func exportUsers(args []string, bc *conf.Bootstrap, logger log.Logger) error {
+	fs := flag.NewFlagSet(args[0], flag.ExitOnError)
+	format := fs.String("format", "csv", "file format, csv or ndjson")
+	output := fs.String("o", "-", "output file, - for stdout")
+	kind := fs.String("kind", "", "only export users of the kind, human or service")
+	attributes := fs.String("attributes", "", "only export users with the attributes, eg: department=dev,level=3")
+	_ = fs.Parse(args[1:])
+	f, err := acctuc.ParseTransferFormat(*format)
+	if err != nil {
+		return err
+	}
+	filter := &repo.UserFilter{Kind: repo.UserKind(*kind)}
+	if *kind != "" && filter.Kind != repo.UserKindHuman && filter.Kind != repo.UserKindService {
+		return fmt.Errorf("invalid kind %q, expected human or service", *kind)
+	}
+	if *attributes != "" {
+		filter.Attributes = make(map[string]interface{})
+		for _, kv := range strings.Split(*attributes, ",") {
+			i := strings.Index(kv, "=")
+			if i < 0 {
+				return fmt.Errorf("invalid attribute filter %q, expected name=value", kv)
+			}
+			filter.Attributes[kv[:i]] = kv[i+1:]
+		}
+	}
+	var w io.Writer = os.Stdout
+	if *output != "-" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	uc, cleanup, err := wireAccountUsecase(bc.Data, bc.Auth, bc.Account, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	n, err := uc.ExportUsers(context.Background(), w, filter, f)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%d users exported\n", n)
+	return nil
+}
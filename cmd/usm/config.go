@@ -0,0 +1,451 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"usm/internal/biz/usecase/account"
+	"usm/internal/biz/usecase/oauth"
+	"usm/internal/conf"
+	"usm/internal/server"
+	"usm/pkg/kratos/contrib/log/zap"
+
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// 环境变量覆盖配置文件中的值，例如 USM_DATA_DATABASE_SOURCE 对应 data.database.source，
+// USM_LOG_MODULES_DATA 对应 log.modules.data，列表用逗号分隔，不支持元素为对象的列表
+const envPrefix = "USM_"
+
+// ${NAME} 或 ${NAME:default}
+var placeholderPattern = regexp.MustCompile(`\$\{([^}:]+)(?::([^}]*))?\}`)
+
+// application 应用和运行时可以修改配置的组件
+type application struct {
+	*kratos.App
+	account *account.Usecase
+	oauth   *oauth.Usecase
+	cors    *server.CORS
+}
+
+func newConfig(path string) config.Config {
+	return config.New(
+		config.WithSource(&overrideSource{Source: file.NewSource(path), overrides: env.NewSource(envPrefix)}),
+		config.WithDecoder(decodeConfig),
+		config.WithResolver(resolveConfig),
+	)
+}
+
+// loadConfig 加载、解析并校验配置
+func loadConfig(c config.Config) (*conf.Bootstrap, error) {
+	if err := c.Load(); err != nil {
+		return nil, err
+	}
+	return scanConfig(c)
+}
+
+func scanConfig(c config.Config) (*conf.Bootstrap, error) {
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		return nil, err
+	}
+	if err := validateConfig(&bc); err != nil {
+		return nil, err
+	}
+	return &bc, nil
+}
+
+// overrideSource 每次加载配置文件后都合并环境变量，配置文件修改后环境变量仍然优先
+type overrideSource struct {
+	config.Source
+	overrides config.Source
+}
+
+func (s *overrideSource) Load() ([]*config.KeyValue, error) {
+	kvs, err := s.Source.Load()
+	if err != nil {
+		return nil, err
+	}
+	return s.withOverrides(kvs)
+}
+
+func (s *overrideSource) Watch() (config.Watcher, error) {
+	w, err := s.Source.Watch()
+	if err != nil {
+		return nil, err
+	}
+	return &overrideWatcher{Watcher: w, source: s}, nil
+}
+
+func (s *overrideSource) withOverrides(kvs []*config.KeyValue) ([]*config.KeyValue, error) {
+	overrides, err := s.overrides.Load()
+	if err != nil {
+		return nil, err
+	}
+	return append(kvs, overrides...), nil
+}
+
+type overrideWatcher struct {
+	config.Watcher
+	source *overrideSource
+}
+
+func (w *overrideWatcher) Next() ([]*config.KeyValue, error) {
+	kvs, err := w.Watcher.Next()
+	if err != nil {
+		return nil, err
+	}
+	return w.source.withOverrides(kvs)
+}
+
+// decodeConfig 配置文件按格式解析，环境变量按 conf.Bootstrap 的字段转换为配置路径，不对应任何字段的忽略
+func decodeConfig(kv *config.KeyValue, target map[string]interface{}) error {
+	if kv.Format != "" {
+		codec := encoding.GetCodec(kv.Format)
+		if codec == nil {
+			return fmt.Errorf("unsupported key: %s format: %s", kv.Key, kv.Format)
+		}
+		return codec.Unmarshal(kv.Value, &target)
+	}
+	md := (&conf.Bootstrap{}).ProtoReflect().Descriptor()
+	path, fd, ok := fieldPath(md, strings.Split(strings.ToLower(kv.Key), "_"))
+	if !ok {
+		return nil
+	}
+	v, err := envValue(fd, string(kv.Value))
+	if err != nil {
+		return fmt.Errorf("environment variable %s%s: %w", envPrefix, kv.Key, err)
+	}
+	for _, k := range path[:len(path)-1] {
+		sub := make(map[string]interface{})
+		target[k] = sub
+		target = sub
+	}
+	target[path[len(path)-1]] = v
+	return nil
+}
+
+// fieldPath 将下划线分隔的单词匹配为字段路径，字段名本身可以包含下划线，优先匹配较长的字段名
+func fieldPath(md protoreflect.MessageDescriptor, words []string) ([]string, protoreflect.FieldDescriptor, bool) {
+	for i := len(words); i > 0; i-- {
+		fd := md.Fields().ByName(protoreflect.Name(strings.Join(words[:i], "_")))
+		if fd == nil {
+			continue
+		}
+		name, rest := string(fd.Name()), words[i:]
+		switch {
+		case fd.IsMap():
+			if len(rest) > 0 && fd.MapValue().Kind() != protoreflect.MessageKind {
+				return []string{name, strings.Join(rest, "_")}, fd.MapValue(), true
+			}
+		case fd.Kind() == protoreflect.MessageKind && !isScalarMessage(fd):
+			if fd.IsList() || len(rest) == 0 {
+				continue
+			}
+			if path, leaf, ok := fieldPath(fd.Message(), rest); ok {
+				return append([]string{name}, path...), leaf, true
+			}
+		case len(rest) == 0:
+			return []string{name}, fd, true
+		}
+	}
+	return nil, nil, false
+}
+
+// isScalarMessage 在配置中按标量填写的消息：Duration 填写字符串，DoubleValue 等包装类型填写 value 字段的值
+func isScalarMessage(fd protoreflect.FieldDescriptor) bool {
+	return isDuration(fd) || isWrapper(fd)
+}
+
+func isDuration(fd protoreflect.FieldDescriptor) bool {
+	return fd.Message() != nil && fd.Message().FullName() == "google.protobuf.Duration"
+}
+
+func isWrapper(fd protoreflect.FieldDescriptor) bool {
+	if fd.Message() == nil {
+		return false
+	}
+	name := string(fd.Message().FullName())
+	return strings.HasPrefix(name, "google.protobuf.") && strings.HasSuffix(name, "Value")
+}
+
+// envValue 按字段类型转换环境变量的值，列表用逗号分隔
+func envValue(fd protoreflect.FieldDescriptor, s string) (interface{}, error) {
+	kind := fd.Kind()
+	if isWrapper(fd) {
+		kind = fd.Message().Fields().ByName("value").Kind()
+	}
+	if fd.IsList() {
+		var list []interface{}
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item == "" {
+				continue
+			}
+			v, err := scalarValue(kind, item)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	}
+	return scalarValue(kind, s)
+}
+
+func scalarValue(kind protoreflect.Kind, s string) (interface{}, error) {
+	switch kind {
+	case protoreflect.BoolKind:
+		return strconv.ParseBool(s)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.ParseInt(s, 10, 64)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.ParseUint(s, 10, 64)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return strconv.ParseFloat(s, 64)
+	}
+	return s, nil
+}
+
+// resolveConfig 替换字符串中的 ${NAME} 和 ${NAME:default}，NAME 依次查找环境变量和配置路径，
+// 都不存在且没有默认值时返回错误
+func resolveConfig(input map[string]interface{}) error {
+	var resolve func(path string, v interface{}) (interface{}, error)
+	resolve = func(path string, v interface{}) (interface{}, error) {
+		switch vt := v.(type) {
+		case string:
+			return expandPlaceholders(input, path, vt)
+		case map[string]interface{}:
+			for k, sub := range vt {
+				r, err := resolve(joinPath(path, k), sub)
+				if err != nil {
+					return nil, err
+				}
+				vt[k] = r
+			}
+		case []interface{}:
+			for i, sub := range vt {
+				r, err := resolve(fmt.Sprintf("%s[%d]", path, i), sub)
+				if err != nil {
+					return nil, err
+				}
+				vt[i] = r
+			}
+		}
+		return v, nil
+	}
+	_, err := resolve("", input)
+	return err
+}
+
+func expandPlaceholders(input map[string]interface{}, path, s string) (string, error) {
+	var err error
+	res := placeholderPattern.ReplaceAllStringFunc(s, func(m string) string {
+		sub := placeholderPattern.FindStringSubmatch(m)
+		name := strings.TrimSpace(sub[1])
+		if v, ok := os.LookupEnv(name); ok {
+			return v
+		}
+		if v, ok := lookupConfig(input, name); ok {
+			return v
+		}
+		if strings.Contains(m, ":") {
+			return sub[2]
+		}
+		if err == nil {
+			err = fmt.Errorf("config %s: ${%s} is not set", path, name)
+		}
+		return m
+	})
+	return res, err
+}
+
+func lookupConfig(input map[string]interface{}, path string) (string, bool) {
+	var v interface{} = input
+	for _, k := range strings.Split(path, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if v, ok = m[k]; !ok {
+			return "", false
+		}
+	}
+	switch v.(type) {
+	case map[string]interface{}, []interface{}, nil:
+		return "", false
+	}
+	return fmt.Sprint(v), true
+}
+
+func joinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+// validateConfig 校验配置，返回所有不合法的字段，例如 data.database.source: value length must be at least 1 runes
+func validateConfig(bc *conf.Bootstrap) error {
+	var errs []string
+	if err := bc.ValidateAll(); err != nil {
+		errs = validationErrors("", err)
+	}
+	if err := oauth.CheckConfig(bc.Auth); err != nil {
+		errs = append(errs, fmt.Sprintf("auth.oauth: %v", err))
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid config: %s", strings.Join(errs, "; "))
+}
+
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+func validationErrors(path string, err error) []string {
+	var multi interface{ AllErrors() []error }
+	if errors.As(err, &multi) {
+		var res []string
+		for _, e := range multi.AllErrors() {
+			res = append(res, validationErrors(path, e)...)
+		}
+		return res
+	}
+	var fe fieldError
+	if !errors.As(err, &fe) {
+		return []string{fmt.Sprintf("%s: %v", path, err)}
+	}
+	path = joinPath(path, snakeCase(fe.Field()))
+	if fe.Cause() != nil {
+		return validationErrors(path, fe.Cause())
+	}
+	return []string{fmt.Sprintf("%s: %s", path, fe.Reason())}
+}
+
+// snakeCase 将 Go 字段名转换为配置中的字段名，例如 AccessTokenTtl 为 access_token_ttl，LoginIdentifiers[0] 为 login_identifiers[0]
+func snakeCase(field string) string {
+	name, index := field, ""
+	if i := strings.IndexByte(field, '['); i >= 0 {
+		name, index = field[:i], field[i:]
+	}
+	var sb strings.Builder
+	for i, r := range name {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				sb.WriteByte('_')
+			}
+			r += 'a' - 'A'
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String() + index
+}
+
+// configWatcher 配置文件修改后校验整个配置，只应用可以在运行时修改的部分：日志配置、跨域配置和令牌有效期，
+// 其他修改记录警告，重启后生效
+type configWatcher struct {
+	c config.Config
+	// current 为已应用的配置，每个 key 只更新自己的部分，一次修改涉及多个 key 时都与修改前的配置比较
+	current *conf.Bootstrap
+	logger  *zap.Logger
+	app     *application
+}
+
+var reloadableKeys = []string{"log", "auth", "server"}
+
+func watchConfig(c config.Config, current *conf.Bootstrap, logger *zap.Logger, app *application) error {
+	w := &configWatcher{c: c, current: proto.Clone(current).(*conf.Bootstrap), logger: logger, app: app}
+	for _, key := range append(reloadableKeys, "data", "account", "trace") {
+		if err := c.Watch(key, w.observe); err != nil && !errors.Is(err, config.ErrNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *configWatcher) observe(key string, _ config.Value) {
+	bc, err := scanConfig(w.c)
+	if err != nil {
+		log.Errorf("config %s changed but not applied: %v", key, err)
+		return
+	}
+	switch key {
+	case "log":
+		zc, err := newLogConfig(bc.Log)
+		if err == nil {
+			err = w.logger.Reload(zc)
+		}
+		if err != nil {
+			log.Errorf("config log changed but not applied: %v", err)
+			return
+		}
+		level, modules := w.logger.Levels().Get()
+		log.Infof("log config reloaded, level=%s, modules=%v", level, modules)
+		w.current.Log = bc.Log
+	case "auth":
+		w.app.account.SetTokenTTLs(bc.Auth)
+		w.app.oauth.SetTokenTTLs(bc.Auth)
+		log.Infof("token ttls reloaded")
+		if !proto.Equal(withoutTokenTTLs(w.current.Auth), withoutTokenTTLs(bc.Auth)) {
+			log.Warnf("config auth changed, changes other than token ttls take effect after restart")
+		}
+		w.current.Auth = bc.Auth
+	case "server":
+		w.app.cors.Set(bc.Server.GetHttp().GetCors())
+		log.Infof("cors config reloaded")
+		if !proto.Equal(withoutCORS(w.current.Server), withoutCORS(bc.Server)) {
+			log.Warnf("config server changed, changes other than cors take effect after restart")
+		}
+		w.current.Server = bc.Server
+	default:
+		log.Warnf("config %s changed, changes take effect after restart", key)
+	}
+}
+
+// withoutCORS 返回去掉运行时可以修改的跨域配置后的副本
+func withoutCORS(c *conf.Server) *conf.Server {
+	if c == nil {
+		return nil
+	}
+	c = proto.Clone(c).(*conf.Server)
+	if c.GetHttp() != nil {
+		c.Http.Cors = nil
+	}
+	return c
+}
+
+// withoutTokenTTLs 返回去掉运行时可以修改的令牌有效期后的副本
+func withoutTokenTTLs(c *conf.Auth) *conf.Auth {
+	if c == nil {
+		return nil
+	}
+	c = proto.Clone(c).(*conf.Auth)
+	if c.GetOauth() != nil {
+		c.Oauth.CodeTtl, c.Oauth.AccessTokenTtl, c.Oauth.RefreshTokenTtl = nil, nil, nil
+	}
+	if c.GetEmailVerification() != nil {
+		c.EmailVerification.TokenTtl = nil
+	}
+	if c.GetPasswordReset() != nil {
+		c.PasswordReset.TokenTtl = nil
+	}
+	if c.GetInvitation() != nil {
+		c.Invitation.TokenTtl = nil
+	}
+	return c
+}
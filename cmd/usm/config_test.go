@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"usm/internal/conf"
+	"usm/internal/server"
+	"usm/pkg/kratos/contrib/log/zap"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const testConfig = `
+server:
+  http:
+    addr: 0.0.0.0:8000
+  grpc:
+    addr: 0.0.0.0:9000
+data:
+  database:
+    driver: postgres
+    source: "host=${TEST_DB_HOST} password=${TEST_DB_PASSWORD:secret}"
+auth:
+  oauth:
+    issuer: http://${server.http.addr}
+    access_token_ttl: 3600s
+log:
+  level: info
+`
+
+func writeConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestDecodeConfig_Env(t *testing.T) {
+	tests := []struct {
+		key   string
+		value string
+		want  map[string]interface{}
+	}{
+		{key: "DATA_DATABASE_SOURCE", value: "dsn", want: map[string]interface{}{"data": map[string]interface{}{"database": map[string]interface{}{"source": "dsn"}}}},
+		{key: "AUTH_OAUTH_ACCESS_TOKEN_TTL", value: "60s", want: map[string]interface{}{"auth": map[string]interface{}{"oauth": map[string]interface{}{"access_token_ttl": "60s"}}}},
+		{key: "LOG_MODULES_DATA", value: "debug", want: map[string]interface{}{"log": map[string]interface{}{"modules": map[string]interface{}{"data": "debug"}}}},
+		{key: "LOG_KEEP_EMAILS", value: "true", want: map[string]interface{}{"log": map[string]interface{}{"keep_emails": true}}},
+		{key: "LOG_RATE_LIMIT_BURST", value: "10", want: map[string]interface{}{"log": map[string]interface{}{"rate_limit": map[string]interface{}{"burst": int64(10)}}}},
+		{key: "AUTH_LOGIN_IDENTIFIERS", value: "username, email", want: map[string]interface{}{"auth": map[string]interface{}{"login_identifiers": []interface{}{"username", "email"}}}},
+		{key: "TRACE_SAMPLE_RATIO", value: "0", want: map[string]interface{}{"trace": map[string]interface{}{"sample_ratio": float64(0)}}},
+		{key: "UNKNOWN_FIELD", value: "x", want: map[string]interface{}{}},
+		{key: "LOG_OUTPUTS_TYPE", value: "stdout", want: map[string]interface{}{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			target := make(map[string]interface{})
+			require.NoError(t, decodeConfig(&config.KeyValue{Key: tt.key, Value: []byte(tt.value)}, target))
+			assert.Equal(t, tt.want, target)
+		})
+	}
+
+	err := decodeConfig(&config.KeyValue{Key: "LOG_KEEP_EMAILS", Value: []byte("maybe")}, make(map[string]interface{}))
+	assert.EqualError(t, err, `environment variable USM_LOG_KEEP_EMAILS: strconv.ParseBool: parsing "maybe": invalid syntax`)
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Setenv("TEST_DB_HOST", "db.example.com")
+	t.Setenv("USM_LOG_LEVEL", "debug")
+	t.Setenv("USM_DATA_DATABASE_DRIVER", "sqlite3")
+	path := writeConfig(t, testConfig)
+	c := newConfig(path)
+	defer c.Close()
+	bc, err := loadConfig(c)
+	require.NoError(t, err)
+	assert.Equal(t, "host=db.example.com password=secret", bc.Data.Database.Source)
+	assert.Equal(t, "http://0.0.0.0:8000", bc.Auth.Oauth.Issuer)
+	assert.Equal(t, "sqlite3", bc.Data.Database.Driver)
+	assert.Equal(t, "debug", bc.Log.Level)
+
+	// 配置文件修改后环境变量仍然优先
+	require.NoError(t, os.WriteFile(path, []byte(testConfig+"  modules:\n    data: warn\n"), 0o600))
+	assert.Eventually(t, func() bool {
+		bc, err = scanConfig(c)
+		return err == nil && bc.Log.Modules["data"] == "warn"
+	}, 3*time.Second, 50*time.Millisecond)
+	assert.Equal(t, "debug", bc.Log.Level)
+	assert.Equal(t, "sqlite3", bc.Data.Database.Driver)
+}
+
+func TestLoadConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr string
+	}{
+		{
+			name:    "missing placeholder",
+			wantErr: "config data.database.source: ${TEST_DB_HOST} is not set",
+		},
+		{
+			name: "invalid fields",
+			env: map[string]string{
+				"TEST_DB_HOST":                    "localhost",
+				"USM_LOG_LEVEL":                   "verbose",
+				"USM_AUTH_OAUTH_ACCESS_TOKEN_TTL": "0s",
+			},
+			wantErr: "invalid config: auth.oauth.access_token_ttl: value must be greater than 0s; log.level: value must be in list [ debug info warn error]",
+		},
+		{
+			name: "negative sample ratio",
+			env: map[string]string{
+				"TEST_DB_HOST":           "localhost",
+				"USM_TRACE_SAMPLE_RATIO": "-0.5",
+			},
+			wantErr: "invalid config: trace.sample_ratio: value must be inside range [0, 1]",
+		},
+		{
+			name: "key overlap shorter than access token ttl",
+			env: map[string]string{
+				"TEST_DB_HOST":               "localhost",
+				"USM_AUTH_OAUTH_KEY_OVERLAP": "1800s",
+			},
+			wantErr: "invalid config: auth.oauth: key_overlap 30m0s must be longer than access_token_ttl 1h0m0s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			c := newConfig(writeConfig(t, testConfig))
+			defer c.Close()
+			_, err := loadConfig(c)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestWithoutTokenTTLs(t *testing.T) {
+	var bc conf.Bootstrap
+	c := newConfig(writeConfig(t, "auth:\n  oauth:\n    issuer: https://usm.example.com\n    access_token_ttl: 60s\n  invitation:\n    token_ttl: 3600s\n"))
+	defer c.Close()
+	require.NoError(t, c.Load())
+	require.NoError(t, c.Scan(&bc))
+	auth := withoutTokenTTLs(bc.Auth)
+	assert.Nil(t, auth.Oauth.AccessTokenTtl)
+	assert.Nil(t, auth.Invitation.TokenTtl)
+	assert.Equal(t, "https://usm.example.com", auth.Oauth.Issuer)
+	assert.NotNil(t, bc.Auth.Oauth.AccessTokenTtl, "original must not be modified")
+	assert.Nil(t, withoutTokenTTLs(nil))
+}
+
+func TestWithoutCORS(t *testing.T) {
+	c := &conf.Server{Http: &conf.Server_HTTP{Addr: ":8000", Cors: &conf.Server_HTTP_CORS{AllowedOrigins: []string{"*"}}}}
+	got := withoutCORS(c)
+	assert.Nil(t, got.Http.Cors)
+	assert.Equal(t, ":8000", got.Http.Addr)
+	assert.NotNil(t, c.Http.Cors, "original must not be modified")
+	assert.True(t, proto.Equal(withoutCORS(c), withoutCORS(&conf.Server{Http: &conf.Server_HTTP{Addr: ":8000"}})))
+	assert.Nil(t, withoutCORS(nil))
+}
+
+func TestConfigWatcher_MultipleKeys(t *testing.T) {
+	t.Setenv("TEST_DB_HOST", "db.example.com")
+	var current conf.Bootstrap
+	old := newConfig(writeConfig(t, testConfig))
+	defer old.Close()
+	require.NoError(t, old.Load())
+	require.NoError(t, old.Scan(&current))
+
+	// 同一次修改同时修改了日志级别和 server 中不能在运行时修改的地址
+	c := newConfig(writeConfig(t, strings.Replace(strings.Replace(testConfig, "level: info", "level: warn", 1), ":8000", ":8080", 1)))
+	defer c.Close()
+	require.NoError(t, c.Load())
+	zl, err := zap.NewLogger(&zap.Config{Dev: true})
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	log.SetLogger(log.NewStdLogger(&buf))
+	defer log.SetLogger(log.NewStdLogger(os.Stderr))
+
+	w := &configWatcher{c: c, current: &current, logger: zl, app: &application{cors: server.NewCORS(current.Server)}}
+	w.observe("log", nil)
+	w.observe("server", nil)
+	level, _ := zl.Levels().Get()
+	assert.Equal(t, "warn", level)
+	assert.Contains(t, buf.String(), "config server changed, changes other than cors take effect after restart")
+	assert.Equal(t, "0.0.0.0:8080", w.current.Server.Http.Addr)
+}
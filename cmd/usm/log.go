@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"usm/internal/conf"
+	"usm/pkg/kratos/contrib/log/zap"
+	"usm/pkg/kratos/contrib/metrics/prometheus"
+
+	"github.com/go-kratos/kratos/v2/log"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const defaultLogPrefix = "USMV9"
+
+var logDroppedTotal = promauto.NewCounterVec(prom.CounterOpts{
+	Namespace: "usm",
+	Subsystem: "log",
+	Name:      "dropped_entries_total",
+	Help:      "The total number of log entries dropped by sampling or rate limiting.",
+}, []string{"reason", "level"})
+
+// bootstrapLogger 加载配置前使用的日志，只输出到 stderr，用于报告配置错误
+func bootstrapLogger() log.Logger {
+	l, err := zap.NewLogger(&zap.Config{
+		Dev:      flagDebug,
+		Prefix:   defaultLogPrefix,
+		Encoding: flagLogEncoding,
+		Outputs:  []zap.Output{{Type: zap.OutputStderr}},
+	})
+	if err != nil {
+		return log.NewStdLogger(os.Stderr)
+	}
+	// 与 log.With 包装后的层数一致，caller 才能指向调用代码
+	return log.With(l)
+}
+
+// newLogConfig 将配置转换为 zap.Config，-debug 和 -log-encoding 参数优先于配置
+func newLogConfig(c *conf.Log) (*zap.Config, error) {
+	zc := &zap.Config{
+		Dev:               c.GetDev() || flagDebug,
+		LogFilePath:       c.GetFile().GetPath(),
+		LogFileMaxSize:    int(c.GetFile().GetMaxSize()),
+		LogFileMaxBackups: int(c.GetFile().GetMaxBackups()),
+		LogFileMaxAge:     int(c.GetFile().GetMaxAge()),
+		LogFileCompress:   c.GetFile().GetCompress(),
+		LogFileLocalTime:  c.GetFile().GetLocalTime(),
+		Prefix:            c.GetPrefix(),
+		Encoding:          c.GetEncoding(),
+		Level:             c.GetLevel(),
+		ModuleLevels:      c.GetModules(),
+		RedactKeys:        c.GetRedactKeys(),
+		KeepEmails:        c.GetKeepEmails(),
+		Dropped:           prometheus.NewCounter(logDroppedTotal),
+	}
+	if zc.Prefix == "" {
+		zc.Prefix = defaultLogPrefix
+	}
+	if flagLogEncoding != "" {
+		zc.Encoding = flagLogEncoding
+	}
+	if c.GetStacktraceLevel() != "" {
+		if err := zc.StacktraceLevel.UnmarshalText([]byte(c.StacktraceLevel)); err != nil {
+			return nil, fmt.Errorf("invalid stacktrace level %q", c.StacktraceLevel)
+		}
+		zc.AddStacktrace = true
+	}
+	for _, o := range c.GetOutputs() {
+		zc.Outputs = append(zc.Outputs, zap.Output{
+			Type:     o.Type,
+			Level:    o.Level,
+			Encoding: o.Encoding,
+			File: zap.FileOutput{
+				Path:       o.GetFile().GetPath(),
+				MaxSize:    int(o.GetFile().GetMaxSize()),
+				MaxBackups: int(o.GetFile().GetMaxBackups()),
+				MaxAge:     int(o.GetFile().GetMaxAge()),
+				Compress:   o.GetFile().GetCompress(),
+				LocalTime:  o.GetFile().GetLocalTime(),
+			},
+			Syslog: zap.SyslogOutput{
+				Network:  o.GetSyslog().GetNetwork(),
+				Address:  o.GetSyslog().GetAddress(),
+				Facility: o.GetSyslog().GetFacility(),
+				Tag:      o.GetSyslog().GetTag(),
+			},
+		})
+	}
+	if s := c.GetSampling(); s != nil {
+		zc.Sampling = &zap.Sampling{
+			Tick:       s.Tick.AsDuration(),
+			Initial:    int(s.Initial),
+			Thereafter: int(s.Thereafter),
+		}
+	}
+	if r := c.GetRateLimit(); r != nil {
+		zc.RateLimit = &zap.RateLimit{
+			Interval: r.Interval.AsDuration(),
+			Burst:    int(r.Burst),
+		}
+	}
+	return zc, nil
+}
+
+func newLogger(c *conf.Log) (*zap.Logger, error) {
+	zc, err := newLogConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return zap.NewLogger(zc)
+}
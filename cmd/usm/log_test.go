@@ -0,0 +1,28 @@
+package main
+
+import (
+	"testing"
+
+	"usm/internal/conf"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogConfig_File(t *testing.T) {
+	zc, err := newLogConfig(&conf.Log{File: &conf.Log_Output_File{
+		Path:       "/var/log/usm.log",
+		MaxSize:    20,
+		MaxBackups: 5,
+		MaxAge:     7,
+		Compress:   true,
+		LocalTime:  true,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "/var/log/usm.log", zc.LogFilePath)
+	assert.Equal(t, 20, zc.LogFileMaxSize)
+	assert.Equal(t, 5, zc.LogFileMaxBackups)
+	assert.Equal(t, 7, zc.LogFileMaxAge)
+	assert.True(t, zc.LogFileCompress)
+	assert.True(t, zc.LogFileLocalTime)
+}
@@ -3,24 +3,24 @@ package main
 import (
 	"flag"
 	"os"
+	"time"
 
-	"usm/internal/conf"
-	"usm/pkg/kratos/contrib/log/zap"
+	"usm/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
-	"github.com/go-kratos/kratos/v2/config"
-	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 // go build -ldflags "-X main.Version=x.y.z"
 var (
-	Name      string
-	Version   string
-	flagConf  string
-	flagDebug bool
+	Name            string
+	Version         string
+	flagConf        string
+	flagDebug       bool
+	flagLogEncoding string
 
 	id, _ = os.Hostname()
 )
@@ -28,53 +28,71 @@ var (
 func init() {
 	flag.StringVar(&flagConf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 	flag.BoolVar(&flagDebug, "debug", false, "Run in debug mode")
+	flag.StringVar(&flagLogEncoding, "log-encoding", "", "log encoding: console or json, overrides log.encoding in config")
+	flag.Usage = usage
 }
 
-func newApp(logger log.Logger, hs *http.Server, gs *grpc.Server) *kratos.App {
+func newApp(logger log.Logger, hs *http.Server, gs *grpc.Server, ks *server.KeyRotationServer, health *server.Health) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
 		kratos.Version(Version),
 		kratos.Metadata(map[string]string{}),
 		kratos.Logger(logger),
+		// 通过 Registrar 在服务启动后切换为就绪，停止前切换为未就绪并等待 drain
+		kratos.Registrar(health),
+		kratos.RegistrarTimeout(health.DrainPeriod()+10*time.Second),
 		kratos.Server(
 			hs,
 			gs,
+			ks,
 		),
 	)
 }
 
 func main() {
 	flag.Parse()
-	logger, err := zap.NewLogger(&zap.Config{
-		Dev:    flagDebug,
-		Prefix: "USMV9",
-	})
+	// 配置加载前的日志和错误输出到 stderr
+	bl := bootstrapLogger()
+	log.SetLogger(bl)
+	blog := log.NewHelper(bl)
+	c := newConfig(flagConf)
+	defer c.Close()
+
+	bc, err := loadConfig(c)
 	if err != nil {
-		panic(err)
+		blog.Fatalf("load config %s: %v", flagConf, err)
 	}
-	log.With(logger)
-	c := config.New(
-		config.WithSource(
-			file.NewSource(flagConf),
-		),
-	)
-	defer c.Close()
+	zl, err := newLogger(bc.Log)
+	if err != nil {
+		blog.Fatalf("create logger: %v", err)
+	}
+	defer zl.Sync()
+	// 通过 log.WithContext 记录的日志带上当前请求的 trace_id
+	logger := log.With(zl, "trace_id", tracing.TraceID(), "span_id", tracing.SpanID())
+	log.SetLogger(logger)
 
-	if err := c.Load(); err != nil {
-		panic(err)
+	if flag.NArg() > 0 {
+		if err := runCommand(flag.Args(), bc, logger); err != nil {
+			panic(err)
+		}
+		return
 	}
 
-	var bc conf.Bootstrap
-	if err := c.Scan(&bc); err != nil {
+	shutdown, err := setTracerProvider(bc.Trace)
+	if err != nil {
 		panic(err)
 	}
+	defer shutdown()
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, bc.Account, logger, zl.Levels())
 	if err != nil {
 		panic(err)
 	}
 	defer cleanup()
+	if err := watchConfig(c, bc, zl, app); err != nil {
+		panic(err)
+	}
 
 	// start and wait for stop signal
 	if err := app.Run(); err != nil {
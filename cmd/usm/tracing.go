@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"usm/internal/conf"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+)
+
+// setTracerProvider 设置全局的 TracerProvider，返回的函数在退出前导出剩余的 span
+func setTracerProvider(c *conf.Trace) (func(), error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	exporter, err := newTraceExporter(c)
+	if err != nil {
+		return nil, err
+	}
+	ratio := 1.0
+	if r := c.GetSampleRatio(); r != nil {
+		ratio = r.Value
+	}
+	name := Name
+	if name == "" {
+		name = "usm"
+	}
+	opts := []tracesdk.TracerProviderOption{
+		tracesdk.WithSampler(tracesdk.ParentBased(tracesdk.TraceIDRatioBased(ratio))),
+		tracesdk.WithResource(resource.NewSchemaless(
+			semconv.ServiceNameKey.String(name),
+			semconv.ServiceVersionKey.String(Version),
+			semconv.ServiceInstanceIDKey.String(id),
+		)),
+	}
+	// 不导出时仍然生成 trace_id，便于关联同一请求的日志
+	if exporter != nil {
+		opts = append(opts, tracesdk.WithBatcher(exporter))
+	}
+	tp := tracesdk.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		tp.Shutdown(ctx)
+	}, nil
+}
+
+func newTraceExporter(c *conf.Trace) (tracesdk.SpanExporter, error) {
+	switch c.GetExporter() {
+	case "":
+		return nil, nil
+	case "stdout":
+		return stdouttrace.New()
+	case "otlp":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(c.Endpoint)}
+		if c.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		// 不阻塞启动，接收端不可用时 span 在后台重试导出
+		return otlptracegrpc.New(context.Background(), opts...)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", c.Exporter)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"usm/internal/conf"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSetTracerProvider_SampleRatio(t *testing.T) {
+	tests := []struct {
+		name        string
+		c           *conf.Trace
+		wantSampled bool
+	}{
+		{name: "unset samples all", c: &conf.Trace{}, wantSampled: true},
+		{name: "one samples all", c: &conf.Trace{SampleRatio: wrapperspb.Double(1)}, wantSampled: true},
+		{name: "zero samples none", c: &conf.Trace{SampleRatio: wrapperspb.Double(0)}, wantSampled: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shutdown, err := setTracerProvider(tt.c)
+			require.NoError(t, err)
+			defer shutdown()
+			_, span := otel.Tracer("test").Start(context.Background(), "test")
+			defer span.End()
+			assert.Equal(t, tt.wantSampled, span.SpanContext().IsSampled())
+		})
+	}
+}
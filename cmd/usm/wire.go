@@ -6,17 +6,36 @@ package main
 
 import (
 	"usm/internal/biz"
+	"usm/internal/biz/usecase/account"
+	"usm/internal/biz/usecase/oauth"
 	"usm/internal/conf"
 	"usm/internal/data"
 	"usm/internal/server"
 	"usm/internal/service"
+	"usm/pkg/kratos/contrib/log/zap"
 
-	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// metricsSet 指标注册到 Prometheus 默认的 Registry，与日志丢弃计数共用
+var metricsSet = wire.NewSet(
+	wire.InterfaceValue(new(prometheus.Registerer), prometheus.DefaultRegisterer),
+	wire.InterfaceValue(new(prometheus.Gatherer), prometheus.DefaultGatherer),
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, log.Logger) (*kratos.App, func(), error) {
-	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
+func wireApp(*conf.Server, *conf.Data, *conf.Auth, *conf.Account, log.Logger, *zap.Levels) (*application, func(), error) {
+	panic(wire.Build(metricsSet, server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp, wire.Struct(new(application), "*")))
+}
+
+// wireOAuthUsecase init oauth usecase for command line tools.
+func wireOAuthUsecase(*conf.Data, *conf.Auth, log.Logger) (*oauth.Usecase, func(), error) {
+	panic(wire.Build(metricsSet, data.ProviderSet, biz.ProviderSet))
+}
+
+// wireAccountUsecase init account usecase for command line tools.
+func wireAccountUsecase(*conf.Data, *conf.Auth, *conf.Account, log.Logger) (*account.Usecase, func(), error) {
+	panic(wire.Build(metricsSet, data.ProviderSet, biz.ProviderSet))
 }
@@ -7,31 +7,204 @@
 package main
 
 import (
-	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+	"github.com/prometheus/client_golang/prometheus"
 	"usm/internal/biz/usecase/account"
+	"usm/internal/biz/usecase/group"
+	"usm/internal/biz/usecase/oauth"
 	"usm/internal/conf"
 	"usm/internal/data"
 	"usm/internal/server"
 	account2 "usm/internal/service/account"
+	oauth2 "usm/internal/service/oauth"
+	"usm/internal/service/scim"
+	"usm/pkg/kratos/contrib/log/zap"
 )
 
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
-	dataData, cleanup, err := data.NewData(confData, logger)
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, confAccount *conf.Account, logger log.Logger, levels *zap.Levels) (*application, func(), error) {
+	registerer := _wireRegistererValue
+	dataData, cleanup, err := data.NewData(confData, registerer, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	transaction := data.NewTransaction(dataData)
 	userRepo := data.NewUserRepo(dataData)
-	usecase := account.NewUsecase(transaction, userRepo)
+	groupRepo := data.NewGroupRepo(dataData)
+	apiKeyRepo := data.NewAPIKeyRepo(dataData)
+	emailVerificationRepo := data.NewEmailVerificationRepo(dataData)
+	passwordResetRepo := data.NewPasswordResetRepo(dataData)
+	oAuthTokenRepo := data.NewOAuthTokenRepo(dataData)
+	invitationRepo := data.NewInvitationRepo(dataData)
+	mailer, cleanup2, err := data.NewMailer(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	notifier := data.NewNotifier(mailer)
+	externalAuthenticators, cleanup3, err := data.NewExternalAuthenticators(auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	metrics, err := data.NewMetrics(registerer)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	usecase, err := account.NewUsecase(auth, confAccount, transaction, userRepo, groupRepo, apiKeyRepo, emailVerificationRepo, passwordResetRepo, oAuthTokenRepo, invitationRepo, mailer, notifier, externalAuthenticators, metrics)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	service := account2.NewService(usecase, logger)
-	httpServer := server.NewHTTPServer(confServer, service, logger)
-	grpcServer := server.NewGRPCServer(confServer, service, logger)
-	app := newApp(logger, httpServer, grpcServer)
-	return app, func() {
+	oAuthClientRepo := data.NewOAuthClientRepo(dataData)
+	oAuthCodeRepo := data.NewOAuthCodeRepo(dataData)
+	signingKeyRepo, cleanup4, err := data.NewSigningKeyRepo(dataData, confData, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	oauthUsecase := oauth.NewUsecase(auth, transaction, userRepo, oAuthClientRepo, oAuthCodeRepo, oAuthTokenRepo, signingKeyRepo)
+	oauthService := oauth2.NewService(oauthUsecase, usecase, logger)
+	groupUsecase := group.NewUsecase(transaction, userRepo, groupRepo)
+	scimService, cleanup5, err := scim.NewService(auth, usecase, groupUsecase, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	healthChecker := data.NewHealthChecker(dataData)
+	keyRotationServer := server.NewKeyRotationServer(oauthUsecase, logger)
+	health := server.NewHealth(confServer, healthChecker, keyRotationServer, logger)
+	cors := server.NewCORS(confServer)
+	gatherer := _wireGathererValue
+	serverMetrics, err := server.NewMetrics(registerer, gatherer)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	httpServer, cleanup6, err := server.NewHTTPServer(confServer, service, oauthService, scimService, health, cors, serverMetrics, levels, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	grpcServer := server.NewGRPCServer(confServer, service, oauthService, health, serverMetrics, logger)
+	app := newApp(logger, httpServer, grpcServer, keyRotationServer, health)
+	mainApplication := &application{
+		App:     app,
+		account: usecase,
+		oauth:   oauthUsecase,
+		cors:    cors,
+	}
+	return mainApplication, func() {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
+
+var (
+	_wireRegistererValue = prometheus.DefaultRegisterer
+	_wireGathererValue   = prometheus.DefaultGatherer
+)
+
+// wireOAuthUsecase init oauth usecase for command line tools.
+func wireOAuthUsecase(confData *conf.Data, auth *conf.Auth, logger log.Logger) (*oauth.Usecase, func(), error) {
+	registerer := _wireRegistererValue
+	dataData, cleanup, err := data.NewData(confData, registerer, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	transaction := data.NewTransaction(dataData)
+	userRepo := data.NewUserRepo(dataData)
+	oAuthClientRepo := data.NewOAuthClientRepo(dataData)
+	oAuthCodeRepo := data.NewOAuthCodeRepo(dataData)
+	oAuthTokenRepo := data.NewOAuthTokenRepo(dataData)
+	signingKeyRepo, cleanup2, err := data.NewSigningKeyRepo(dataData, confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	usecase := oauth.NewUsecase(auth, transaction, userRepo, oAuthClientRepo, oAuthCodeRepo, oAuthTokenRepo, signingKeyRepo)
+	return usecase, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+// wireAccountUsecase init account usecase for command line tools.
+func wireAccountUsecase(confData *conf.Data, auth *conf.Auth, confAccount *conf.Account, logger log.Logger) (*account.Usecase, func(), error) {
+	registerer := _wireRegistererValue
+	dataData, cleanup, err := data.NewData(confData, registerer, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	transaction := data.NewTransaction(dataData)
+	userRepo := data.NewUserRepo(dataData)
+	groupRepo := data.NewGroupRepo(dataData)
+	apiKeyRepo := data.NewAPIKeyRepo(dataData)
+	emailVerificationRepo := data.NewEmailVerificationRepo(dataData)
+	passwordResetRepo := data.NewPasswordResetRepo(dataData)
+	oAuthTokenRepo := data.NewOAuthTokenRepo(dataData)
+	invitationRepo := data.NewInvitationRepo(dataData)
+	mailer, cleanup2, err := data.NewMailer(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	notifier := data.NewNotifier(mailer)
+	externalAuthenticators, cleanup3, err := data.NewExternalAuthenticators(auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	metrics, err := data.NewMetrics(registerer)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	usecase, err := account.NewUsecase(auth, confAccount, transaction, userRepo, groupRepo, apiKeyRepo, emailVerificationRepo, passwordResetRepo, oAuthTokenRepo, invitationRepo, mailer, notifier, externalAuthenticators, metrics)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	return usecase, func() {
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+// wire.go:
+
+// metricsSet 指标注册到 Prometheus 默认的 Registry，与日志丢弃计数共用
+var metricsSet = wire.NewSet(wire.InterfaceValue(new(prometheus.Registerer), prometheus.DefaultRegisterer), wire.InterfaceValue(new(prometheus.Gatherer), prometheus.DefaultGatherer))
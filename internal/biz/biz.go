@@ -1,10 +1,26 @@
 package biz
 
 import (
-	"errors"
+	"usm/internal/biz/repo"
 )
 
 var (
-	ErrResourceNotFound      = errors.New("resource not found")
-	ErrResourceAlreadyExists = errors.New("resource already exists")
+	ErrResourceNotFound      = repo.ErrResourceNotFound
+	ErrResourceAlreadyExists = repo.ErrResourceAlreadyExists
+	ErrEmailAlreadyExists    = repo.ErrEmailAlreadyExists
+	ErrInvalidCredentials    = repo.ErrInvalidCredentials
+	ErrHumanOnly             = repo.ErrHumanOnly
+	ErrServiceAccountOnly    = repo.ErrServiceAccountOnly
+	ErrInvalidOwner          = repo.ErrInvalidOwner
+	ErrExternalUser          = repo.ErrExternalUser
+	ErrInvalidToken          = repo.ErrInvalidToken
+	ErrEmailNotSet           = repo.ErrEmailNotSet
+	ErrEmailAlreadyVerified  = repo.ErrEmailAlreadyVerified
+	ErrWeakPassword          = repo.ErrWeakPassword
+	ErrInvitationNotPending  = repo.ErrInvitationNotPending
+	ErrInvalidGroup          = repo.ErrInvalidGroup
+	ErrInvalidProfile        = repo.ErrInvalidProfile
+	ErrInvalidUsername       = repo.ErrInvalidUsername
+	ErrBatchAborted          = repo.ErrBatchAborted
+	ErrInvalidImport         = repo.ErrInvalidImport
 )
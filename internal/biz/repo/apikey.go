@@ -0,0 +1,34 @@
+package repo
+
+//go:generate mockgen -destination=./mock/apikey.go -package=mock usm/internal/biz/repo APIKeyRepo
+
+import (
+	"context"
+	"time"
+)
+
+// APIKey 服务账号的访问凭证，仅保存密钥的哈希值，Prefix 用于定位密钥
+type APIKey struct {
+	ID           int
+	UserID       int
+	Name         string
+	Prefix       string
+	Hash         string
+	ExpireTime   time.Time
+	LastUsedTime time.Time
+	CreateTime   time.Time
+	UpdateTime   time.Time
+}
+
+func (k *APIKey) Expired(now time.Time) bool {
+	return !k.ExpireTime.IsZero() && now.After(k.ExpireTime)
+}
+
+type APIKeyRepo interface {
+	Create(ctx context.Context, m *APIKey) (*APIKey, error)
+	Delete(ctx context.Context, id int) error
+	Get(ctx context.Context, id int) (*APIKey, error)
+	GetByPrefix(ctx context.Context, prefix string) (*APIKey, error)
+	ListByUser(ctx context.Context, userID int) ([]*APIKey, error)
+	SetLastUsedTime(ctx context.Context, id int, t time.Time) error
+}
@@ -0,0 +1,24 @@
+package repo
+
+//go:generate mockgen -destination=./mock/authenticator.go -package=mock usm/internal/biz/repo Authenticator
+
+import (
+	"context"
+)
+
+// Identity 认证后端确认的身份
+type Identity struct {
+	// Source 认证来源，与 User.Source 对应
+	Source   string
+	Username string
+	Email    string
+}
+
+// Authenticator 用户名密码认证后端，凭证错误或用户不存在时返回 ErrInvalidCredentials
+type Authenticator interface {
+	Name() string
+	Authenticate(ctx context.Context, username, password string) (*Identity, error)
+}
+
+// ExternalAuthenticators 按配置启用的外部认证后端，按顺序尝试，本地密码认证总是第一个
+type ExternalAuthenticators []Authenticator
@@ -0,0 +1,30 @@
+package repo
+
+//go:generate mockgen -destination=./mock/email_verification.go -package=mock usm/internal/biz/repo EmailVerificationRepo
+
+import (
+	"context"
+	"time"
+)
+
+// EmailVerification 邮箱验证令牌，仅保存哈希值，使用一次后删除
+type EmailVerification struct {
+	ID     int
+	UserID int
+	// Email 发送验证邮件时的邮箱，与用户当前邮箱不一致时令牌无效
+	Email      string
+	TokenHash  string
+	ExpireTime time.Time
+	CreateTime time.Time
+}
+
+func (v *EmailVerification) Expired(now time.Time) bool {
+	return !now.Before(v.ExpireTime)
+}
+
+type EmailVerificationRepo interface {
+	Create(ctx context.Context, m *EmailVerification) (*EmailVerification, error)
+	Delete(ctx context.Context, id int) error
+	GetByHash(ctx context.Context, hash string) (*EmailVerification, error)
+	DeleteByUser(ctx context.Context, userID int) error
+}
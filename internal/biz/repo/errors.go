@@ -0,0 +1,27 @@
+package repo
+
+import (
+	"errors"
+)
+
+// 业务错误定义在 repo 中以便 usecase 引用（usecase 无法引用 biz 包），biz 包中保留同名引用
+var (
+	ErrResourceNotFound      = errors.New("resource not found")
+	ErrResourceAlreadyExists = errors.New("resource already exists")
+	ErrEmailAlreadyExists    = errors.New("email address is used by another user")
+	ErrInvalidCredentials    = errors.New("invalid credentials")
+	ErrHumanOnly             = errors.New("operation is not allowed for service accounts")
+	ErrServiceAccountOnly    = errors.New("operation is only allowed for service accounts")
+	ErrInvalidOwner          = errors.New("invalid owner")
+	ErrExternalUser          = errors.New("user is managed by an external identity source")
+	ErrInvalidToken          = errors.New("invalid or expired token")
+	ErrEmailNotSet           = errors.New("user has no email address")
+	ErrEmailAlreadyVerified  = errors.New("email address is already verified")
+	ErrWeakPassword          = errors.New("password does not satisfy the password policy")
+	ErrInvitationNotPending  = errors.New("invitation is no longer pending")
+	ErrInvalidGroup          = errors.New("invalid group")
+	ErrInvalidProfile        = errors.New("invalid user profile")
+	ErrInvalidUsername       = errors.New("invalid username")
+	ErrBatchAborted          = errors.New("batch aborted because another item failed")
+	ErrInvalidImport         = errors.New("invalid import file")
+)
@@ -0,0 +1,37 @@
+package repo
+
+//go:generate mockgen -destination=./mock/group.go -package=mock usm/internal/biz/repo GroupRepo
+
+import (
+	"context"
+	"time"
+)
+
+type Group struct {
+	ID          int
+	DisplayName string
+	// ExternalID 外部系统中的 ID，由 SCIM 客户端维护
+	ExternalID string
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+type GroupFilter struct {
+	DisplayName string
+	ExternalID  string
+}
+
+type GroupRepo interface {
+	Create(ctx context.Context, m *Group) (*Group, error)
+	Update(ctx context.Context, m *Group) (*Group, error)
+	Delete(ctx context.Context, id int) error
+	Get(ctx context.Context, id int) (*Group, error)
+	List(ctx context.Context, filter *GroupFilter, offset, limit int) ([]*Group, error)
+	Count(ctx context.Context, filter *GroupFilter) (int, error)
+
+	ListMembers(ctx context.Context, id int) ([]*User, error)
+	AddMembers(ctx context.Context, id int, userIDs []int) error
+	RemoveMembers(ctx context.Context, id int, userIDs []int) error
+	ClearMembers(ctx context.Context, id int) error
+	ListByUser(ctx context.Context, userID int) ([]*Group, error)
+}
@@ -0,0 +1,8 @@
+package repo
+
+import "context"
+
+// HealthChecker 检查依赖的资源是否可用，用于就绪检查
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
@@ -0,0 +1,61 @@
+package repo
+
+//go:generate mockgen -destination=./mock/invitation.go -package=mock usm/internal/biz/repo InvitationRepo
+
+import (
+	"context"
+	"time"
+)
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+	// InvitationStatusExpired 不保存在数据库中，仅用于查询过期未接受的邀请
+	InvitationStatusExpired InvitationStatus = "expired"
+)
+
+// Invitation 邀请用户注册，令牌仅保存哈希值
+type Invitation struct {
+	ID        int
+	Email     string
+	TokenHash string
+	// GroupIDs 接受邀请后加入的用户组
+	GroupIDs   []int
+	Status     InvitationStatus
+	ExpireTime time.Time
+	// UserID 接受邀请后创建的用户
+	UserID     int
+	AcceptTime time.Time
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+func (i *Invitation) Expired(now time.Time) bool {
+	return !now.Before(i.ExpireTime)
+}
+
+// Pending 邀请未被接受、撤销且未过期
+func (i *Invitation) Pending(now time.Time) bool {
+	return i.Status == InvitationStatusPending && !i.Expired(now)
+}
+
+type InvitationFilter struct {
+	Status InvitationStatus
+	Email  string
+}
+
+type InvitationRepo interface {
+	Create(ctx context.Context, m *Invitation) (*Invitation, error)
+	Get(ctx context.Context, id int) (*Invitation, error)
+	GetByHash(ctx context.Context, hash string) (*Invitation, error)
+	List(ctx context.Context, filter *InvitationFilter, offset, limit int) ([]*Invitation, error)
+	// Revoke 撤销待接受的邀请，邀请不是 pending 状态时返回 ErrResourceNotFound
+	Revoke(ctx context.Context, id int) error
+	// Accept 将待接受的邀请标记为已接受，邀请不是 pending 状态时返回 ErrResourceNotFound
+	Accept(ctx context.Context, id, userID int, now time.Time) error
+	// RevokeByEmail 撤销该邮箱所有待接受的邀请
+	RevokeByEmail(ctx context.Context, email string) error
+}
@@ -0,0 +1,17 @@
+package repo
+
+//go:generate mockgen -destination=./mock/mailer.go -package=mock usm/internal/biz/repo Mailer
+
+import "context"
+
+// Mail 纯文本邮件
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer 邮件发送，实现见 data 包，本地测试可以写入文件或日志
+type Mailer interface {
+	Send(ctx context.Context, m *Mail) error
+}
@@ -0,0 +1,26 @@
+package repo
+
+//go:generate mockgen -destination=./mock/metrics.go -package=mock usm/internal/biz/repo Metrics
+
+// 登录方式
+const (
+	LoginMethodBasic  = "basic"
+	LoginMethodAPIKey = "api_key"
+)
+
+// 用户的创建方式
+const (
+	UserOriginAPI        = "api"
+	UserOriginBatch      = "batch"
+	UserOriginImport     = "import"
+	UserOriginInvitation = "invitation"
+	UserOriginExternal   = "external"
+)
+
+// Metrics 业务指标，实现见 data 包
+type Metrics interface {
+	// LoginAttempted 记录一次登录，success 为 false 表示凭据错误或用户被禁用等
+	LoginAttempted(method string, success bool)
+	// UsersCreated 记录新创建的用户数
+	UsersCreated(origin string, n int)
+}
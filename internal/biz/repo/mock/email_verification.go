@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usm/internal/biz/repo (interfaces: EmailVerificationRepo)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	repo "usm/internal/biz/repo"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEmailVerificationRepo is a mock of EmailVerificationRepo interface.
+type MockEmailVerificationRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerificationRepoMockRecorder
+}
+
+// MockEmailVerificationRepoMockRecorder is the mock recorder for MockEmailVerificationRepo.
+type MockEmailVerificationRepoMockRecorder struct {
+	mock *MockEmailVerificationRepo
+}
+
+// NewMockEmailVerificationRepo creates a new mock instance.
+func NewMockEmailVerificationRepo(ctrl *gomock.Controller) *MockEmailVerificationRepo {
+	mock := &MockEmailVerificationRepo{ctrl: ctrl}
+	mock.recorder = &MockEmailVerificationRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerificationRepo) EXPECT() *MockEmailVerificationRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockEmailVerificationRepo) Create(arg0 context.Context, arg1 *repo.EmailVerification) (*repo.EmailVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*repo.EmailVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockEmailVerificationRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEmailVerificationRepo)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockEmailVerificationRepo) Delete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockEmailVerificationRepoMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEmailVerificationRepo)(nil).Delete), arg0, arg1)
+}
+
+// DeleteByUser mocks base method.
+func (m *MockEmailVerificationRepo) DeleteByUser(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUser indicates an expected call of DeleteByUser.
+func (mr *MockEmailVerificationRepoMockRecorder) DeleteByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUser", reflect.TypeOf((*MockEmailVerificationRepo)(nil).DeleteByUser), arg0, arg1)
+}
+
+// GetByHash mocks base method.
+func (m *MockEmailVerificationRepo) GetByHash(arg0 context.Context, arg1 string) (*repo.EmailVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", arg0, arg1)
+	ret0, _ := ret[0].(*repo.EmailVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MockEmailVerificationRepoMockRecorder) GetByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MockEmailVerificationRepo)(nil).GetByHash), arg0, arg1)
+}
@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usm/internal/biz/repo (interfaces: GroupRepo)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	repo "usm/internal/biz/repo"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGroupRepo is a mock of GroupRepo interface.
+type MockGroupRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupRepoMockRecorder
+}
+
+// MockGroupRepoMockRecorder is the mock recorder for MockGroupRepo.
+type MockGroupRepoMockRecorder struct {
+	mock *MockGroupRepo
+}
+
+// NewMockGroupRepo creates a new mock instance.
+func NewMockGroupRepo(ctrl *gomock.Controller) *MockGroupRepo {
+	mock := &MockGroupRepo{ctrl: ctrl}
+	mock.recorder = &MockGroupRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupRepo) EXPECT() *MockGroupRepoMockRecorder {
+	return m.recorder
+}
+
+// AddMembers mocks base method.
+func (m *MockGroupRepo) AddMembers(arg0 context.Context, arg1 int, arg2 []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMembers", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMembers indicates an expected call of AddMembers.
+func (mr *MockGroupRepoMockRecorder) AddMembers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMembers", reflect.TypeOf((*MockGroupRepo)(nil).AddMembers), arg0, arg1, arg2)
+}
+
+// ClearMembers mocks base method.
+func (m *MockGroupRepo) ClearMembers(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearMembers", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearMembers indicates an expected call of ClearMembers.
+func (mr *MockGroupRepoMockRecorder) ClearMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearMembers", reflect.TypeOf((*MockGroupRepo)(nil).ClearMembers), arg0, arg1)
+}
+
+// Count mocks base method.
+func (m *MockGroupRepo) Count(arg0 context.Context, arg1 *repo.GroupFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockGroupRepoMockRecorder) Count(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockGroupRepo)(nil).Count), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockGroupRepo) Create(arg0 context.Context, arg1 *repo.Group) (*repo.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*repo.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGroupRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGroupRepo)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockGroupRepo) Delete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGroupRepoMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGroupRepo)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockGroupRepo) Get(arg0 context.Context, arg1 int) (*repo.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*repo.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGroupRepoMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGroupRepo)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockGroupRepo) List(arg0 context.Context, arg1 *repo.GroupFilter, arg2, arg3 int) ([]*repo.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*repo.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockGroupRepoMockRecorder) List(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockGroupRepo)(nil).List), arg0, arg1, arg2, arg3)
+}
+
+// ListByUser mocks base method.
+func (m *MockGroupRepo) ListByUser(arg0 context.Context, arg1 int) ([]*repo.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", arg0, arg1)
+	ret0, _ := ret[0].([]*repo.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockGroupRepoMockRecorder) ListByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockGroupRepo)(nil).ListByUser), arg0, arg1)
+}
+
+// ListMembers mocks base method.
+func (m *MockGroupRepo) ListMembers(arg0 context.Context, arg1 int) ([]*repo.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", arg0, arg1)
+	ret0, _ := ret[0].([]*repo.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockGroupRepoMockRecorder) ListMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockGroupRepo)(nil).ListMembers), arg0, arg1)
+}
+
+// RemoveMembers mocks base method.
+func (m *MockGroupRepo) RemoveMembers(arg0 context.Context, arg1 int, arg2 []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMembers", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMembers indicates an expected call of RemoveMembers.
+func (mr *MockGroupRepoMockRecorder) RemoveMembers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMembers", reflect.TypeOf((*MockGroupRepo)(nil).RemoveMembers), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockGroupRepo) Update(arg0 context.Context, arg1 *repo.Group) (*repo.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(*repo.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockGroupRepoMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockGroupRepo)(nil).Update), arg0, arg1)
+}
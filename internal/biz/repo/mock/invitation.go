@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usm/internal/biz/repo (interfaces: InvitationRepo)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	repo "usm/internal/biz/repo"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInvitationRepo is a mock of InvitationRepo interface.
+type MockInvitationRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationRepoMockRecorder
+}
+
+// MockInvitationRepoMockRecorder is the mock recorder for MockInvitationRepo.
+type MockInvitationRepoMockRecorder struct {
+	mock *MockInvitationRepo
+}
+
+// NewMockInvitationRepo creates a new mock instance.
+func NewMockInvitationRepo(ctrl *gomock.Controller) *MockInvitationRepo {
+	mock := &MockInvitationRepo{ctrl: ctrl}
+	mock.recorder = &MockInvitationRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitationRepo) EXPECT() *MockInvitationRepoMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockInvitationRepo) Accept(arg0 context.Context, arg1, arg2 int, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockInvitationRepoMockRecorder) Accept(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockInvitationRepo)(nil).Accept), arg0, arg1, arg2, arg3)
+}
+
+// Create mocks base method.
+func (m *MockInvitationRepo) Create(arg0 context.Context, arg1 *repo.Invitation) (*repo.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*repo.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInvitationRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInvitationRepo)(nil).Create), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockInvitationRepo) Get(arg0 context.Context, arg1 int) (*repo.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*repo.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInvitationRepoMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInvitationRepo)(nil).Get), arg0, arg1)
+}
+
+// GetByHash mocks base method.
+func (m *MockInvitationRepo) GetByHash(arg0 context.Context, arg1 string) (*repo.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", arg0, arg1)
+	ret0, _ := ret[0].(*repo.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MockInvitationRepoMockRecorder) GetByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MockInvitationRepo)(nil).GetByHash), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockInvitationRepo) List(arg0 context.Context, arg1 *repo.InvitationFilter, arg2, arg3 int) ([]*repo.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*repo.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockInvitationRepoMockRecorder) List(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInvitationRepo)(nil).List), arg0, arg1, arg2, arg3)
+}
+
+// Revoke mocks base method.
+func (m *MockInvitationRepo) Revoke(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockInvitationRepoMockRecorder) Revoke(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockInvitationRepo)(nil).Revoke), arg0, arg1)
+}
+
+// RevokeByEmail mocks base method.
+func (m *MockInvitationRepo) RevokeByEmail(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByEmail", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeByEmail indicates an expected call of RevokeByEmail.
+func (mr *MockInvitationRepoMockRecorder) RevokeByEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByEmail", reflect.TypeOf((*MockInvitationRepo)(nil).RevokeByEmail), arg0, arg1)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usm/internal/biz/repo (interfaces: Metrics)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMetrics is a mock of Metrics interface.
+type MockMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricsMockRecorder
+}
+
+// MockMetricsMockRecorder is the mock recorder for MockMetrics.
+type MockMetricsMockRecorder struct {
+	mock *MockMetrics
+}
+
+// NewMockMetrics creates a new mock instance.
+func NewMockMetrics(ctrl *gomock.Controller) *MockMetrics {
+	mock := &MockMetrics{ctrl: ctrl}
+	mock.recorder = &MockMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetrics) EXPECT() *MockMetricsMockRecorder {
+	return m.recorder
+}
+
+// LoginAttempted mocks base method.
+func (m *MockMetrics) LoginAttempted(arg0 string, arg1 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LoginAttempted", arg0, arg1)
+}
+
+// LoginAttempted indicates an expected call of LoginAttempted.
+func (mr *MockMetricsMockRecorder) LoginAttempted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginAttempted", reflect.TypeOf((*MockMetrics)(nil).LoginAttempted), arg0, arg1)
+}
+
+// UsersCreated mocks base method.
+func (m *MockMetrics) UsersCreated(arg0 string, arg1 int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UsersCreated", arg0, arg1)
+}
+
+// UsersCreated indicates an expected call of UsersCreated.
+func (mr *MockMetricsMockRecorder) UsersCreated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsersCreated", reflect.TypeOf((*MockMetrics)(nil).UsersCreated), arg0, arg1)
+}
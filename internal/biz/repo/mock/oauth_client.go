@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usm/internal/biz/repo (interfaces: OAuthClientRepo)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	repo "usm/internal/biz/repo"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOAuthClientRepo is a mock of OAuthClientRepo interface.
+type MockOAuthClientRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockOAuthClientRepoMockRecorder
+}
+
+// MockOAuthClientRepoMockRecorder is the mock recorder for MockOAuthClientRepo.
+type MockOAuthClientRepoMockRecorder struct {
+	mock *MockOAuthClientRepo
+}
+
+// NewMockOAuthClientRepo creates a new mock instance.
+func NewMockOAuthClientRepo(ctrl *gomock.Controller) *MockOAuthClientRepo {
+	mock := &MockOAuthClientRepo{ctrl: ctrl}
+	mock.recorder = &MockOAuthClientRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOAuthClientRepo) EXPECT() *MockOAuthClientRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOAuthClientRepo) Create(arg0 context.Context, arg1 *repo.OAuthClient) (*repo.OAuthClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*repo.OAuthClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOAuthClientRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOAuthClientRepo)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockOAuthClientRepo) Delete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOAuthClientRepoMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOAuthClientRepo)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockOAuthClientRepo) Get(arg0 context.Context, arg1 int) (*repo.OAuthClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*repo.OAuthClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockOAuthClientRepoMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOAuthClientRepo)(nil).Get), arg0, arg1)
+}
+
+// GetByClientID mocks base method.
+func (m *MockOAuthClientRepo) GetByClientID(arg0 context.Context, arg1 string) (*repo.OAuthClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByClientID", arg0, arg1)
+	ret0, _ := ret[0].(*repo.OAuthClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByClientID indicates an expected call of GetByClientID.
+func (mr *MockOAuthClientRepoMockRecorder) GetByClientID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByClientID", reflect.TypeOf((*MockOAuthClientRepo)(nil).GetByClientID), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockOAuthClientRepo) List(arg0 context.Context, arg1, arg2 int) ([]*repo.OAuthClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*repo.OAuthClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockOAuthClientRepoMockRecorder) List(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOAuthClientRepo)(nil).List), arg0, arg1, arg2)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usm/internal/biz/repo (interfaces: OAuthCodeRepo)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	repo "usm/internal/biz/repo"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOAuthCodeRepo is a mock of OAuthCodeRepo interface.
+type MockOAuthCodeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockOAuthCodeRepoMockRecorder
+}
+
+// MockOAuthCodeRepoMockRecorder is the mock recorder for MockOAuthCodeRepo.
+type MockOAuthCodeRepoMockRecorder struct {
+	mock *MockOAuthCodeRepo
+}
+
+// NewMockOAuthCodeRepo creates a new mock instance.
+func NewMockOAuthCodeRepo(ctrl *gomock.Controller) *MockOAuthCodeRepo {
+	mock := &MockOAuthCodeRepo{ctrl: ctrl}
+	mock.recorder = &MockOAuthCodeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOAuthCodeRepo) EXPECT() *MockOAuthCodeRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOAuthCodeRepo) Create(arg0 context.Context, arg1 *repo.OAuthCode) (*repo.OAuthCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*repo.OAuthCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOAuthCodeRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOAuthCodeRepo)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockOAuthCodeRepo) Delete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOAuthCodeRepoMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOAuthCodeRepo)(nil).Delete), arg0, arg1)
+}
+
+// GetByHash mocks base method.
+func (m *MockOAuthCodeRepo) GetByHash(arg0 context.Context, arg1 string) (*repo.OAuthCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", arg0, arg1)
+	ret0, _ := ret[0].(*repo.OAuthCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MockOAuthCodeRepoMockRecorder) GetByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MockOAuthCodeRepo)(nil).GetByHash), arg0, arg1)
+}
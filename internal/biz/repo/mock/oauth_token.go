@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usm/internal/biz/repo (interfaces: OAuthTokenRepo)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	repo "usm/internal/biz/repo"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOAuthTokenRepo is a mock of OAuthTokenRepo interface.
+type MockOAuthTokenRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockOAuthTokenRepoMockRecorder
+}
+
+// MockOAuthTokenRepoMockRecorder is the mock recorder for MockOAuthTokenRepo.
+type MockOAuthTokenRepoMockRecorder struct {
+	mock *MockOAuthTokenRepo
+}
+
+// NewMockOAuthTokenRepo creates a new mock instance.
+func NewMockOAuthTokenRepo(ctrl *gomock.Controller) *MockOAuthTokenRepo {
+	mock := &MockOAuthTokenRepo{ctrl: ctrl}
+	mock.recorder = &MockOAuthTokenRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOAuthTokenRepo) EXPECT() *MockOAuthTokenRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOAuthTokenRepo) Create(arg0 context.Context, arg1 *repo.OAuthToken) (*repo.OAuthToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*repo.OAuthToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOAuthTokenRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOAuthTokenRepo)(nil).Create), arg0, arg1)
+}
+
+// GetByHash mocks base method.
+func (m *MockOAuthTokenRepo) GetByHash(arg0 context.Context, arg1 string) (*repo.OAuthToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", arg0, arg1)
+	ret0, _ := ret[0].(*repo.OAuthToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MockOAuthTokenRepoMockRecorder) GetByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MockOAuthTokenRepo)(nil).GetByHash), arg0, arg1)
+}
+
+// Revoke mocks base method.
+func (m *MockOAuthTokenRepo) Revoke(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockOAuthTokenRepoMockRecorder) Revoke(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockOAuthTokenRepo)(nil).Revoke), arg0, arg1)
+}
+
+// RevokeByUser mocks base method.
+func (m *MockOAuthTokenRepo) RevokeByUser(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeByUser indicates an expected call of RevokeByUser.
+func (mr *MockOAuthTokenRepoMockRecorder) RevokeByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByUser", reflect.TypeOf((*MockOAuthTokenRepo)(nil).RevokeByUser), arg0, arg1)
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usm/internal/biz/repo (interfaces: SigningKeyRepo)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	repo "usm/internal/biz/repo"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSigningKeyRepo is a mock of SigningKeyRepo interface.
+type MockSigningKeyRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockSigningKeyRepoMockRecorder
+}
+
+// MockSigningKeyRepoMockRecorder is the mock recorder for MockSigningKeyRepo.
+type MockSigningKeyRepoMockRecorder struct {
+	mock *MockSigningKeyRepo
+}
+
+// NewMockSigningKeyRepo creates a new mock instance.
+func NewMockSigningKeyRepo(ctrl *gomock.Controller) *MockSigningKeyRepo {
+	mock := &MockSigningKeyRepo{ctrl: ctrl}
+	mock.recorder = &MockSigningKeyRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSigningKeyRepo) EXPECT() *MockSigningKeyRepoMockRecorder {
+	return m.recorder
+}
+
+// Activate mocks base method.
+func (m *MockSigningKeyRepo) Activate(arg0 context.Context, arg1 int, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Activate indicates an expected call of Activate.
+func (mr *MockSigningKeyRepoMockRecorder) Activate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockSigningKeyRepo)(nil).Activate), arg0, arg1, arg2)
+}
+
+// Active mocks base method.
+func (m *MockSigningKeyRepo) Active(arg0 context.Context) (*repo.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Active", arg0)
+	ret0, _ := ret[0].(*repo.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Active indicates an expected call of Active.
+func (mr *MockSigningKeyRepoMockRecorder) Active(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Active", reflect.TypeOf((*MockSigningKeyRepo)(nil).Active), arg0)
+}
+
+// Create mocks base method.
+func (m *MockSigningKeyRepo) Create(arg0 context.Context, arg1 *repo.SigningKey) (*repo.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*repo.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSigningKeyRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSigningKeyRepo)(nil).Create), arg0, arg1)
+}
+
+// DeleteRetiredBefore mocks base method.
+func (m *MockSigningKeyRepo) DeleteRetiredBefore(arg0 context.Context, arg1 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetiredBefore", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetiredBefore indicates an expected call of DeleteRetiredBefore.
+func (mr *MockSigningKeyRepoMockRecorder) DeleteRetiredBefore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetiredBefore", reflect.TypeOf((*MockSigningKeyRepo)(nil).DeleteRetiredBefore), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockSigningKeyRepo) List(arg0 context.Context) ([]*repo.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0)
+	ret0, _ := ret[0].([]*repo.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSigningKeyRepoMockRecorder) List(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSigningKeyRepo)(nil).List), arg0)
+}
+
+// Lock mocks base method.
+func (m *MockSigningKeyRepo) Lock(arg0 context.Context) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", arg0)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockSigningKeyRepoMockRecorder) Lock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockSigningKeyRepo)(nil).Lock), arg0)
+}
+
+// Retire mocks base method.
+func (m *MockSigningKeyRepo) Retire(arg0 context.Context, arg1 int, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retire", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retire indicates an expected call of Retire.
+func (mr *MockSigningKeyRepoMockRecorder) Retire(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retire", reflect.TypeOf((*MockSigningKeyRepo)(nil).Retire), arg0, arg1, arg2)
+}
@@ -35,6 +35,21 @@ func (m *MockUserRepo) EXPECT() *MockUserRepoMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockUserRepo) Count(arg0 context.Context, arg1 *repo.UserFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockUserRepoMockRecorder) Count(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockUserRepo)(nil).Count), arg0, arg1)
+}
+
 // Create mocks base method.
 func (m *MockUserRepo) Create(arg0 context.Context, arg1 *repo.User) (*repo.User, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +65,21 @@ func (mr *MockUserRepoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRepo)(nil).Create), arg0, arg1)
 }
 
+// CreateBulk mocks base method.
+func (m *MockUserRepo) CreateBulk(arg0 context.Context, arg1 []*repo.User) ([]*repo.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBulk", arg0, arg1)
+	ret0, _ := ret[0].([]*repo.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBulk indicates an expected call of CreateBulk.
+func (mr *MockUserRepoMockRecorder) CreateBulk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBulk", reflect.TypeOf((*MockUserRepo)(nil).CreateBulk), arg0, arg1)
+}
+
 // Delete mocks base method.
 func (m *MockUserRepo) Delete(arg0 context.Context, arg1 int) error {
 	m.ctrl.T.Helper()
@@ -107,6 +137,36 @@ func (mr *MockUserRepoMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserRepo)(nil).Get), arg0, arg1)
 }
 
+// GetByEmail mocks base method.
+func (m *MockUserRepo) GetByEmail(arg0 context.Context, arg1 string) (*repo.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmail", arg0, arg1)
+	ret0, _ := ret[0].(*repo.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmail indicates an expected call of GetByEmail.
+func (mr *MockUserRepoMockRecorder) GetByEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockUserRepo)(nil).GetByEmail), arg0, arg1)
+}
+
+// GetByPhone mocks base method.
+func (m *MockUserRepo) GetByPhone(arg0 context.Context, arg1 string) (*repo.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPhone", arg0, arg1)
+	ret0, _ := ret[0].(*repo.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPhone indicates an expected call of GetByPhone.
+func (mr *MockUserRepoMockRecorder) GetByPhone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPhone", reflect.TypeOf((*MockUserRepo)(nil).GetByPhone), arg0, arg1)
+}
+
 // GetByUsername mocks base method.
 func (m *MockUserRepo) GetByUsername(arg0 context.Context, arg1 string) (*repo.User, error) {
 	m.ctrl.T.Helper()
@@ -123,18 +183,32 @@ func (mr *MockUserRepoMockRecorder) GetByUsername(arg0, arg1 interface{}) *gomoc
 }
 
 // List mocks base method.
-func (m *MockUserRepo) List(arg0 context.Context, arg1, arg2 int) ([]*repo.User, error) {
+func (m *MockUserRepo) List(arg0 context.Context, arg1 *repo.UserFilter, arg2, arg3 int) ([]*repo.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]*repo.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockUserRepoMockRecorder) List(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockUserRepoMockRecorder) List(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepo)(nil).List), arg0, arg1, arg2, arg3)
+}
+
+// SetEmailVerified mocks base method.
+func (m *MockUserRepo) SetEmailVerified(arg0 context.Context, arg1 int, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEmailVerified", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEmailVerified indicates an expected call of SetEmailVerified.
+func (mr *MockUserRepoMockRecorder) SetEmailVerified(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepo)(nil).List), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmailVerified", reflect.TypeOf((*MockUserRepo)(nil).SetEmailVerified), arg0, arg1, arg2)
 }
 
 // SetPassword mocks base method.
@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// CanonicalIdentifier 返回用户名、邮箱保存时使用的形式：去掉首尾空白并做 NFKC 规范化，保留大小写
+func CanonicalIdentifier(s string) string {
+	return norm.NFKC.String(strings.TrimSpace(s))
+}
+
+// IdentifierKey 返回用户名、邮箱比较时使用的键：NFKC 规范化后做大小写折叠，
+// 折叠可能产生非 NFKC 形式的字符，因此再做一次 NFKC。"Alice"、"ALICE" 和全角的 "Ａｌｉｃｅ" 得到相同的键
+func IdentifierKey(s string) string {
+	// cases.Caser 有状态，不能在 goroutine 间共享
+	return norm.NFKC.String(cases.Fold().String(CanonicalIdentifier(s)))
+}
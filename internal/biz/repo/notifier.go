@@ -0,0 +1,16 @@
+package repo
+
+//go:generate mockgen -destination=./mock/notifier.go -package=mock usm/internal/biz/repo Notifier
+
+import "context"
+
+// Notification 发送给用户的通知
+type Notification struct {
+	Subject string
+	Body    string
+}
+
+// Notifier 通知用户，默认实现通过邮件发送，也可以替换为短信等渠道
+type Notifier interface {
+	Notify(ctx context.Context, u *User, n *Notification) error
+}
@@ -0,0 +1,64 @@
+package repo
+
+//go:generate mockgen -destination=./mock/oauth_client.go -package=mock usm/internal/biz/repo OAuthClientRepo
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeClientCredentials = "client_credentials"
+	GrantTypeRefreshToken      = "refresh_token"
+)
+
+// OAuthClient 接入 USM 的第三方应用，Public 客户端没有密钥且必须使用 PKCE，
+// client_credentials 模式签发的令牌以 ServiceAccountID 对应的服务账号为主体
+type OAuthClient struct {
+	ID               int
+	ClientID         string
+	SecretHash       string
+	Name             string
+	RedirectURIs     []string
+	GrantTypes       []string
+	Scopes           []string
+	Public           bool
+	ServiceAccountID int
+	CreateTime       time.Time
+	UpdateTime       time.Time
+}
+
+func (c *OAuthClient) AllowGrantType(grantType string) bool {
+	return contains(c.GrantTypes, grantType)
+}
+
+func (c *OAuthClient) AllowRedirectURI(uri string) bool {
+	return contains(c.RedirectURIs, uri)
+}
+
+func (c *OAuthClient) AllowScopes(scopes []string) bool {
+	for _, s := range scopes {
+		if !contains(c.Scopes, s) {
+			return false
+		}
+	}
+	return true
+}
+
+type OAuthClientRepo interface {
+	Create(ctx context.Context, m *OAuthClient) (*OAuthClient, error)
+	Delete(ctx context.Context, id int) error
+	Get(ctx context.Context, id int) (*OAuthClient, error)
+	GetByClientID(ctx context.Context, clientID string) (*OAuthClient, error)
+	List(ctx context.Context, offset, limit int) ([]*OAuthClient, error)
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package repo
+
+//go:generate mockgen -destination=./mock/oauth_code.go -package=mock usm/internal/biz/repo OAuthCodeRepo
+
+import (
+	"context"
+	"time"
+)
+
+// OAuthCode 授权码，仅保存哈希值，使用一次后删除
+type OAuthCode struct {
+	ID                  int
+	CodeHash            string
+	ClientID            string
+	UserID              int
+	RedirectURI         string
+	Scopes              []string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Nonce               string
+	ExpireTime          time.Time
+	CreateTime          time.Time
+}
+
+type OAuthCodeRepo interface {
+	Create(ctx context.Context, m *OAuthCode) (*OAuthCode, error)
+	// Delete 授权码不存在或已被删除时返回 ErrResourceNotFound
+	Delete(ctx context.Context, id int) error
+	GetByHash(ctx context.Context, hash string) (*OAuthCode, error)
+}
@@ -0,0 +1,41 @@
+package repo
+
+//go:generate mockgen -destination=./mock/oauth_token.go -package=mock usm/internal/biz/repo OAuthTokenRepo
+
+import (
+	"context"
+	"time"
+)
+
+type TokenKind string
+
+const (
+	TokenKindAccess  TokenKind = "access"
+	TokenKindRefresh TokenKind = "refresh"
+)
+
+// OAuthToken 已签发的令牌记录，用于令牌自省和吊销，仅保存令牌的哈希值
+type OAuthToken struct {
+	ID         int
+	TokenHash  string
+	Kind       TokenKind
+	ClientID   string
+	UserID     int
+	Scopes     []string
+	ExpireTime time.Time
+	Revoked    bool
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+func (t *OAuthToken) Active(now time.Time) bool {
+	return !t.Revoked && now.Before(t.ExpireTime)
+}
+
+type OAuthTokenRepo interface {
+	Create(ctx context.Context, m *OAuthToken) (*OAuthToken, error)
+	GetByHash(ctx context.Context, hash string) (*OAuthToken, error)
+	// Revoke 吊销未吊销的令牌，令牌不存在或已被吊销时返回 ErrResourceNotFound
+	Revoke(ctx context.Context, id int) error
+	RevokeByUser(ctx context.Context, userID int) error
+}
@@ -0,0 +1,27 @@
+package repo
+
+//go:generate mockgen -destination=./mock/password_reset.go -package=mock usm/internal/biz/repo PasswordResetRepo
+
+import (
+	"context"
+	"time"
+)
+
+// PasswordReset 密码重置令牌，仅保存哈希值，使用一次后删除
+type PasswordReset struct {
+	ID         int
+	UserID     int
+	TokenHash  string
+	ExpireTime time.Time
+	CreateTime time.Time
+}
+
+func (r *PasswordReset) Expired(now time.Time) bool {
+	return !now.Before(r.ExpireTime)
+}
+
+type PasswordResetRepo interface {
+	Create(ctx context.Context, m *PasswordReset) (*PasswordReset, error)
+	GetByHash(ctx context.Context, hash string) (*PasswordReset, error)
+	DeleteByUser(ctx context.Context, userID int) error
+}
@@ -0,0 +1,45 @@
+package repo
+
+//go:generate mockgen -destination=./mock/signing_key.go -package=mock usm/internal/biz/repo SigningKeyRepo
+
+import (
+	"context"
+	"crypto/rsa"
+	"time"
+)
+
+// SigningKeyStatus 签名密钥的生命周期：pending 已在 JWKS 发布但尚未用于签名，
+// active 为当前签名密钥，retired 不再签名但在重叠窗口内仍可用于验证
+type SigningKeyStatus string
+
+const (
+	SigningKeyStatusPending SigningKeyStatus = "pending"
+	SigningKeyStatusActive  SigningKeyStatus = "active"
+	SigningKeyStatusRetired SigningKeyStatus = "retired"
+)
+
+// SigningKey 令牌签名密钥，KID 写入 JWT 头部用于选择验证公钥
+type SigningKey struct {
+	ID           int
+	KID          string
+	Algorithm    string
+	PrivateKey   *rsa.PrivateKey
+	Status       SigningKeyStatus
+	ActivateTime time.Time
+	RetireTime   time.Time
+	CreateTime   time.Time
+}
+
+type SigningKeyRepo interface {
+	Create(ctx context.Context, m *SigningKey) (*SigningKey, error)
+	// Active 返回当前用于签名的密钥，没有时返回 ErrResourceNotFound
+	Active(ctx context.Context) (*SigningKey, error)
+	// List 返回所有未删除的密钥，即可用于验证签名的密钥
+	List(ctx context.Context) ([]*SigningKey, error)
+	Activate(ctx context.Context, id int, now time.Time) error
+	Retire(ctx context.Context, id int, now time.Time) error
+	// DeleteRetiredBefore 删除在 t 之前退役的密钥
+	DeleteRetiredBefore(ctx context.Context, t time.Time) (int, error)
+	// Lock 获取多个实例之间互斥的密钥维护锁，返回释放锁的函数，在事务开始前调用并在事务结束后释放
+	Lock(ctx context.Context) (unlock func(), err error)
+}
@@ -7,28 +7,95 @@ import (
 	"time"
 )
 
+type UserKind string
+
+const (
+	UserKindHuman   UserKind = "human"
+	UserKindService UserKind = "service"
+)
+
+const UserSourceLocal = "local"
+
+type OwnerKind string
+
+const (
+	OwnerKindUser  OwnerKind = "user"
+	OwnerKindGroup OwnerKind = "group"
+)
+
 type User struct {
 	ID         int
 	Disabled   bool
 	CreateTime time.Time
 	UpdateTime time.Time
 
-	// TODO: 补充自定义字段
 	Username string
 	Email    string
 	Password string
+	// EmailVerified 邮箱是否已验证，修改邮箱后重置
+	EmailVerified bool
+
+	// Kind 区分人类用户与服务账号，服务账号必须有 Owner
+	Kind      UserKind
+	OwnerKind OwnerKind
+	OwnerID   int
+
+	// Source 认证来源，外部认证源的用户由首次登录时自动创建，不保存本地密码
+	Source string
+	// ExternalID 外部系统中的 ID，由 SCIM 客户端维护
+	ExternalID string
+
+	// 用户资料
+	DisplayName string
+	// Phone E.164 格式，例如 +8613800000000
+	Phone     string
+	AvatarURL string
+	// Locale BCP 47 语言标签，例如 zh-CN
+	Locale string
+	// Timezone IANA 时区名，例如 Asia/Shanghai
+	Timezone string
+	// Attributes 自定义属性，值的类型为 string、float64 或 bool
+	Attributes map[string]interface{}
+}
+
+func (u *User) IsExternal() bool {
+	return u.Source != "" && u.Source != UserSourceLocal
+}
+
+func (u *User) IsServiceAccount() bool {
+	return u.Kind == UserKindService
+}
+
+type UserFilter struct {
+	IDs        []int
+	Kind       UserKind
+	Username   string
+	Email      string
+	ExternalID string
+	// Attributes 按自定义属性精确匹配
+	Attributes map[string]interface{}
+	// AfterID 只返回 ID 大于 AfterID 的用户，用于按 ID 顺序翻页
+	AfterID int
 }
 
 type UserRepo interface {
 	Create(ctx context.Context, m *User) (*User, error)
+	// CreateBulk 使用一条语句批量创建，任一用户冲突时全部失败
+	CreateBulk(ctx context.Context, ms []*User) ([]*User, error)
 	Update(ctx context.Context, m *User) (*User, error)
 	Delete(ctx context.Context, id int) error
 	Get(ctx context.Context, id int) (*User, error)
 	Enable(ctx context.Context, id int) error
 	Disable(ctx context.Context, id int) error
-	List(ctx context.Context, offset, limit int) ([]*User, error)
+	List(ctx context.Context, filter *UserFilter, offset, limit int) ([]*User, error)
+	Count(ctx context.Context, filter *UserFilter) (int, error)
 
 	// TODO: 补充自定义方法
 	SetPassword(ctx context.Context, id int, password string) error
 	GetByUsername(ctx context.Context, username string) (*User, error)
+	// GetByEmail 邮箱全局唯一，比较时忽略大小写
+	GetByEmail(ctx context.Context, email string) (*User, error)
+	// GetByPhone 手机号不唯一，多个用户使用同一手机号时返回 ErrResourceNotFound
+	GetByPhone(ctx context.Context, phone string) (*User, error)
+	SetEmailVerified(ctx context.Context, id int, verified bool) error
 }
@@ -0,0 +1,136 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"usm/internal/biz/repo"
+)
+
+// API Key 格式为 usm_<prefix>_<secret>，prefix 明文保存用于查找，secret 仅保存哈希
+const (
+	apiKeyScheme = "usm"
+	// prefix 的随机字节数，prefix 唯一，冲突时重新生成
+	apiKeyPrefixLen     = 8
+	apiKeyCreateRetries = 3
+)
+
+func (uc *Usecase) CreateAPIKey(ctx context.Context, userID int, name string, ttl time.Duration) (*repo.APIKey, string, error) {
+	ctx, span := tracer.Start(ctx, "account.CreateAPIKey")
+	defer span.End()
+	u, err := uc.userRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if !u.IsServiceAccount() {
+		return nil, "", repo.ErrServiceAccountOnly
+	}
+	secret, err := randomString(32)
+	if err != nil {
+		return nil, "", err
+	}
+	var expireTime time.Time
+	if ttl > 0 {
+		expireTime = time.Now().Add(ttl)
+	}
+	for i := 0; ; i++ {
+		prefix, err := randomString(apiKeyPrefixLen)
+		if err != nil {
+			return nil, "", err
+		}
+		key, err := uc.apiKeyRepo.Create(ctx, &repo.APIKey{
+			UserID:     userID,
+			Name:       name,
+			Prefix:     prefix,
+			Hash:       hashSecret(secret),
+			ExpireTime: expireTime,
+		})
+		if err == repo.ErrResourceAlreadyExists && i+1 < apiKeyCreateRetries {
+			continue
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return key, strings.Join([]string{apiKeyScheme, prefix, secret}, "_"), nil
+	}
+}
+
+func (uc *Usecase) ListAPIKeys(ctx context.Context, userID int) ([]*repo.APIKey, error) {
+	ctx, span := tracer.Start(ctx, "account.ListAPIKeys")
+	defer span.End()
+	if _, err := uc.userRepo.Get(ctx, userID); err != nil {
+		return nil, err
+	}
+	return uc.apiKeyRepo.ListByUser(ctx, userID)
+}
+
+func (uc *Usecase) DeleteAPIKey(ctx context.Context, userID, id int) error {
+	ctx, span := tracer.Start(ctx, "account.DeleteAPIKey")
+	defer span.End()
+	return uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		key, err := uc.apiKeyRepo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if key.UserID != userID {
+			return repo.ErrResourceNotFound
+		}
+		return uc.apiKeyRepo.Delete(ctx, id)
+	})
+}
+
+// AuthenticateAPIKey 校验 API Key 并返回其所属的服务账号
+func (uc *Usecase) AuthenticateAPIKey(ctx context.Context, rawKey string) (*repo.User, error) {
+	ctx, span := tracer.Start(ctx, "account.AuthenticateAPIKey")
+	defer span.End()
+	u, err := uc.authenticateAPIKey(ctx, rawKey)
+	uc.loginAttempted(repo.LoginMethodAPIKey, err)
+	return u, err
+}
+
+func (uc *Usecase) authenticateAPIKey(ctx context.Context, rawKey string) (*repo.User, error) {
+	parts := strings.SplitN(rawKey, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyScheme {
+		return nil, repo.ErrInvalidCredentials
+	}
+	key, err := uc.apiKeyRepo.GetByPrefix(ctx, parts[1])
+	if err != nil {
+		if err == repo.ErrResourceNotFound {
+			return nil, repo.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+	now := time.Now()
+	if subtle.ConstantTimeCompare([]byte(key.Hash), []byte(hashSecret(parts[2]))) != 1 || key.Expired(now) {
+		return nil, repo.ErrInvalidCredentials
+	}
+	u, err := uc.userRepo.Get(ctx, key.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if u.Disabled || !u.IsServiceAccount() {
+		return nil, repo.ErrInvalidCredentials
+	}
+	if err := uc.apiKeyRepo.SetLastUsedTime(ctx, key.ID, now); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,267 @@
+package account
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"usm/internal/biz/repo"
+	"usm/internal/biz/repo/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var testServiceAccount = &repo.User{
+	ID:        2,
+	Username:  "robot",
+	Kind:      repo.UserKindService,
+	OwnerKind: repo.OwnerKindUser,
+	OwnerID:   1,
+}
+
+func newTestAPIKeyUsecase(ctrl *gomock.Controller, keys map[string]*repo.APIKey) *Usecase {
+	mockTran := mock.NewMockTransaction(ctrl)
+	mockTran.EXPECT().WithTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockUserRepo := mock.NewMockUserRepo(ctrl)
+	mockUserRepo.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) (*repo.User, error) {
+		if id == testServiceAccount.ID {
+			return testServiceAccount, nil
+		}
+		store, ok := testUserStore[id]
+		if !ok {
+			return nil, repo.ErrResourceNotFound
+		}
+		return store, nil
+	})
+	mockKeyRepo := mock.NewMockAPIKeyRepo(ctrl)
+	mockKeyRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, k *repo.APIKey) (*repo.APIKey, error) {
+		k.ID = len(keys) + 1
+		keys[k.Prefix] = k
+		return k, nil
+	})
+	mockKeyRepo.EXPECT().GetByPrefix(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, prefix string) (*repo.APIKey, error) {
+		k, ok := keys[prefix]
+		if !ok {
+			return nil, repo.ErrResourceNotFound
+		}
+		return k, nil
+	})
+	mockKeyRepo.EXPECT().SetLastUsedTime(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+	return &Usecase{
+		tran:       mockTran,
+		userRepo:   mockUserRepo,
+		apiKeyRepo: mockKeyRepo,
+	}
+}
+
+func TestUsecase_CreateAPIKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	type args struct {
+		userID int
+		ttl    time.Duration
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "should create api key successfully",
+			args: args{
+				userID: 2,
+				ttl:    time.Hour,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should create api key failed if user is human",
+			args: args{
+				userID: 1,
+			},
+			wantErr: repo.ErrServiceAccountOnly,
+		},
+		{
+			name: "should create api key failed if user not found",
+			args: args{
+				userID: 3,
+			},
+			wantErr: repo.ErrResourceNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newTestAPIKeyUsecase(ctrl, map[string]*repo.APIKey{})
+			got, key, err := uc.CreateAPIKey(ctx, tt.args.userID, "ci", tt.args.ttl)
+			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.True(t, strings.HasPrefix(key, "usm_"+got.Prefix+"_"), "unexpected key format: %s", key)
+			assert.NotContains(t, got.Hash, strings.TrimPrefix(key, "usm_"+got.Prefix+"_"), "secret should not be stored in plain text")
+			assert.False(t, got.ExpireTime.IsZero(), "expire time should be set")
+		})
+	}
+}
+
+func TestUsecase_CreateAPIKey_PrefixCollision(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockUserRepo := mock.NewMockUserRepo(ctrl)
+	mockUserRepo.EXPECT().Get(gomock.Any(), testServiceAccount.ID).AnyTimes().Return(testServiceAccount, nil)
+	tests := []struct {
+		name       string
+		collisions int
+		wantErr    error
+	}{
+		{name: "should retry on prefix collision", collisions: apiKeyCreateRetries - 1},
+		{name: "should create api key failed if prefix keeps colliding", collisions: apiKeyCreateRetries, wantErr: repo.ErrResourceAlreadyExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var prefixes []string
+			mockKeyRepo := mock.NewMockAPIKeyRepo(ctrl)
+			mockKeyRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(apiKeyCreateRetries).DoAndReturn(func(ctx context.Context, k *repo.APIKey) (*repo.APIKey, error) {
+				prefixes = append(prefixes, k.Prefix)
+				if len(prefixes) <= tt.collisions {
+					return nil, repo.ErrResourceAlreadyExists
+				}
+				return k, nil
+			})
+			uc := &Usecase{userRepo: mockUserRepo, apiKeyRepo: mockKeyRepo}
+			got, key, err := uc.CreateAPIKey(ctx, testServiceAccount.ID, "ci", 0)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Len(t, prefixes, apiKeyCreateRetries)
+			assert.NotEqual(t, prefixes[0], prefixes[1], "prefix should be regenerated")
+			if tt.wantErr == nil {
+				assert.Equal(t, prefixes[len(prefixes)-1], got.Prefix)
+				assert.True(t, strings.HasPrefix(key, "usm_"+got.Prefix+"_"))
+			}
+		})
+	}
+}
+
+func TestUsecase_AuthenticateAPIKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	keys := map[string]*repo.APIKey{}
+	uc := newTestAPIKeyUsecase(ctrl, keys)
+	_, validKey, err := uc.CreateAPIKey(ctx, testServiceAccount.ID, "ci", 0)
+	assert.NoError(t, err)
+	expired, expiredKey, err := uc.CreateAPIKey(ctx, testServiceAccount.ID, "expired", time.Hour)
+	assert.NoError(t, err)
+	expired.ExpireTime = time.Now().Add(-time.Minute)
+	tests := []struct {
+		name    string
+		key     string
+		want    *repo.User
+		wantErr error
+	}{
+		{
+			name:    "should authenticate successfully",
+			key:     validKey,
+			want:    testServiceAccount,
+			wantErr: nil,
+		},
+		{
+			name:    "should authenticate failed if secret mismatch",
+			key:     validKey[:len(validKey)-1] + "x",
+			wantErr: repo.ErrInvalidCredentials,
+		},
+		{
+			name:    "should authenticate failed if key expired",
+			key:     expiredKey,
+			wantErr: repo.ErrInvalidCredentials,
+		},
+		{
+			name:    "should authenticate failed if key is malformed",
+			key:     "malformed",
+			wantErr: repo.ErrInvalidCredentials,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uc.AuthenticateAPIKey(ctx, tt.key)
+			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
+			assert.Equal(t, tt.want, got, "mismatch: got=%v, want=%v", got, tt.want)
+		})
+	}
+}
+
+func TestUsecase_AuthenticateBasic(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockTran := mock.NewMockTransaction(ctrl)
+	mockRepo := mock.NewMockUserRepo(ctrl)
+	mockRepo.EXPECT().GetByUsername(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, username string) (*repo.User, error) {
+		switch username {
+		case "liubo":
+			return &repo.User{ID: 1, Username: "liubo", Password: "Admin@169+-", Kind: repo.UserKindHuman}, nil
+		case "robot":
+			return testServiceAccount, nil
+		case "disabled":
+			return &repo.User{ID: 4, Username: "disabled", Password: "Admin@169+-", Kind: repo.UserKindHuman, Disabled: true}, nil
+		case "legacy-robot":
+			return &repo.User{ID: 3, Username: "legacy-robot", Password: "Robot@169+-", Kind: repo.UserKindService}, nil
+		}
+		return nil, repo.ErrResourceNotFound
+	})
+	type args struct {
+		username string
+		password string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name:    "should authenticate successfully",
+			args:    args{username: "liubo", password: "Admin@169+-"},
+			wantErr: nil,
+		},
+		{
+			name:    "should authenticate failed if password mismatch",
+			args:    args{username: "liubo", password: "wrong"},
+			wantErr: repo.ErrInvalidCredentials,
+		},
+		{
+			name:    "should authenticate failed if user not found",
+			args:    args{username: "nobody", password: "Admin@169+-"},
+			wantErr: repo.ErrInvalidCredentials,
+		},
+		{
+			name:    "should authenticate failed if user is disabled",
+			args:    args{username: "disabled", password: "Admin@169+-"},
+			wantErr: repo.ErrInvalidCredentials,
+		},
+		{
+			name:    "should not reveal service account without valid password",
+			args:    args{username: "robot", password: "guess"},
+			wantErr: repo.ErrInvalidCredentials,
+		},
+		{
+			name:    "should authenticate failed if user is a service account",
+			args:    args{username: "legacy-robot", password: "Robot@169+-"},
+			wantErr: repo.ErrHumanOnly,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMetrics := mock.NewMockMetrics(ctrl)
+			mockMetrics.EXPECT().LoginAttempted(repo.LoginMethodBasic, tt.wantErr == nil)
+			uc := &Usecase{
+				tran:           mockTran,
+				userRepo:       mockRepo,
+				authenticators: []repo.Authenticator{&passwordAuthenticator{userRepo: mockRepo}},
+				metrics:        mockMetrics,
+			}
+			_, err := uc.AuthenticateBasic(ctx, tt.args.username, tt.args.password)
+			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
+		})
+	}
+}
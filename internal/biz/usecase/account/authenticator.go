@@ -0,0 +1,45 @@
+package account
+
+import (
+	"context"
+
+	"usm/internal/biz/repo"
+)
+
+var _ repo.Authenticator = (*passwordAuthenticator)(nil)
+
+// passwordAuthenticator 使用本地数据库中的密码认证，username 可以是 identifiers 中配置的任一登录标识
+type passwordAuthenticator struct {
+	userRepo    repo.UserRepo
+	identifiers []loginIdentifier
+}
+
+func (a *passwordAuthenticator) Name() string {
+	return repo.UserSourceLocal
+}
+
+func (a *passwordAuthenticator) Authenticate(ctx context.Context, username, password string) (*repo.Identity, error) {
+	u, err := findUserByIdentifier(ctx, a.userRepo, a.identifiers, username)
+	if err != nil {
+		if err == repo.ErrResourceNotFound {
+			return nil, repo.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+	// 外部用户没有本地密码，交给后续认证后端处理
+	if u.IsExternal() {
+		return nil, repo.ErrInvalidCredentials
+	}
+	// 先校验密码，避免未持有凭据的调用方探测服务账号的用户名
+	if !checkPassword(u.Password, password) {
+		return nil, repo.ErrInvalidCredentials
+	}
+	if u.IsServiceAccount() {
+		return nil, repo.ErrHumanOnly
+	}
+	return &repo.Identity{
+		Source:   repo.UserSourceLocal,
+		Username: u.Username,
+		Email:    u.Email,
+	}, nil
+}
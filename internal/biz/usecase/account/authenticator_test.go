@@ -0,0 +1,96 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"usm/internal/biz/repo"
+	"usm/internal/biz/repo/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsecase_AuthenticateBasicExternal(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	users := map[string]*repo.User{
+		"liubo": {ID: 1, Username: "liubo", Password: "Admin@169+-", Kind: repo.UserKindHuman, Source: repo.UserSourceLocal},
+		"alice": {ID: 2, Username: "alice", Email: "alice@old.example.com", Kind: repo.UserKindHuman, Source: "ldap"},
+	}
+	mockRepo := mock.NewMockUserRepo(ctrl)
+	mockRepo.EXPECT().GetByUsername(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, username string) (*repo.User, error) {
+		u, ok := users[username]
+		if !ok {
+			return nil, repo.ErrResourceNotFound
+		}
+		return u, nil
+	})
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, u *repo.User) (*repo.User, error) {
+		u.ID = len(users) + 1
+		users[u.Username] = u
+		return u, nil
+	})
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, u *repo.User) (*repo.User, error) {
+		return u, nil
+	})
+	mockLDAP := mock.NewMockAuthenticator(ctrl)
+	mockLDAP.EXPECT().Authenticate(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, username, password string) (*repo.Identity, error) {
+		if password != "directory" {
+			return nil, repo.ErrInvalidCredentials
+		}
+		return &repo.Identity{Source: "ldap", Username: username, Email: username + "@example.com"}, nil
+	})
+	uc := &Usecase{
+		userRepo:       mockRepo,
+		authenticators: []repo.Authenticator{&passwordAuthenticator{userRepo: mockRepo}, mockLDAP},
+	}
+	type args struct {
+		username string
+		password string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *repo.User
+		wantErr error
+	}{
+		{
+			name: "should authenticate local user with local password",
+			args: args{username: "liubo", password: "Admin@169+-"},
+			want: users["liubo"],
+		},
+		{
+			name:    "should not authenticate local user with directory password",
+			args:    args{username: "liubo", password: "directory"},
+			wantErr: repo.ErrInvalidCredentials,
+		},
+		{
+			name: "should sync email of existing directory user",
+			args: args{username: "alice", password: "directory"},
+			want: &repo.User{ID: 2, Username: "alice", Email: "alice@example.com", Kind: repo.UserKindHuman, Source: "ldap"},
+		},
+		{
+			name:    "should not authenticate directory user with empty local password",
+			args:    args{username: "alice", password: ""},
+			wantErr: repo.ErrInvalidCredentials,
+		},
+		{
+			name: "should provision directory user on first login",
+			args: args{username: "bob", password: "directory"},
+			want: &repo.User{ID: 3, Username: "bob", Email: "bob@example.com", Kind: repo.UserKindHuman, Source: "ldap"},
+		},
+		{
+			name:    "should authenticate failed if no backend accepts",
+			args:    args{username: "carol", password: "wrong"},
+			wantErr: repo.ErrInvalidCredentials,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uc.AuthenticateBasic(ctx, tt.args.username, tt.args.password)
+			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
+			assert.Equal(t, tt.want, got, "mismatch: got=%v, want=%v", got, tt.want)
+		})
+	}
+}
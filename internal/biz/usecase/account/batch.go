@@ -0,0 +1,218 @@
+package account
+
+import (
+	"context"
+
+	"usm/internal/biz/repo"
+)
+
+// BatchResult 批量操作中单个用户的结果，Err 不为空表示该用户失败
+type BatchResult struct {
+	User *repo.User
+	Err  error
+}
+
+// UserUpdate 批量更新中的单个用户，Paths 的含义与 UpdateUser 相同
+type UserUpdate struct {
+	User  *repo.User
+	Paths []string
+}
+
+// BatchCreateUsers 批量创建用户，结果与 users 一一对应。
+// atomic 为 true 时任一用户失败则都不创建，其余用户的错误为 ErrBatchAborted；否则只创建校验通过且不冲突的用户
+func (uc *Usecase) BatchCreateUsers(ctx context.Context, users []*repo.User, atomic bool) ([]*BatchResult, error) {
+	ctx, span := tracer.Start(ctx, "account.BatchCreateUsers")
+	defer span.End()
+	results, err := uc.batchCreateUsers(ctx, users, atomic, uc.prepareUser)
+	if err != nil {
+		return nil, err
+	}
+	uc.usersCreated(repo.UserOriginBatch, createdCount(results))
+	return results, nil
+}
+
+func (uc *Usecase) batchCreateUsers(ctx context.Context, users []*repo.User, atomic bool,
+	prepare func(ctx context.Context, u *repo.User) error) ([]*BatchResult, error) {
+	results := make([]*BatchResult, len(users))
+	usernames := make(map[string]bool, len(users))
+	emails := make(map[string]bool, len(users))
+	var valid []int
+	for i, u := range users {
+		results[i] = &BatchResult{}
+		if err := prepare(ctx, u); err != nil {
+			results[i].Err = err
+			continue
+		}
+		// 批次内重复的用户名和邮箱，第一个之后的都视为冲突
+		username, email := repo.IdentifierKey(u.Username), repo.IdentifierKey(u.Email)
+		if usernames[username] || (email != "" && emails[email]) {
+			results[i].Err = repo.ErrResourceAlreadyExists
+			continue
+		}
+		usernames[username] = true
+		if email != "" {
+			emails[email] = true
+		}
+		valid = append(valid, i)
+	}
+	if atomic && len(valid) < len(users) {
+		abortBatch(results)
+		return results, nil
+	}
+	var created []*repo.User
+	create := func(ctx context.Context) (err error) {
+		created, err = uc.userRepo.CreateBulk(ctx, pick(users, valid))
+		return err
+	}
+	var err error
+	if atomic {
+		err = uc.tran.WithTx(ctx, create)
+	} else {
+		err = create(ctx)
+	}
+	switch {
+	case err == nil:
+		for j, i := range valid {
+			results[i].User = created[j]
+		}
+	case err != repo.ErrResourceAlreadyExists:
+		return nil, err
+	case atomic:
+		// 与已有用户冲突，找出冲突的用户，找不到时（并发创建）所有用户都视为冲突
+		conflicted := false
+		for _, i := range valid {
+			exists, err := uc.userExists(ctx, users[i])
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				results[i].Err = repo.ErrResourceAlreadyExists
+				conflicted = true
+			}
+		}
+		if !conflicted {
+			for _, i := range valid {
+				results[i].Err = repo.ErrResourceAlreadyExists
+			}
+		}
+		abortBatch(results)
+	default:
+		// 部分成功模式下逐个创建以确定冲突的用户
+		for _, i := range valid {
+			results[i].User, results[i].Err = uc.userRepo.Create(ctx, users[i])
+		}
+	}
+	return results, nil
+}
+
+// BatchGetUsers 批量查询用户，不存在的用户错误为 ErrResourceNotFound
+func (uc *Usecase) BatchGetUsers(ctx context.Context, ids []int) ([]*BatchResult, error) {
+	ctx, span := tracer.Start(ctx, "account.BatchGetUsers")
+	defer span.End()
+	us, err := uc.userRepo.List(ctx, &repo.UserFilter{IDs: ids}, 0, len(ids))
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*repo.User, len(us))
+	for _, u := range us {
+		byID[u.ID] = u
+	}
+	results := make([]*BatchResult, len(ids))
+	for i, id := range ids {
+		if u, ok := byID[id]; ok {
+			results[i] = &BatchResult{User: u}
+		} else {
+			results[i] = &BatchResult{Err: repo.ErrResourceNotFound}
+		}
+	}
+	return results, nil
+}
+
+// BatchUpdateUsers 批量更新用户，atomic 的含义与 BatchCreateUsers 相同
+func (uc *Usecase) BatchUpdateUsers(ctx context.Context, updates []*UserUpdate, atomic bool) ([]*BatchResult, error) {
+	ctx, span := tracer.Start(ctx, "account.BatchUpdateUsers")
+	defer span.End()
+	return uc.runBatch(ctx, len(updates), atomic, func(ctx context.Context, i int) (*repo.User, error) {
+		return uc.UpdateUser(ctx, updates[i].User, updates[i].Paths)
+	})
+}
+
+// BatchDeleteUsers 批量删除用户，atomic 的含义与 BatchCreateUsers 相同
+func (uc *Usecase) BatchDeleteUsers(ctx context.Context, ids []int, atomic bool) ([]*BatchResult, error) {
+	ctx, span := tracer.Start(ctx, "account.BatchDeleteUsers")
+	defer span.End()
+	return uc.runBatch(ctx, len(ids), atomic, func(ctx context.Context, i int) (*repo.User, error) {
+		return nil, uc.DeleteUser(ctx, ids[i])
+	})
+}
+
+// runBatch 依次执行 fn，atomic 为 true 时在同一事务中执行并在第一个失败处回滚
+func (uc *Usecase) runBatch(ctx context.Context, n int, atomic bool, fn func(ctx context.Context, i int) (*repo.User, error)) ([]*BatchResult, error) {
+	results := make([]*BatchResult, n)
+	for i := range results {
+		results[i] = &BatchResult{}
+	}
+	if !atomic {
+		for i := range results {
+			results[i].User, results[i].Err = fn(ctx, i)
+		}
+		return results, nil
+	}
+	failed := -1
+	err := uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		for i := range results {
+			u, err := fn(ctx, i)
+			if err != nil {
+				failed = i
+				return err
+			}
+			results[i].User = u
+		}
+		return nil
+	})
+	if err != nil {
+		if failed < 0 {
+			return nil, err
+		}
+		results[failed].Err = err
+		abortBatch(results)
+	}
+	return results, nil
+}
+
+// abortBatch 整批回滚，没有错误的用户标记为 ErrBatchAborted
+func abortBatch(results []*BatchResult) {
+	for _, r := range results {
+		r.User = nil
+		if r.Err == nil {
+			r.Err = repo.ErrBatchAborted
+		}
+	}
+}
+
+func (uc *Usecase) userExists(ctx context.Context, u *repo.User) (bool, error) {
+	_, err := uc.userRepo.GetByUsername(ctx, u.Username)
+	if err == nil {
+		return true, nil
+	}
+	if err != repo.ErrResourceNotFound || u.Email == "" {
+		return false, ignoreNotFound(err)
+	}
+	_, err = uc.userRepo.GetByEmail(ctx, u.Email)
+	return err == nil, ignoreNotFound(err)
+}
+
+func ignoreNotFound(err error) error {
+	if err == repo.ErrResourceNotFound {
+		return nil
+	}
+	return err
+}
+
+func pick(users []*repo.User, idx []int) []*repo.User {
+	res := make([]*repo.User, 0, len(idx))
+	for _, i := range idx {
+		res = append(res, users[i])
+	}
+	return res
+}
@@ -0,0 +1,135 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"usm/internal/biz/repo"
+	"usm/internal/biz/repo/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsecase_BatchCreateUsers(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockTran := mock.NewMockTransaction(ctrl)
+	mockTran.EXPECT().WithTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+	existing := map[string]bool{"taken": true}
+	create := func(u *repo.User, id int) (*repo.User, error) {
+		if existing[u.Username] {
+			return nil, repo.ErrResourceAlreadyExists
+		}
+		c := *u
+		c.ID = id
+		return &c, nil
+	}
+	mockRepo := mock.NewMockUserRepo(ctrl)
+	mockRepo.EXPECT().CreateBulk(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, us []*repo.User) ([]*repo.User, error) {
+		var res []*repo.User
+		for i, u := range us {
+			c, err := create(u, i+1)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, c)
+		}
+		return res, nil
+	})
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, u *repo.User) (*repo.User, error) {
+		return create(u, 100)
+	})
+	mockRepo.EXPECT().GetByUsername(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, username string) (*repo.User, error) {
+		if existing[username] {
+			return &repo.User{Username: username}, nil
+		}
+		return nil, repo.ErrResourceNotFound
+	})
+	mockRepo.EXPECT().GetByEmail(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, repo.ErrResourceNotFound)
+	uc := &Usecase{tran: mockTran, userRepo: mockRepo}
+	tests := []struct {
+		name      string
+		usernames []string
+		atomic    bool
+		wantUsers []string
+		wantErrs  []error
+	}{
+		{
+			name:      "should create all users",
+			usernames: []string{"alice", "bob"},
+			wantUsers: []string{"alice", "bob"},
+			wantErrs:  []error{nil, nil},
+		},
+		{
+			name:      "should create valid users in partial mode",
+			usernames: []string{"alice", "a", "Alice", "taken", "bob"},
+			wantUsers: []string{"alice", "", "", "", "bob"},
+			wantErrs:  []error{nil, repo.ErrInvalidUsername, repo.ErrResourceAlreadyExists, repo.ErrResourceAlreadyExists, nil},
+		},
+		{
+			name:      "should abort batch on invalid user in atomic mode",
+			usernames: []string{"alice", "a"},
+			atomic:    true,
+			wantUsers: []string{"", ""},
+			wantErrs:  []error{repo.ErrBatchAborted, repo.ErrInvalidUsername},
+		},
+		{
+			name:      "should abort batch on existing user in atomic mode",
+			usernames: []string{"alice", "taken"},
+			atomic:    true,
+			wantUsers: []string{"", ""},
+			wantErrs:  []error{repo.ErrBatchAborted, repo.ErrResourceAlreadyExists},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := make([]*repo.User, 0, len(tt.usernames))
+			for _, name := range tt.usernames {
+				users = append(users, &repo.User{Username: name})
+			}
+			results, err := uc.BatchCreateUsers(ctx, users, tt.atomic)
+			assert.NoError(t, err)
+			var gotUsers []string
+			var gotErrs []error
+			for _, r := range results {
+				name := ""
+				if r.User != nil {
+					name = r.User.Username
+				}
+				gotUsers = append(gotUsers, name)
+				gotErrs = append(gotErrs, r.Err)
+			}
+			assert.Equal(t, tt.wantUsers, gotUsers)
+			assert.Equal(t, tt.wantErrs, gotErrs)
+		})
+	}
+}
+
+func TestUsecase_BatchDeleteUsers(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockTran := mock.NewMockTransaction(ctrl)
+	mockTran.EXPECT().WithTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockRepo := mock.NewMockUserRepo(ctrl)
+	mockRepo.EXPECT().Delete(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) error {
+		if id > 2 {
+			return repo.ErrResourceNotFound
+		}
+		return nil
+	})
+	uc := &Usecase{tran: mockTran, userRepo: mockRepo}
+
+	results, err := uc.BatchDeleteUsers(ctx, []int{1, 3, 2}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []*BatchResult{{}, {Err: repo.ErrResourceNotFound}, {}}, results)
+
+	// 第一个失败的用户之后的用户不再执行
+	results, err = uc.BatchDeleteUsers(ctx, []int{1, 3, 2}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []*BatchResult{{Err: repo.ErrBatchAborted}, {Err: repo.ErrResourceNotFound}, {Err: repo.ErrBatchAborted}}, results)
+}
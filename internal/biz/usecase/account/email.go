@@ -0,0 +1,124 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"usm/internal/biz/repo"
+)
+
+const defaultVerificationTTL = 24 * time.Hour
+
+// SendVerificationEmail 生成新的验证令牌并发送到用户当前邮箱，之前发送的令牌失效
+func (uc *Usecase) SendVerificationEmail(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "account.SendVerificationEmail")
+	defer span.End()
+	var (
+		u     *repo.User
+		token string
+	)
+	err := uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		u, err = uc.userRepo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if u.IsServiceAccount() {
+			return repo.ErrHumanOnly
+		}
+		if u.Email == "" {
+			return repo.ErrEmailNotSet
+		}
+		if u.EmailVerified {
+			return repo.ErrEmailAlreadyVerified
+		}
+		if err := uc.verificationRepo.DeleteByUser(ctx, id); err != nil {
+			return err
+		}
+		token, err = randomString(32)
+		if err != nil {
+			return err
+		}
+		_, err = uc.verificationRepo.Create(ctx, &repo.EmailVerification{
+			UserID:     id,
+			Email:      u.Email,
+			TokenHash:  hashSecret(token),
+			ExpireTime: time.Now().Add(uc.tokenTTLs().verification),
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return uc.mailer.Send(ctx, &repo.Mail{
+		To:      u.Email,
+		Subject: "Verify your email address",
+		Body:    uc.verificationMailBody(u, token),
+	})
+}
+
+// VerifyEmail 使用令牌验证邮箱，令牌只能使用一次
+func (uc *Usecase) VerifyEmail(ctx context.Context, token string) (*repo.User, error) {
+	ctx, span := tracer.Start(ctx, "account.VerifyEmail")
+	defer span.End()
+	var u *repo.User
+	err := uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		v, err := uc.verificationRepo.GetByHash(ctx, hashSecret(token))
+		if err != nil {
+			if err == repo.ErrResourceNotFound {
+				return repo.ErrInvalidToken
+			}
+			return err
+		}
+		if v.Expired(time.Now()) {
+			return repo.ErrInvalidToken
+		}
+		u, err = uc.userRepo.Get(ctx, v.UserID)
+		if err != nil {
+			if err == repo.ErrResourceNotFound {
+				return repo.ErrInvalidToken
+			}
+			return err
+		}
+		// 发送后修改了邮箱
+		if u.Email != v.Email {
+			return repo.ErrInvalidToken
+		}
+		if err := uc.userRepo.SetEmailVerified(ctx, u.ID, true); err != nil {
+			return err
+		}
+		u.EmailVerified = true
+		return uc.verificationRepo.DeleteByUser(ctx, u.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (uc *Usecase) verificationMailBody(u *repo.User, token string) string {
+	if uc.verificationURL == "" {
+		return fmt.Sprintf("Hi %s,\n\nUse the following token to verify your email address:\n\n%s\n\nThe token expires in %s.\n",
+			u.Username, token, formatTTL(uc.tokenTTLs().verification))
+	}
+	return fmt.Sprintf("Hi %s,\n\nOpen the following link to verify your email address:\n\n%s\n\nThe link expires in %s.\n",
+		u.Username, tokenLink(uc.verificationURL, token), formatTTL(uc.tokenTTLs().verification))
+}
+
+// tokenLink 将令牌作为 token 参数附加到页面地址
+func tokenLink(base, token string) string {
+	q := url.Values{"token": {token}}
+	if parsed, err := url.Parse(base); err == nil && parsed.RawQuery != "" {
+		return base + "&" + q.Encode()
+	}
+	return base + "?" + q.Encode()
+}
+
+func formatTTL(d time.Duration) string {
+	if d >= time.Hour {
+		return fmt.Sprintf("%d hours", int(d.Hours()))
+	}
+	return fmt.Sprintf("%d minutes", int(d.Minutes()))
+}
@@ -0,0 +1,159 @@
+package account
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"usm/internal/biz/repo"
+	"usm/internal/biz/repo/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newEmailTestUsecase(t *testing.T, users map[int]*repo.User) (*Usecase, *[]*repo.Mail) {
+	ctrl := gomock.NewController(t)
+	mockTran := mock.NewMockTransaction(ctrl)
+	mockTran.EXPECT().WithTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockRepo := mock.NewMockUserRepo(ctrl)
+	mockRepo.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) (*repo.User, error) {
+		u, ok := users[id]
+		if !ok {
+			return nil, repo.ErrResourceNotFound
+		}
+		cp := *u
+		return &cp, nil
+	})
+	mockRepo.EXPECT().SetEmailVerified(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int, verified bool) error {
+		users[id].EmailVerified = verified
+		return nil
+	})
+
+	tokens := map[string]*repo.EmailVerification{}
+	mockVerificationRepo := mock.NewMockEmailVerificationRepo(ctrl)
+	mockVerificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, m *repo.EmailVerification) (*repo.EmailVerification, error) {
+		m.ID = len(tokens) + 1
+		tokens[m.TokenHash] = m
+		return m, nil
+	})
+	mockVerificationRepo.EXPECT().GetByHash(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, hash string) (*repo.EmailVerification, error) {
+		v, ok := tokens[hash]
+		if !ok {
+			return nil, repo.ErrResourceNotFound
+		}
+		return v, nil
+	})
+	mockVerificationRepo.EXPECT().DeleteByUser(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, userID int) error {
+		for h, v := range tokens {
+			if v.UserID == userID {
+				delete(tokens, h)
+			}
+		}
+		return nil
+	})
+
+	var sent []*repo.Mail
+	mockMailer := mock.NewMockMailer(ctrl)
+	mockMailer.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, m *repo.Mail) error {
+		sent = append(sent, m)
+		return nil
+	})
+	uc := &Usecase{
+		tran:             mockTran,
+		userRepo:         mockRepo,
+		verificationRepo: mockVerificationRepo,
+		mailer:           mockMailer,
+		verificationURL:  "https://usm.example.com/verify",
+	}
+	uc.ttls.Store(tokenTTLs{verification: time.Hour})
+	return uc, &sent
+}
+
+func tokenFromMail(t *testing.T, m *repo.Mail) string {
+	for _, line := range strings.Split(m.Body, "\n") {
+		if strings.HasPrefix(line, "https://") {
+			u, err := url.Parse(line)
+			assert.NoError(t, err)
+			return u.Query().Get("token")
+		}
+	}
+	t.Fatalf("no verification link in mail body: %s", m.Body)
+	return ""
+}
+
+func TestUsecase_SendVerificationEmail(t *testing.T) {
+	ctx := context.Background()
+	users := map[int]*repo.User{
+		1: {ID: 1, Username: "liubo", Email: "findliubo@163.com", Kind: repo.UserKindHuman},
+		2: {ID: 2, Username: "robot", Kind: repo.UserKindService},
+		3: {ID: 3, Username: "noemail", Kind: repo.UserKindHuman},
+		4: {ID: 4, Username: "verified", Email: "v@example.com", EmailVerified: true, Kind: repo.UserKindHuman},
+	}
+	uc, sent := newEmailTestUsecase(t, users)
+	tests := []struct {
+		name    string
+		id      int
+		wantErr error
+	}{
+		{name: "should send verification email successfully", id: 1},
+		{name: "should send verification email failed if not found", id: 9, wantErr: repo.ErrResourceNotFound},
+		{name: "should send verification email failed if user is a service account", id: 2, wantErr: repo.ErrHumanOnly},
+		{name: "should send verification email failed if email is not set", id: 3, wantErr: repo.ErrEmailNotSet},
+		{name: "should send verification email failed if already verified", id: 4, wantErr: repo.ErrEmailAlreadyVerified},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := uc.SendVerificationEmail(ctx, tt.id)
+			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
+		})
+	}
+	if assert.Len(t, *sent, 1) {
+		assert.Equal(t, "findliubo@163.com", (*sent)[0].To)
+		assert.NotEmpty(t, tokenFromMail(t, (*sent)[0]))
+	}
+}
+
+func TestUsecase_VerifyEmail(t *testing.T) {
+	ctx := context.Background()
+	users := map[int]*repo.User{
+		1: {ID: 1, Username: "liubo", Email: "findliubo@163.com", Kind: repo.UserKindHuman},
+	}
+	uc, sent := newEmailTestUsecase(t, users)
+
+	assert.NoError(t, uc.SendVerificationEmail(ctx, 1))
+	first := tokenFromMail(t, (*sent)[0])
+	assert.NoError(t, uc.SendVerificationEmail(ctx, 1))
+	second := tokenFromMail(t, (*sent)[1])
+
+	_, err := uc.VerifyEmail(ctx, first)
+	assert.Equal(t, repo.ErrInvalidToken, err, "resending must invalidate earlier tokens")
+
+	u, err := uc.VerifyEmail(ctx, second)
+	assert.NoError(t, err)
+	assert.True(t, u.EmailVerified)
+	assert.True(t, users[1].EmailVerified)
+
+	_, err = uc.VerifyEmail(ctx, second)
+	assert.Equal(t, repo.ErrInvalidToken, err, "token must be single use")
+
+	// 邮箱变更后旧令牌失效
+	users[1].EmailVerified = false
+	assert.NoError(t, uc.SendVerificationEmail(ctx, 1))
+	third := tokenFromMail(t, (*sent)[2])
+	users[1].Email = "new@example.com"
+	_, err = uc.VerifyEmail(ctx, third)
+	assert.Equal(t, repo.ErrInvalidToken, err)
+	assert.False(t, users[1].EmailVerified)
+
+	// 过期
+	users[1].Email = "findliubo@163.com"
+	uc.ttls.Store(tokenTTLs{verification: -time.Second})
+	assert.NoError(t, uc.SendVerificationEmail(ctx, 1))
+	_, err = uc.VerifyEmail(ctx, tokenFromMail(t, (*sent)[3]))
+	assert.Equal(t, repo.ErrInvalidToken, err)
+}
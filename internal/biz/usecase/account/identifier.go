@@ -0,0 +1,27 @@
+package account
+
+import (
+	"regexp"
+	"unicode/utf8"
+
+	"usm/internal/biz/repo"
+)
+
+const (
+	minUsernameLength = 2
+	maxUsernameLength = 50
+)
+
+// 用户名由字母、数字和 . _ @ + - 组成，以字母或数字开头，允许非 ASCII 字母以支持中文等用户名
+var usernamePattern = regexp.MustCompile(`^[\p{L}\p{N}][\p{L}\p{N}._@+-]*$`)
+
+// normalizeUser 规范化用户名和邮箱并校验用户名，大小写不同或 Unicode 形式不同的用户名由数据层的唯一索引保证不重复
+func normalizeUser(u *repo.User) error {
+	u.Username = repo.CanonicalIdentifier(u.Username)
+	u.Email = repo.CanonicalIdentifier(u.Email)
+	n := utf8.RuneCountInString(u.Username)
+	if n < minUsernameLength || n > maxUsernameLength || !usernamePattern.MatchString(u.Username) {
+		return repo.ErrInvalidUsername
+	}
+	return nil
+}
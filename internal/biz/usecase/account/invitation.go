@@ -0,0 +1,139 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"usm/internal/biz/repo"
+)
+
+const defaultInvitationTTL = 7 * 24 * time.Hour
+
+// CreateInvitation 邀请用户注册并发送邀请邮件，同一邮箱之前待接受的邀请被撤销
+func (uc *Usecase) CreateInvitation(ctx context.Context, inv *repo.Invitation) (*repo.Invitation, error) {
+	ctx, span := tracer.Start(ctx, "account.CreateInvitation")
+	defer span.End()
+	inv.Email = repo.CanonicalIdentifier(inv.Email)
+	token, err := randomString(32)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		for _, id := range inv.GroupIDs {
+			if _, err := uc.groupRepo.Get(ctx, id); err != nil {
+				if err == repo.ErrResourceNotFound {
+					return repo.ErrInvalidGroup
+				}
+				return err
+			}
+		}
+		if err := uc.invitationRepo.RevokeByEmail(ctx, inv.Email); err != nil {
+			return err
+		}
+		inv, err = uc.invitationRepo.Create(ctx, &repo.Invitation{
+			Email:      inv.Email,
+			TokenHash:  hashSecret(token),
+			GroupIDs:   inv.GroupIDs,
+			ExpireTime: time.Now().Add(uc.tokenTTLs().invitation),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.mailer.Send(ctx, &repo.Mail{
+		To:      inv.Email,
+		Subject: "You have been invited",
+		Body:    uc.invitationMailBody(token),
+	}); err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+func (uc *Usecase) ListInvitations(ctx context.Context, filter *repo.InvitationFilter, offset, limit int) ([]*repo.Invitation, error) {
+	ctx, span := tracer.Start(ctx, "account.ListInvitations")
+	defer span.End()
+	return uc.invitationRepo.List(ctx, filter, offset, limit)
+}
+
+func (uc *Usecase) RevokeInvitation(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "account.RevokeInvitation")
+	defer span.End()
+	return uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		inv, err := uc.invitationRepo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if inv.Status != repo.InvitationStatusPending {
+			return repo.ErrInvitationNotPending
+		}
+		return uc.invitationRepo.Revoke(ctx, id)
+	})
+}
+
+// AcceptInvitation 使用邀请令牌创建用户并加入预设的用户组，邮箱视为已验证
+func (uc *Usecase) AcceptInvitation(ctx context.Context, token, username, password string) (*repo.User, error) {
+	ctx, span := tracer.Start(ctx, "account.AcceptInvitation")
+	defer span.End()
+	if err := uc.passwordPolicy.check(password); err != nil {
+		return nil, err
+	}
+	nu := &repo.User{Username: username}
+	if err := normalizeUser(nu); err != nil {
+		return nil, err
+	}
+	var u *repo.User
+	err := uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		inv, err := uc.invitationRepo.GetByHash(ctx, hashSecret(token))
+		if err != nil {
+			if err == repo.ErrResourceNotFound {
+				return repo.ErrInvalidToken
+			}
+			return err
+		}
+		now := time.Now()
+		if !inv.Pending(now) {
+			return repo.ErrInvalidToken
+		}
+		u, err = uc.userRepo.Create(ctx, &repo.User{
+			Username:      nu.Username,
+			Email:         inv.Email,
+			EmailVerified: true,
+			Password:      password,
+			Kind:          repo.UserKindHuman,
+		})
+		if err != nil {
+			return err
+		}
+		for _, id := range inv.GroupIDs {
+			// 邀请后被删除的用户组忽略
+			if err := uc.groupRepo.AddMembers(ctx, id, []int{u.ID}); err != nil && err != repo.ErrResourceNotFound {
+				return err
+			}
+		}
+		if err := uc.invitationRepo.Accept(ctx, inv.ID, u.ID, now); err != nil {
+			// 并发接受同一个邀请
+			if err == repo.ErrResourceNotFound {
+				return repo.ErrInvalidToken
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.usersCreated(repo.UserOriginInvitation, 1)
+	return u, nil
+}
+
+func (uc *Usecase) invitationMailBody(token string) string {
+	if uc.invitationURL == "" {
+		return fmt.Sprintf("Hi,\n\nYou have been invited to create an account. Use the following token to accept the invitation:\n\n%s\n\n"+
+			"The invitation expires in %s.\n", token, formatTTL(uc.tokenTTLs().invitation))
+	}
+	return fmt.Sprintf("Hi,\n\nYou have been invited to create an account. Open the following link to accept the invitation:\n\n%s\n\n"+
+		"The invitation expires in %s.\n", tokenLink(uc.invitationURL, token), formatTTL(uc.tokenTTLs().invitation))
+}
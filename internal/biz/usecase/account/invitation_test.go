@@ -0,0 +1,143 @@
+package account
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"usm/internal/biz/repo"
+	"usm/internal/biz/repo/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsecase_Invitation(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockTran := mock.NewMockTransaction(ctrl)
+	mockTran.EXPECT().WithTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+	users := map[string]*repo.User{"liubo": {ID: 1, Username: "liubo"}}
+	mockRepo := mock.NewMockUserRepo(ctrl)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, m *repo.User) (*repo.User, error) {
+		if _, ok := users[m.Username]; ok {
+			return nil, repo.ErrResourceAlreadyExists
+		}
+		m.ID = len(users) + 1
+		users[m.Username] = m
+		return m, nil
+	})
+	members := map[int][]int{}
+	mockGroupRepo := mock.NewMockGroupRepo(ctrl)
+	mockGroupRepo.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) (*repo.Group, error) {
+		if id != 10 {
+			return nil, repo.ErrResourceNotFound
+		}
+		return &repo.Group{ID: 10, DisplayName: "dev"}, nil
+	})
+	mockGroupRepo.EXPECT().AddMembers(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int, userIDs []int) error {
+		members[id] = append(members[id], userIDs...)
+		return nil
+	})
+
+	invs := map[int]*repo.Invitation{}
+	mockInvitationRepo := mock.NewMockInvitationRepo(ctrl)
+	mockInvitationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, m *repo.Invitation) (*repo.Invitation, error) {
+		m.ID = len(invs) + 1
+		m.Status = repo.InvitationStatusPending
+		invs[m.ID] = m
+		return m, nil
+	})
+	mockInvitationRepo.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) (*repo.Invitation, error) {
+		inv, ok := invs[id]
+		if !ok {
+			return nil, repo.ErrResourceNotFound
+		}
+		return inv, nil
+	})
+	mockInvitationRepo.EXPECT().GetByHash(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, hash string) (*repo.Invitation, error) {
+		for _, inv := range invs {
+			if inv.TokenHash == hash {
+				return inv, nil
+			}
+		}
+		return nil, repo.ErrResourceNotFound
+	})
+	mockInvitationRepo.EXPECT().RevokeByEmail(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, email string) error {
+		for _, inv := range invs {
+			if inv.Email == email && inv.Status == repo.InvitationStatusPending {
+				inv.Status = repo.InvitationStatusRevoked
+			}
+		}
+		return nil
+	})
+	mockInvitationRepo.EXPECT().Revoke(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) error {
+		invs[id].Status = repo.InvitationStatusRevoked
+		return nil
+	})
+	mockInvitationRepo.EXPECT().Accept(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id, userID int, now time.Time) error {
+		invs[id].Status = repo.InvitationStatusAccepted
+		invs[id].UserID = userID
+		return nil
+	})
+	var tokens []string
+	mockMailer := mock.NewMockMailer(ctrl)
+	mockMailer.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, m *repo.Mail) error {
+		tokens = append(tokens, strings.Split(m.Body, "\n")[4])
+		return nil
+	})
+	uc := &Usecase{
+		tran:           mockTran,
+		userRepo:       mockRepo,
+		groupRepo:      mockGroupRepo,
+		invitationRepo: mockInvitationRepo,
+		mailer:         mockMailer,
+		passwordPolicy: newPasswordPolicy(nil),
+	}
+	uc.ttls.Store(tokenTTLs{invitation: time.Hour})
+
+	_, err := uc.CreateInvitation(ctx, &repo.Invitation{Email: "alice@example.com", GroupIDs: []int{11}})
+	assert.Equal(t, repo.ErrInvalidGroup, err)
+	assert.Empty(t, tokens)
+
+	first, err := uc.CreateInvitation(ctx, &repo.Invitation{Email: "alice@example.com", GroupIDs: []int{10}})
+	assert.NoError(t, err)
+	second, err := uc.CreateInvitation(ctx, &repo.Invitation{Email: "alice@example.com", GroupIDs: []int{10}})
+	assert.NoError(t, err)
+	assert.Equal(t, repo.InvitationStatusRevoked, first.Status, "new invitation must revoke earlier ones")
+	if !assert.Len(t, tokens, 2) {
+		return
+	}
+
+	_, err = uc.AcceptInvitation(ctx, tokens[0], "alice", "Passw0rd!")
+	assert.Equal(t, repo.ErrInvalidToken, err)
+	_, err = uc.AcceptInvitation(ctx, tokens[1], "alice", "short")
+	assert.Equal(t, repo.ErrWeakPassword, err)
+	_, err = uc.AcceptInvitation(ctx, tokens[1], "liubo", "Passw0rd!")
+	assert.Equal(t, repo.ErrResourceAlreadyExists, err)
+
+	u, err := uc.AcceptInvitation(ctx, tokens[1], "alice", "Passw0rd!")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice@example.com", u.Email)
+	assert.True(t, u.EmailVerified)
+	assert.Equal(t, repo.UserKindHuman, u.Kind)
+	assert.Equal(t, []int{u.ID}, members[10])
+	assert.Equal(t, repo.InvitationStatusAccepted, second.Status)
+	assert.Equal(t, u.ID, second.UserID)
+
+	_, err = uc.AcceptInvitation(ctx, tokens[1], "alice2", "Passw0rd!")
+	assert.Equal(t, repo.ErrInvalidToken, err, "invitation must be single use")
+	assert.Equal(t, repo.ErrInvitationNotPending, uc.RevokeInvitation(ctx, second.ID))
+	assert.Equal(t, repo.ErrResourceNotFound, uc.RevokeInvitation(ctx, 99))
+
+	// 过期
+	uc.ttls.Store(tokenTTLs{invitation: -time.Second})
+	expired, err := uc.CreateInvitation(ctx, &repo.Invitation{Email: "bob@example.com"})
+	assert.NoError(t, err)
+	_, err = uc.AcceptInvitation(ctx, tokens[2], "bob", "Passw0rd!")
+	assert.Equal(t, repo.ErrInvalidToken, err)
+	assert.NoError(t, uc.RevokeInvitation(ctx, expired.ID))
+}
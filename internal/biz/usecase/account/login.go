@@ -0,0 +1,81 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"usm/internal/biz/repo"
+)
+
+type loginIdentifier string
+
+const (
+	loginIdentifierUsername loginIdentifier = "username"
+	loginIdentifierEmail    loginIdentifier = "email"
+	loginIdentifierPhone    loginIdentifier = "phone"
+)
+
+var defaultLoginIdentifiers = []loginIdentifier{loginIdentifierUsername, loginIdentifierEmail}
+
+func newLoginIdentifiers(names []string) ([]loginIdentifier, error) {
+	if len(names) == 0 {
+		return defaultLoginIdentifiers, nil
+	}
+	identifiers := make([]loginIdentifier, 0, len(names))
+	seen := make(map[loginIdentifier]bool, len(names))
+	for _, name := range names {
+		id := loginIdentifier(name)
+		switch id {
+		case loginIdentifierUsername, loginIdentifierEmail, loginIdentifierPhone:
+		default:
+			return nil, fmt.Errorf("invalid login identifier %q", name)
+		}
+		if !seen[id] {
+			seen[id] = true
+			identifiers = append(identifiers, id)
+		}
+	}
+	return identifiers, nil
+}
+
+// findUserByIdentifier 按配置的顺序使用用户名、邮箱或手机号查找用户。
+// 所有方式都找不到时统一返回 ErrResourceNotFound，未验证的邮箱、多人使用的手机号和服务账号的邮箱、手机号
+// 与不存在同样处理，调用方无法据此判断某个邮箱或手机号是否已注册
+func findUserByIdentifier(ctx context.Context, userRepo repo.UserRepo, identifiers []loginIdentifier, identifier string) (*repo.User, error) {
+	if len(identifiers) == 0 {
+		identifiers = []loginIdentifier{loginIdentifierUsername}
+	}
+	identifier = strings.TrimSpace(identifier)
+	for _, id := range identifiers {
+		var (
+			u   *repo.User
+			err error
+		)
+		switch id {
+		case loginIdentifierUsername:
+			u, err = userRepo.GetByUsername(ctx, identifier)
+		case loginIdentifierEmail:
+			if !strings.Contains(identifier, "@") {
+				continue
+			}
+			u, err = userRepo.GetByEmail(ctx, identifier)
+			if err == nil && (!u.EmailVerified || u.IsServiceAccount()) {
+				u, err = nil, repo.ErrResourceNotFound
+			}
+		case loginIdentifierPhone:
+			if !phonePattern.MatchString(identifier) {
+				continue
+			}
+			u, err = userRepo.GetByPhone(ctx, identifier)
+			if err == nil && u.IsServiceAccount() {
+				u, err = nil, repo.ErrResourceNotFound
+			}
+		}
+		if err == repo.ErrResourceNotFound {
+			continue
+		}
+		return u, err
+	}
+	return nil, repo.ErrResourceNotFound
+}
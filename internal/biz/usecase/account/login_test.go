@@ -0,0 +1,121 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"usm/internal/biz/repo"
+	"usm/internal/biz/repo/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLoginIdentifiers(t *testing.T) {
+	got, err := newLoginIdentifiers(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultLoginIdentifiers, got)
+
+	got, err = newLoginIdentifiers([]string{"phone", "username", "phone"})
+	assert.NoError(t, err)
+	assert.Equal(t, []loginIdentifier{loginIdentifierPhone, loginIdentifierUsername}, got)
+
+	_, err = newLoginIdentifiers([]string{"nickname"})
+	assert.Error(t, err)
+}
+
+func TestFindUserByIdentifier(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	users := []*repo.User{
+		{ID: 1, Username: "liubo", Email: "findliubo@163.com", EmailVerified: true, Phone: "+8613800000001", Kind: repo.UserKindHuman},
+		{ID: 2, Username: "carol", Email: "carol@example.com", Phone: "+8613800000002", Kind: repo.UserKindHuman},
+		{ID: 3, Username: "robot", Email: "robot@example.com", EmailVerified: true, Kind: repo.UserKindService},
+		{ID: 4, Username: "dave@example.com", Kind: repo.UserKindHuman},
+		{ID: 5, Username: "erin", Email: "dave@example.com", EmailVerified: true, Kind: repo.UserKindHuman},
+	}
+	find := func(match func(u *repo.User) bool) (*repo.User, error) {
+		for _, u := range users {
+			if match(u) {
+				return u, nil
+			}
+		}
+		return nil, repo.ErrResourceNotFound
+	}
+	mockRepo := mock.NewMockUserRepo(ctrl)
+	mockRepo.EXPECT().GetByUsername(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, username string) (*repo.User, error) {
+		return find(func(u *repo.User) bool { return u.Username == username })
+	})
+	mockRepo.EXPECT().GetByEmail(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, email string) (*repo.User, error) {
+		return find(func(u *repo.User) bool { return u.Email == email })
+	})
+	mockRepo.EXPECT().GetByPhone(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, phone string) (*repo.User, error) {
+		return find(func(u *repo.User) bool { return u.Phone == phone })
+	})
+	all := []loginIdentifier{loginIdentifierUsername, loginIdentifierEmail, loginIdentifierPhone}
+	tests := []struct {
+		name        string
+		identifiers []loginIdentifier
+		identifier  string
+		wantID      int
+		wantErr     error
+	}{
+		{
+			name:        "should find user by username",
+			identifiers: all,
+			identifier:  "liubo",
+			wantID:      1,
+		},
+		{
+			name:        "should find user by verified email",
+			identifiers: all,
+			identifier:  "findliubo@163.com",
+			wantID:      1,
+		},
+		{
+			name:        "should find user by phone",
+			identifiers: all,
+			identifier:  "+8613800000002",
+			wantID:      2,
+		},
+		{
+			name:        "should prefer username over email",
+			identifiers: all,
+			identifier:  "dave@example.com",
+			wantID:      4,
+		},
+		{
+			name:        "should not find user by unverified email",
+			identifiers: all,
+			identifier:  "carol@example.com",
+			wantErr:     repo.ErrResourceNotFound,
+		},
+		{
+			name:        "should not find service account by email",
+			identifiers: all,
+			identifier:  "robot@example.com",
+			wantErr:     repo.ErrResourceNotFound,
+		},
+		{
+			name:        "should not find user by email if email is not a login identifier",
+			identifiers: []loginIdentifier{loginIdentifierUsername},
+			identifier:  "findliubo@163.com",
+			wantErr:     repo.ErrResourceNotFound,
+		},
+		{
+			name:        "should use username only by default",
+			identifiers: nil,
+			identifier:  "+8613800000001",
+			wantErr:     repo.ErrResourceNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findUserByIdentifier(ctx, mockRepo, tt.identifiers, tt.identifier)
+			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantID, got.ID)
+			}
+		})
+	}
+}
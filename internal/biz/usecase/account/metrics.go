@@ -0,0 +1,23 @@
+package account
+
+func (uc *Usecase) loginAttempted(method string, err error) {
+	if uc.metrics != nil {
+		uc.metrics.LoginAttempted(method, err == nil)
+	}
+}
+
+func (uc *Usecase) usersCreated(origin string, n int) {
+	if uc.metrics != nil && n > 0 {
+		uc.metrics.UsersCreated(origin, n)
+	}
+}
+
+func createdCount(results []*BatchResult) int {
+	n := 0
+	for _, r := range results {
+		if r.User != nil {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,175 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
+)
+
+const (
+	defaultPasswordResetTTL  = time.Hour
+	defaultPasswordMinLength = 8
+	defaultPasswordMaxLength = 128
+)
+
+// passwordPolicy 密码复杂度要求，零值只要求密码非空
+type passwordPolicy struct {
+	minLength        int
+	maxLength        int
+	requireUppercase bool
+	requireLowercase bool
+	requireDigit     bool
+	requireSymbol    bool
+}
+
+func newPasswordPolicy(c *conf.Auth_PasswordPolicy) passwordPolicy {
+	p := passwordPolicy{
+		minLength:        int(c.GetMinLength()),
+		maxLength:        int(c.GetMaxLength()),
+		requireUppercase: c.GetRequireUppercase(),
+		requireLowercase: c.GetRequireLowercase(),
+		requireDigit:     c.GetRequireDigit(),
+		requireSymbol:    c.GetRequireSymbol(),
+	}
+	if p.minLength <= 0 {
+		p.minLength = defaultPasswordMinLength
+	}
+	if p.maxLength <= 0 {
+		p.maxLength = defaultPasswordMaxLength
+	}
+	return p
+}
+
+func (p passwordPolicy) check(password string) error {
+	n := utf8.RuneCountInString(password)
+	if n == 0 || n < p.minLength || (p.maxLength > 0 && n > p.maxLength) {
+		return repo.ErrWeakPassword
+	}
+	// 与导入的密码哈希无法区分
+	if isPasswordHash(password) {
+		return repo.ErrWeakPassword
+	}
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+	if (p.requireUppercase && !upper) || (p.requireLowercase && !lower) ||
+		(p.requireDigit && !digit) || (p.requireSymbol && !symbol) {
+		return repo.ErrWeakPassword
+	}
+	return nil
+}
+
+// RequestPasswordReset 根据用户名或邮箱发送密码重置通知。
+// 为避免泄露用户是否存在，找不到用户或用户不能重置密码时同样返回成功
+func (uc *Usecase) RequestPasswordReset(ctx context.Context, identifier string) error {
+	ctx, span := tracer.Start(ctx, "account.RequestPasswordReset")
+	defer span.End()
+	u, err := uc.findResettableUser(ctx, identifier)
+	if err != nil || u == nil {
+		return err
+	}
+	token, err := randomString(32)
+	if err != nil {
+		return err
+	}
+	err = uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		if err := uc.resetRepo.DeleteByUser(ctx, u.ID); err != nil {
+			return err
+		}
+		_, err := uc.resetRepo.Create(ctx, &repo.PasswordReset{
+			UserID:     u.ID,
+			TokenHash:  hashSecret(token),
+			ExpireTime: time.Now().Add(uc.tokenTTLs().reset),
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return uc.notifier.Notify(ctx, u, &repo.Notification{
+		Subject: "Reset your password",
+		Body:    uc.resetNotificationBody(u, token),
+	})
+}
+
+// ResetPassword 使用令牌重置密码，成功后吊销用户已签发的全部令牌
+func (uc *Usecase) ResetPassword(ctx context.Context, token, password string) error {
+	ctx, span := tracer.Start(ctx, "account.ResetPassword")
+	defer span.End()
+	if err := uc.passwordPolicy.check(password); err != nil {
+		return err
+	}
+	return uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		r, err := uc.resetRepo.GetByHash(ctx, hashSecret(token))
+		if err != nil {
+			if err == repo.ErrResourceNotFound {
+				return repo.ErrInvalidToken
+			}
+			return err
+		}
+		if r.Expired(time.Now()) {
+			return repo.ErrInvalidToken
+		}
+		u, err := uc.userRepo.Get(ctx, r.UserID)
+		if err != nil {
+			if err == repo.ErrResourceNotFound {
+				return repo.ErrInvalidToken
+			}
+			return err
+		}
+		if u.IsServiceAccount() || u.IsExternal() || u.Disabled {
+			return repo.ErrInvalidToken
+		}
+		if err := uc.userRepo.SetPassword(ctx, u.ID, password); err != nil {
+			return err
+		}
+		if err := uc.resetRepo.DeleteByUser(ctx, u.ID); err != nil {
+			return err
+		}
+		return uc.tokenRepo.RevokeByUser(ctx, u.ID)
+	})
+}
+
+// findResettableUser 先按用户名查找，再按邮箱查找，重置通知发送到用户邮箱，因此邮箱不要求已验证
+func (uc *Usecase) findResettableUser(ctx context.Context, identifier string) (*repo.User, error) {
+	u, err := uc.userRepo.GetByUsername(ctx, identifier)
+	if err == repo.ErrResourceNotFound {
+		u, err = uc.userRepo.GetByEmail(ctx, identifier)
+	}
+	if err == repo.ErrResourceNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if u.IsServiceAccount() || u.IsExternal() || u.Disabled || u.Email == "" {
+		return nil, nil
+	}
+	return u, nil
+}
+
+func (uc *Usecase) resetNotificationBody(u *repo.User, token string) string {
+	if uc.resetURL == "" {
+		return fmt.Sprintf("Hi %s,\n\nUse the following token to reset your password:\n\n%s\n\n"+
+			"The token expires in %s. If you did not request a password reset, you can ignore this message.\n",
+			u.Username, token, formatTTL(uc.tokenTTLs().reset))
+	}
+	return fmt.Sprintf("Hi %s,\n\nOpen the following link to reset your password:\n\n%s\n\n"+
+		"The link expires in %s. If you did not request a password reset, you can ignore this message.\n",
+		u.Username, tokenLink(uc.resetURL, token), formatTTL(uc.tokenTTLs().reset))
+}
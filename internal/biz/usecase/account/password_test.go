@@ -0,0 +1,158 @@
+package account
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"usm/internal/biz/repo"
+	"usm/internal/biz/repo/mock"
+	"usm/internal/conf"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_passwordPolicy_check(t *testing.T) {
+	strict := newPasswordPolicy(&conf.Auth_PasswordPolicy{
+		MinLength:        10,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	})
+	tests := []struct {
+		name     string
+		policy   passwordPolicy
+		password string
+		wantErr  error
+	}{
+		{name: "zero policy allows short password", policy: passwordPolicy{}, password: "a"},
+		{name: "zero policy rejects empty password", policy: passwordPolicy{}, password: "", wantErr: repo.ErrWeakPassword},
+		{name: "default policy rejects short password", policy: newPasswordPolicy(nil), password: "1234567", wantErr: repo.ErrWeakPassword},
+		{name: "default policy allows 8 characters", policy: newPasswordPolicy(nil), password: "12345678"},
+		{name: "default policy rejects long password", policy: newPasswordPolicy(nil), password: strings.Repeat("a", 129), wantErr: repo.ErrWeakPassword},
+		{name: "length counts characters instead of bytes", policy: newPasswordPolicy(nil), password: "密码密码密码密", wantErr: repo.ErrWeakPassword},
+		{name: "strict policy allows complex password", policy: strict, password: "Admin@169+-"},
+		{name: "strict policy requires uppercase", policy: strict, password: "admin@169+-", wantErr: repo.ErrWeakPassword},
+		{name: "strict policy requires lowercase", policy: strict, password: "ADMIN@169+-", wantErr: repo.ErrWeakPassword},
+		{name: "strict policy requires digit", policy: strict, password: "Admin@abc+-", wantErr: repo.ErrWeakPassword},
+		{name: "strict policy requires symbol", policy: strict, password: "Admin169abc", wantErr: repo.ErrWeakPassword},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.policy.check(tt.password))
+		})
+	}
+}
+
+func TestUsecase_PasswordReset(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockTran := mock.NewMockTransaction(ctrl)
+	mockTran.EXPECT().WithTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+	users := map[int]*repo.User{
+		1: {ID: 1, Username: "liubo", Email: "findliubo@163.com", Password: "old", Kind: repo.UserKindHuman},
+		2: {ID: 2, Username: "robot", Email: "robot@example.com", Kind: repo.UserKindService},
+		3: {ID: 3, Username: "carol", Email: "carol@example.com", Kind: repo.UserKindHuman, Source: "ldap"},
+	}
+	mockRepo := mock.NewMockUserRepo(ctrl)
+	mockRepo.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) (*repo.User, error) {
+		u, ok := users[id]
+		if !ok {
+			return nil, repo.ErrResourceNotFound
+		}
+		return u, nil
+	})
+	mockRepo.EXPECT().GetByUsername(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, username string) (*repo.User, error) {
+		for _, u := range users {
+			if u.Username == username {
+				return u, nil
+			}
+		}
+		return nil, repo.ErrResourceNotFound
+	})
+	mockRepo.EXPECT().GetByEmail(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, email string) (*repo.User, error) {
+		for _, u := range users {
+			if u.Email == email {
+				return u, nil
+			}
+		}
+		return nil, repo.ErrResourceNotFound
+	})
+	mockRepo.EXPECT().SetPassword(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int, pass string) error {
+		users[id].Password = pass
+		return nil
+	})
+
+	resets := map[string]*repo.PasswordReset{}
+	mockResetRepo := mock.NewMockPasswordResetRepo(ctrl)
+	mockResetRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, m *repo.PasswordReset) (*repo.PasswordReset, error) {
+		resets[m.TokenHash] = m
+		return m, nil
+	})
+	mockResetRepo.EXPECT().GetByHash(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, hash string) (*repo.PasswordReset, error) {
+		r, ok := resets[hash]
+		if !ok {
+			return nil, repo.ErrResourceNotFound
+		}
+		return r, nil
+	})
+	mockResetRepo.EXPECT().DeleteByUser(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, userID int) error {
+		for h, r := range resets {
+			if r.UserID == userID {
+				delete(resets, h)
+			}
+		}
+		return nil
+	})
+	mockTokenRepo := mock.NewMockOAuthTokenRepo(ctrl)
+	var revoked []int
+	mockTokenRepo.EXPECT().RevokeByUser(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, userID int) error {
+		revoked = append(revoked, userID)
+		return nil
+	})
+	var notified []string
+	mockNotifier := mock.NewMockNotifier(ctrl)
+	mockNotifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, u *repo.User, n *repo.Notification) error {
+		lines := strings.Split(n.Body, "\n")
+		notified = append(notified, lines[4])
+		return nil
+	})
+	uc := &Usecase{
+		tran:           mockTran,
+		userRepo:       mockRepo,
+		resetRepo:      mockResetRepo,
+		tokenRepo:      mockTokenRepo,
+		notifier:       mockNotifier,
+		passwordPolicy: newPasswordPolicy(nil),
+	}
+	uc.ttls.Store(tokenTTLs{reset: time.Hour})
+
+	// 不存在的用户、服务账号和外部用户都返回成功但不发送通知
+	for _, identifier := range []string{"nobody", "nobody@example.com", "robot", "robot@example.com", "carol"} {
+		assert.NoError(t, uc.RequestPasswordReset(ctx, identifier))
+	}
+	assert.Empty(t, notified)
+
+	assert.NoError(t, uc.RequestPasswordReset(ctx, "liubo"))
+	assert.NoError(t, uc.RequestPasswordReset(ctx, "findliubo@163.com"))
+	if !assert.Len(t, notified, 2) {
+		return
+	}
+	first, second := notified[0], notified[1]
+
+	assert.Equal(t, repo.ErrInvalidToken, uc.ResetPassword(ctx, first, "NewPassw0rd"), "earlier token must be invalidated")
+	assert.Equal(t, repo.ErrWeakPassword, uc.ResetPassword(ctx, second, "short"))
+	assert.NoError(t, uc.ResetPassword(ctx, second, "NewPassw0rd"))
+	assert.Equal(t, "NewPassw0rd", users[1].Password)
+	assert.Equal(t, []int{1}, revoked)
+	assert.Equal(t, repo.ErrInvalidToken, uc.ResetPassword(ctx, second, "NewPassw0rd2"), "token must be single use")
+
+	uc.ttls.Store(tokenTTLs{reset: -time.Second})
+	assert.NoError(t, uc.RequestPasswordReset(ctx, "liubo"))
+	assert.Equal(t, repo.ErrInvalidToken, uc.ResetPassword(ctx, notified[2], "NewPassw0rd3"))
+}
@@ -0,0 +1,106 @@
+package account
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 从其他系统导入的密码哈希原样保存在 password 中，认证时按前缀识别：
+// bcrypt（$2a$、$2b$、$2y$）和 PHC 格式的 argon2（$argon2id$v=19$m=65536,t=3,p=4$salt$hash）
+var passwordHashPrefixes = []string{"$2a$", "$2b$", "$2y$", "$argon2id$", "$argon2i$"}
+
+func isPasswordHash(s string) bool {
+	for _, p := range passwordHashPrefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// validatePasswordHash 校验导入的密码哈希格式
+func validatePasswordHash(hash string) error {
+	if strings.HasPrefix(hash, "$argon2") {
+		_, err := parseArgon2Hash(hash)
+		return err
+	}
+	if !isPasswordHash(hash) {
+		return fmt.Errorf("unsupported password hash, only bcrypt and argon2 are supported")
+	}
+	if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+		return fmt.Errorf("invalid bcrypt hash: %v", err)
+	}
+	return nil
+}
+
+// checkPassword 校验密码，stored 为导入的哈希或本地设置的密码
+func checkPassword(stored, password string) bool {
+	if stored == "" {
+		return false
+	}
+	if strings.HasPrefix(stored, "$argon2") {
+		h, err := parseArgon2Hash(stored)
+		return err == nil && h.matches(password)
+	}
+	if isPasswordHash(stored) {
+		return bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)) == nil
+	}
+	return subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1
+}
+
+const (
+	maxArgon2Memory = 1 << 20 // KiB
+	maxArgon2Time   = 16
+)
+
+type argon2Hash struct {
+	variant string
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+func parseArgon2Hash(s string) (*argon2Hash, error) {
+	// "", variant, v=19, m=..,t=..,p=.., salt, key
+	parts := strings.Split(s, "$")
+	if len(parts) != 6 || (parts[1] != "argon2id" && parts[1] != "argon2i") {
+		return nil, fmt.Errorf("invalid argon2 hash")
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, fmt.Errorf("unsupported argon2 version %q", parts[2])
+	}
+	h := &argon2Hash{variant: parts[1]}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &h.memory, &h.time, &h.threads); err != nil {
+		return nil, fmt.Errorf("invalid argon2 parameters %q", parts[3])
+	}
+	// 限制参数避免导入的哈希使认证消耗过多资源
+	if h.memory == 0 || h.memory > maxArgon2Memory || h.time == 0 || h.time > maxArgon2Time || h.threads == 0 {
+		return nil, fmt.Errorf("invalid argon2 parameters %q", parts[3])
+	}
+	var err error
+	if h.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, fmt.Errorf("invalid argon2 salt")
+	}
+	if h.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(h.key) == 0 {
+		return nil, fmt.Errorf("invalid argon2 key")
+	}
+	return h, nil
+}
+
+func (h *argon2Hash) matches(password string) bool {
+	var key []byte
+	if h.variant == "argon2id" {
+		key = argon2.IDKey([]byte(password), h.salt, h.time, h.memory, h.threads, uint32(len(h.key)))
+	} else {
+		key = argon2.Key([]byte(password), h.salt, h.time, h.memory, h.threads, uint32(len(h.key)))
+	}
+	return subtle.ConstantTimeCompare(key, h.key) == 1
+}
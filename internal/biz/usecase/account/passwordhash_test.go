@@ -0,0 +1,66 @@
+package account
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func argon2idHash(password string, memory, time uint32) string {
+	salt := []byte("0123456789abcdef")
+	key := argon2.IDKey([]byte(password), salt, time, memory, 1, 32)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=1$%s$%s", argon2.Version, memory, time,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+func TestCheckPassword(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("Secret@123"), bcrypt.MinCost)
+	assert.NoError(t, err)
+	tests := []struct {
+		name     string
+		stored   string
+		password string
+		want     bool
+	}{
+		{name: "should match plain password", stored: "Secret@123", password: "Secret@123", want: true},
+		{name: "should not match wrong plain password", stored: "Secret@123", password: "secret@123", want: false},
+		{name: "should not match empty stored password", stored: "", password: "", want: false},
+		{name: "should match bcrypt hash", stored: string(bcryptHash), password: "Secret@123", want: true},
+		{name: "should not match wrong password of bcrypt hash", stored: string(bcryptHash), password: "Secret@124", want: false},
+		{name: "should match argon2id hash", stored: argon2idHash("Secret@123", 64, 1), password: "Secret@123", want: true},
+		{name: "should not match wrong password of argon2id hash", stored: argon2idHash("Secret@123", 64, 1), password: "Secret", want: false},
+		{name: "should not match hash itself", stored: string(bcryptHash), password: string(bcryptHash), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, checkPassword(tt.stored, tt.password))
+		})
+	}
+}
+
+func TestValidatePasswordHash(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("Secret@123"), bcrypt.MinCost)
+	assert.NoError(t, err)
+	tests := []struct {
+		name    string
+		hash    string
+		wantErr bool
+	}{
+		{name: "should accept bcrypt hash", hash: string(bcryptHash)},
+		{name: "should accept argon2id hash", hash: argon2idHash("Secret@123", 64, 1)},
+		{name: "should reject plain password", hash: "Secret@123", wantErr: true},
+		{name: "should reject truncated bcrypt hash", hash: string(bcryptHash[:20]), wantErr: true},
+		{name: "should reject argon2 hash with too much memory", hash: argon2idHash("x", maxArgon2Memory+1, 1), wantErr: true},
+		{name: "should reject argon2 hash of unknown version", hash: "$argon2id$v=16$m=64,t=1,p=1$c2FsdA$a2V5", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePasswordHash(tt.hash)
+			assert.Equal(t, tt.wantErr, err != nil, "error=%v", err)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package account
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
+
+	"golang.org/x/text/language"
+)
+
+type attributeType string
+
+const (
+	attributeTypeString attributeType = "string"
+	attributeTypeNumber attributeType = "number"
+	attributeTypeBool   attributeType = "bool"
+)
+
+var (
+	attributeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+	phonePattern         = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+)
+
+// 用户资料中可以通过 UpdateUser 的 update_mask 修改的字段，attributes.<name> 修改单个自定义属性
+const (
+	UserFieldEmail       = "email"
+	UserFieldDisplayName = "display_name"
+	UserFieldPhone       = "phone"
+	UserFieldAvatarURL   = "avatar_url"
+	UserFieldLocale      = "locale"
+	UserFieldTimezone    = "timezone"
+	UserFieldAttributes  = "attributes"
+)
+
+func newAttributeSchema(c *conf.Account) (map[string]attributeType, error) {
+	schema := make(map[string]attributeType, len(c.GetAttributes()))
+	for _, a := range c.GetAttributes() {
+		if !attributeNamePattern.MatchString(a.Name) {
+			return nil, fmt.Errorf("invalid user attribute name %q", a.Name)
+		}
+		if _, ok := schema[a.Name]; ok {
+			return nil, fmt.Errorf("duplicate user attribute %q", a.Name)
+		}
+		switch t := attributeType(a.Type); t {
+		case attributeTypeString, attributeTypeNumber, attributeTypeBool:
+			schema[a.Name] = t
+		default:
+			return nil, fmt.Errorf("invalid type %q of user attribute %q", a.Type, a.Name)
+		}
+	}
+	return schema, nil
+}
+
+// validateProfile 校验用户资料和自定义属性
+func (uc *Usecase) validateProfile(u *repo.User) error {
+	if u.Phone != "" && !phonePattern.MatchString(u.Phone) {
+		return repo.ErrInvalidProfile
+	}
+	if u.AvatarURL != "" {
+		parsed, err := url.Parse(u.AvatarURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return repo.ErrInvalidProfile
+		}
+	}
+	if u.Locale != "" {
+		if _, err := language.Parse(u.Locale); err != nil {
+			return repo.ErrInvalidProfile
+		}
+	}
+	if u.Timezone != "" {
+		if _, err := time.LoadLocation(u.Timezone); err != nil || u.Timezone == "Local" {
+			return repo.ErrInvalidProfile
+		}
+	}
+	for k, v := range u.Attributes {
+		t, ok := uc.attributeSchema[k]
+		if !ok || !attributeValueOf(t, v) {
+			return repo.ErrInvalidProfile
+		}
+	}
+	return nil
+}
+
+func attributeValueOf(t attributeType, v interface{}) bool {
+	switch v.(type) {
+	case string:
+		return t == attributeTypeString
+	case float64:
+		return t == attributeTypeNumber
+	case bool:
+		return t == attributeTypeBool
+	}
+	return false
+}
+
+// attributeFilter 将字符串形式的过滤条件按属性类型转换
+func (uc *Usecase) attributeFilter(filter *repo.UserFilter) error {
+	if filter == nil {
+		return nil
+	}
+	for k, v := range filter.Attributes {
+		t, ok := uc.attributeSchema[k]
+		if !ok {
+			return repo.ErrInvalidProfile
+		}
+		if filter.Attributes[k], ok = parseAttributeValue(t, v); !ok {
+			return repo.ErrInvalidProfile
+		}
+	}
+	return nil
+}
+
+// parseAttributeValue 将字符串形式的属性值按属性类型转换，其他类型的值必须与属性类型一致
+func parseAttributeValue(t attributeType, v interface{}) (interface{}, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return v, attributeValueOf(t, v)
+	}
+	switch t {
+	case attributeTypeNumber:
+		f, err := strconv.ParseFloat(s, 64)
+		return f, err == nil
+	case attributeTypeBool:
+		b, err := strconv.ParseBool(s)
+		return b, err == nil
+	}
+	return s, true
+}
+
+// applyUpdateMask 将 src 中 paths 指定的字段复制到 dst
+func applyUpdateMask(dst, src *repo.User, paths []string) error {
+	for _, p := range paths {
+		switch p {
+		case UserFieldEmail:
+			dst.Email = src.Email
+		case UserFieldDisplayName:
+			dst.DisplayName = src.DisplayName
+		case UserFieldPhone:
+			dst.Phone = src.Phone
+		case UserFieldAvatarURL:
+			dst.AvatarURL = src.AvatarURL
+		case UserFieldLocale:
+			dst.Locale = src.Locale
+		case UserFieldTimezone:
+			dst.Timezone = src.Timezone
+		case UserFieldAttributes:
+			dst.Attributes = src.Attributes
+		default:
+			name := strings.TrimPrefix(p, UserFieldAttributes+".")
+			if name == p || name == "" {
+				return repo.ErrInvalidProfile
+			}
+			attrs := make(map[string]interface{}, len(dst.Attributes)+1)
+			for k, v := range dst.Attributes {
+				attrs[k] = v
+			}
+			// src 中没有该属性时删除
+			if v, ok := src.Attributes[name]; ok {
+				attrs[name] = v
+			} else {
+				delete(attrs, name)
+			}
+			dst.Attributes = attrs
+		}
+	}
+	return nil
+}
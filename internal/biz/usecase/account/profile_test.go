@@ -0,0 +1,149 @@
+package account
+
+import (
+	"testing"
+
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAttributeSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   []*conf.Account_Attribute
+		want    map[string]attributeType
+		wantErr bool
+	}{
+		{
+			name:  "should build schema",
+			attrs: []*conf.Account_Attribute{{Name: "department", Type: "string"}, {Name: "level", Type: "number"}},
+			want:  map[string]attributeType{"department": attributeTypeString, "level": attributeTypeNumber},
+		},
+		{
+			name:    "should fail on invalid name",
+			attrs:   []*conf.Account_Attribute{{Name: "costCenter", Type: "string"}},
+			wantErr: true,
+		},
+		{
+			name:    "should fail on duplicate name",
+			attrs:   []*conf.Account_Attribute{{Name: "level", Type: "number"}, {Name: "level", Type: "string"}},
+			wantErr: true,
+		},
+		{
+			name:    "should fail on unknown type",
+			attrs:   []*conf.Account_Attribute{{Name: "level", Type: "int"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newAttributeSchema(&conf.Account{Attributes: tt.attrs})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsecase_validateProfile(t *testing.T) {
+	uc := &Usecase{attributeSchema: map[string]attributeType{
+		"department": attributeTypeString,
+		"level":      attributeTypeNumber,
+		"contractor": attributeTypeBool,
+	}}
+	tests := []struct {
+		name    string
+		user    *repo.User
+		wantErr error
+	}{
+		{
+			name: "should accept valid profile",
+			user: &repo.User{
+				Phone:      "+8613800000000",
+				AvatarURL:  "https://example.com/a.png",
+				Locale:     "zh-CN",
+				Timezone:   "Asia/Shanghai",
+				Attributes: map[string]interface{}{"department": "rd", "level": float64(3), "contractor": false},
+			},
+		},
+		{
+			name: "should accept empty profile",
+			user: &repo.User{},
+		},
+		{
+			name:    "should reject invalid phone",
+			user:    &repo.User{Phone: "13800000000"},
+			wantErr: repo.ErrInvalidProfile,
+		},
+		{
+			name:    "should reject non-http avatar url",
+			user:    &repo.User{AvatarURL: "javascript:alert(1)"},
+			wantErr: repo.ErrInvalidProfile,
+		},
+		{
+			name:    "should reject invalid locale",
+			user:    &repo.User{Locale: "not a locale"},
+			wantErr: repo.ErrInvalidProfile,
+		},
+		{
+			name:    "should reject invalid timezone",
+			user:    &repo.User{Timezone: "Mars/Olympus"},
+			wantErr: repo.ErrInvalidProfile,
+		},
+		{
+			name:    "should reject undeclared attribute",
+			user:    &repo.User{Attributes: map[string]interface{}{"title": "cto"}},
+			wantErr: repo.ErrInvalidProfile,
+		},
+		{
+			name:    "should reject attribute of wrong type",
+			user:    &repo.User{Attributes: map[string]interface{}{"level": "3"}},
+			wantErr: repo.ErrInvalidProfile,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, uc.validateProfile(tt.user))
+		})
+	}
+}
+
+func TestUsecase_attributeFilter(t *testing.T) {
+	uc := &Usecase{attributeSchema: map[string]attributeType{
+		"department": attributeTypeString,
+		"level":      attributeTypeNumber,
+		"contractor": attributeTypeBool,
+	}}
+	filter := &repo.UserFilter{Attributes: map[string]interface{}{"department": "rd", "level": "3", "contractor": "true"}}
+	assert.NoError(t, uc.attributeFilter(filter))
+	assert.Equal(t, map[string]interface{}{"department": "rd", "level": float64(3), "contractor": true}, filter.Attributes)
+
+	assert.Equal(t, repo.ErrInvalidProfile, uc.attributeFilter(&repo.UserFilter{Attributes: map[string]interface{}{"level": "high"}}))
+	assert.Equal(t, repo.ErrInvalidProfile, uc.attributeFilter(&repo.UserFilter{Attributes: map[string]interface{}{"title": "cto"}}))
+	assert.NoError(t, uc.attributeFilter(nil))
+}
+
+func TestApplyUpdateMask(t *testing.T) {
+	dst := &repo.User{
+		Email:       "old@example.com",
+		DisplayName: "Old",
+		Attributes:  map[string]interface{}{"department": "rd", "level": float64(1)},
+	}
+	src := &repo.User{
+		Email:       "new@example.com",
+		DisplayName: "New",
+		Attributes:  map[string]interface{}{"level": float64(2)},
+	}
+	assert.NoError(t, applyUpdateMask(dst, src, []string{"display_name", "attributes.level", "attributes.department"}))
+	assert.Equal(t, "old@example.com", dst.Email)
+	assert.Equal(t, "New", dst.DisplayName)
+	assert.Equal(t, map[string]interface{}{"level": float64(2)}, dst.Attributes)
+
+	assert.Equal(t, repo.ErrInvalidProfile, applyUpdateMask(dst, src, []string{"password"}))
+	assert.Equal(t, repo.ErrInvalidProfile, applyUpdateMask(dst, src, []string{"attributes."}))
+}
@@ -0,0 +1,6 @@
+package account
+
+import "go.opentelemetry.io/otel"
+
+// 导出方法开始时创建 span，数据库语句的 span 作为其子 span
+var tracer = otel.Tracer("usm/internal/biz/usecase/account")
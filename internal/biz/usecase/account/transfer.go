@@ -0,0 +1,508 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"usm/internal/biz/repo"
+)
+
+type TransferFormat string
+
+const (
+	TransferFormatCSV    TransferFormat = "csv"
+	TransferFormatNDJSON TransferFormat = "ndjson"
+)
+
+func ParseTransferFormat(s string) (TransferFormat, error) {
+	switch f := TransferFormat(strings.ToLower(s)); f {
+	case "", TransferFormatCSV:
+		return TransferFormatCSV, nil
+	case TransferFormatNDJSON:
+		return f, nil
+	}
+	return "", fmt.Errorf("%w: unsupported format %q", repo.ErrInvalidImport, s)
+}
+
+// 导入导出文件中除用户资料外的字段
+const (
+	transferFieldUsername      = "username"
+	transferFieldPassword      = "password"
+	transferFieldPasswordHash  = "password_hash"
+	transferFieldEmailVerified = "email_verified"
+	transferFieldExternalID    = "external_id"
+	transferFieldDisabled      = "disabled"
+	// 以下字段只在导出时写出，导入时忽略，kind 只允许为 human
+	transferFieldKind       = "kind"
+	transferFieldSource     = "source"
+	transferFieldCreateTime = "create_time"
+)
+
+const (
+	importBatchSize = 500
+	exportPageSize  = 500
+	maxImportErrors = 1000
+)
+
+type ImportOptions struct {
+	Format TransferFormat
+	// Mapping 源列名到用户字段的映射，未映射的列按同名字段处理，映射为空字符串的列被忽略
+	Mapping map[string]string
+	// DryRun 只校验不写入
+	DryRun bool
+	// Upsert 按用户名更新已存在的用户，只更新文件中出现的字段，external_id 只在创建时设置
+	Upsert bool
+}
+
+// ImportReport 导入结果，Errors 最多保留 maxImportErrors 条
+type ImportReport struct {
+	Total   int
+	Created int
+	Updated int
+	Failed  int
+	Errors  []*ImportError
+	DryRun  bool
+}
+
+type ImportError struct {
+	Line     int
+	Username string
+	Err      error
+}
+
+func (r *ImportReport) fail(row *importRow, err error) {
+	r.Failed++
+	if len(r.Errors) < maxImportErrors {
+		r.Errors = append(r.Errors, &ImportError{Line: row.line, Username: row.user.Username, Err: err})
+	}
+}
+
+// importRow 导入文件中转换后的一行
+type importRow struct {
+	line int
+	user *repo.User
+	// hash 导入的密码哈希，与 user.Password 不能同时设置
+	hash string
+	// paths 行中出现的用户资料字段，更新已存在的用户时只更新这些字段
+	paths         []string
+	disabled      *bool
+	emailVerified *bool
+}
+
+// ImportUsers 从 CSV 或 NDJSON 流中导入用户，按批写入以支持大文件。
+// 单行错误记录在报告中，文件格式或字段映射错误时返回 ErrInvalidImport
+func (uc *Usecase) ImportUsers(ctx context.Context, r io.Reader, opts *ImportOptions) (*ImportReport, error) {
+	ctx, span := tracer.Start(ctx, "account.ImportUsers")
+	defer span.End()
+	for src, field := range opts.Mapping {
+		if field == "" {
+			continue
+		}
+		if err := uc.checkImportField(field); err != nil {
+			return nil, fmt.Errorf("%w: mapping of %q: %v", repo.ErrInvalidImport, src, err)
+		}
+	}
+	rr, err := newRecordReader(r, opts.Format)
+	if err != nil {
+		return nil, err
+	}
+	if cr, ok := rr.(*csvRecordReader); ok {
+		for _, name := range cr.header {
+			if field := importField(name, opts.Mapping); field != "" {
+				if err := uc.checkImportField(field); err != nil {
+					return nil, fmt.Errorf("%w: column %q: %v", repo.ErrInvalidImport, name, err)
+				}
+			}
+		}
+	}
+	report := &ImportReport{DryRun: opts.DryRun}
+	// 文件内重复的用户名和邮箱，值为第一次出现的行号
+	usernames := make(map[string]int)
+	emails := make(map[string]int)
+	batch := make([]*importRow, 0, importBatchSize)
+	for {
+		rec, err := rr.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		report.Total++
+		row, err := uc.importRowFromRecord(rec, opts.Mapping)
+		if err != nil {
+			report.fail(row, err)
+			continue
+		}
+		username, email := repo.IdentifierKey(row.user.Username), repo.IdentifierKey(row.user.Email)
+		if line, ok := usernames[username]; ok {
+			report.fail(row, fmt.Errorf("duplicate username of line %d", line))
+			continue
+		}
+		if line, ok := emails[email]; ok && email != "" {
+			report.fail(row, fmt.Errorf("duplicate email of line %d", line))
+			continue
+		}
+		usernames[username] = row.line
+		if email != "" {
+			emails[email] = row.line
+		}
+		if batch = append(batch, row); len(batch) == importBatchSize {
+			if err := uc.importBatch(ctx, batch, opts, report); err != nil {
+				return nil, err
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := uc.importBatch(ctx, batch, opts, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func importField(name string, mapping map[string]string) string {
+	if field, ok := mapping[name]; ok {
+		return field
+	}
+	return name
+}
+
+func (uc *Usecase) checkImportField(field string) error {
+	switch field {
+	case transferFieldUsername, transferFieldPassword, transferFieldPasswordHash, transferFieldEmailVerified,
+		transferFieldExternalID, transferFieldDisabled, transferFieldKind, transferFieldSource, transferFieldCreateTime,
+		UserFieldEmail, UserFieldDisplayName, UserFieldPhone, UserFieldAvatarURL, UserFieldLocale, UserFieldTimezone,
+		UserFieldAttributes:
+		return nil
+	}
+	name := strings.TrimPrefix(field, UserFieldAttributes+".")
+	if _, ok := uc.attributeSchema[name]; !ok || name == field {
+		return fmt.Errorf("unknown field %q", field)
+	}
+	return nil
+}
+
+func (uc *Usecase) importRowFromRecord(rec *importRecord, mapping map[string]string) (*importRow, error) {
+	row := &importRow{line: rec.line, user: &repo.User{}}
+	if rec.err != nil {
+		return row, rec.err
+	}
+	if s, ok := rec.fields[importSource(transferFieldUsername, mapping)].(string); ok {
+		row.user.Username = s
+	}
+	for src, v := range rec.fields {
+		field := importField(src, mapping)
+		if field == "" {
+			continue
+		}
+		if err := uc.setImportField(row, field, v); err != nil {
+			return row, fmt.Errorf("%s: %v", src, err)
+		}
+	}
+	sort.Strings(row.paths)
+	if row.user.Username == "" {
+		return row, fmt.Errorf("username is required")
+	}
+	if row.user.Password != "" && row.hash != "" {
+		return row, fmt.Errorf("password and password_hash can not be both set")
+	}
+	if row.hash != "" {
+		if err := validatePasswordHash(row.hash); err != nil {
+			return row, err
+		}
+	}
+	return row, normalizeUser(row.user)
+}
+
+// importSource 返回映射到 field 的源列名
+func importSource(field string, mapping map[string]string) string {
+	for src, f := range mapping {
+		if f == field {
+			return src
+		}
+	}
+	return field
+}
+
+func (uc *Usecase) setImportField(row *importRow, field string, v interface{}) error {
+	u := row.user
+	switch field {
+	case transferFieldUsername, transferFieldPassword, transferFieldPasswordHash, transferFieldExternalID:
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("must be a string")
+		}
+		switch field {
+		case transferFieldUsername:
+			u.Username = s
+		case transferFieldPassword:
+			u.Password = s
+		case transferFieldPasswordHash:
+			row.hash = s
+		case transferFieldExternalID:
+			u.ExternalID = s
+		}
+	case UserFieldEmail, UserFieldDisplayName, UserFieldPhone, UserFieldAvatarURL, UserFieldLocale, UserFieldTimezone:
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("must be a string")
+		}
+		switch field {
+		case UserFieldEmail:
+			u.Email = s
+		case UserFieldDisplayName:
+			u.DisplayName = s
+		case UserFieldPhone:
+			u.Phone = s
+		case UserFieldAvatarURL:
+			u.AvatarURL = s
+		case UserFieldLocale:
+			u.Locale = s
+		case UserFieldTimezone:
+			u.Timezone = s
+		}
+		row.paths = append(row.paths, field)
+	case transferFieldDisabled, transferFieldEmailVerified:
+		b, err := boolValue(v)
+		if err != nil {
+			return err
+		}
+		if field == transferFieldDisabled {
+			u.Disabled, row.disabled = b, &b
+		} else {
+			u.EmailVerified, row.emailVerified = b, &b
+		}
+	case transferFieldKind:
+		if v != string(repo.UserKindHuman) {
+			return fmt.Errorf("only human users can be imported")
+		}
+	case transferFieldSource, transferFieldCreateTime:
+	case UserFieldAttributes:
+		attrs, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("must be an object")
+		}
+		for name, v := range attrs {
+			if err := uc.setImportAttribute(row, name, v); err != nil {
+				return err
+			}
+		}
+	default:
+		return uc.setImportAttribute(row, strings.TrimPrefix(field, UserFieldAttributes+"."), v)
+	}
+	return nil
+}
+
+func (uc *Usecase) setImportAttribute(row *importRow, name string, v interface{}) error {
+	t, ok := uc.attributeSchema[name]
+	if !ok {
+		return fmt.Errorf("unknown attribute %q", name)
+	}
+	if v, ok = parseAttributeValue(t, v); !ok {
+		return fmt.Errorf("attribute %q must be a %s", name, t)
+	}
+	if row.user.Attributes == nil {
+		row.user.Attributes = make(map[string]interface{})
+	}
+	row.user.Attributes[name] = v
+	row.paths = append(row.paths, UserFieldAttributes+"."+name)
+	return nil
+}
+
+func boolValue(v interface{}) (bool, error) {
+	switch v := v.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("must be a boolean")
+		}
+		return b, nil
+	}
+	return false, fmt.Errorf("must be a boolean")
+}
+
+// importBatch 导入一批用户，只有查询或写入失败时返回错误
+func (uc *Usecase) importBatch(ctx context.Context, rows []*importRow, opts *ImportOptions, report *ImportReport) error {
+	creates := make([]*importRow, 0, len(rows))
+	for _, row := range rows {
+		if !opts.Upsert {
+			creates = append(creates, row)
+			continue
+		}
+		cur, err := uc.userRepo.GetByUsername(ctx, row.user.Username)
+		if err == repo.ErrResourceNotFound {
+			creates = append(creates, row)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := uc.importUpdate(ctx, cur, row, opts.DryRun); err != nil {
+			report.fail(row, err)
+			continue
+		}
+		report.Updated++
+	}
+	if len(creates) == 0 {
+		return nil
+	}
+	if opts.DryRun {
+		for _, row := range creates {
+			err := uc.prepareImportedUser(ctx, row)
+			if err == nil {
+				var exists bool
+				if exists, err = uc.userExists(ctx, row.user); err != nil {
+					return err
+				}
+				if exists {
+					err = repo.ErrResourceAlreadyExists
+				}
+			}
+			if err != nil {
+				report.fail(row, err)
+				continue
+			}
+			report.Created++
+		}
+		return nil
+	}
+	users := make([]*repo.User, 0, len(creates))
+	byUser := make(map[*repo.User]*importRow, len(creates))
+	for _, row := range creates {
+		users = append(users, row.user)
+		byUser[row.user] = row
+	}
+	results, err := uc.batchCreateUsers(ctx, users, false, func(ctx context.Context, u *repo.User) error {
+		return uc.prepareImportedUser(ctx, byUser[u])
+	})
+	if err != nil {
+		return err
+	}
+	uc.usersCreated(repo.UserOriginImport, createdCount(results))
+	for i, r := range results {
+		if r.Err != nil {
+			report.fail(creates[i], r.Err)
+			continue
+		}
+		report.Created++
+	}
+	return nil
+}
+
+// prepareImportedUser 导入的密码哈希不检查密码复杂度
+func (uc *Usecase) prepareImportedUser(ctx context.Context, row *importRow) error {
+	if row.hash == "" {
+		return uc.prepareUser(ctx, row.user)
+	}
+	row.user.Password = ""
+	if err := uc.prepareUser(ctx, row.user); err != nil {
+		return err
+	}
+	row.user.Password = row.hash
+	return nil
+}
+
+// importUpdate 更新已存在的用户，用户名保持不变
+func (uc *Usecase) importUpdate(ctx context.Context, cur *repo.User, row *importRow, dryRun bool) error {
+	if cur.IsServiceAccount() {
+		return repo.ErrHumanOnly
+	}
+	password := row.hash
+	if row.user.Password != "" {
+		if err := uc.passwordPolicy.check(row.user.Password); err != nil {
+			return err
+		}
+		password = row.user.Password
+	}
+	if password != "" && cur.IsExternal() {
+		return repo.ErrExternalUser
+	}
+	u := *cur
+	if err := applyUpdateMask(&u, row.user, row.paths); err != nil {
+		return err
+	}
+	if err := uc.validateProfile(&u); err != nil {
+		return err
+	}
+	if dryRun {
+		if u.Email == "" || repo.IdentifierKey(u.Email) == repo.IdentifierKey(cur.Email) {
+			return nil
+		}
+		other, err := uc.userRepo.GetByEmail(ctx, u.Email)
+		if err == nil && other.ID != cur.ID {
+			return repo.ErrEmailAlreadyExists
+		}
+		return ignoreNotFound(err)
+	}
+	return uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		if len(row.paths) > 0 {
+			if _, err := uc.userRepo.Update(ctx, &u); err != nil {
+				return err
+			}
+		}
+		if row.disabled != nil && *row.disabled != cur.Disabled {
+			var err error
+			if *row.disabled {
+				err = uc.userRepo.Disable(ctx, cur.ID)
+			} else {
+				err = uc.userRepo.Enable(ctx, cur.ID)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if password != "" {
+			if err := uc.userRepo.SetPassword(ctx, cur.ID, password); err != nil {
+				return err
+			}
+		}
+		if row.emailVerified != nil {
+			return uc.userRepo.SetEmailVerified(ctx, cur.ID, *row.emailVerified)
+		}
+		return nil
+	})
+}
+
+// ExportUsers 按 ID 顺序分页导出符合条件的用户，返回导出的用户数，不导出密码
+func (uc *Usecase) ExportUsers(ctx context.Context, w io.Writer, filter *repo.UserFilter, format TransferFormat) (int, error) {
+	ctx, span := tracer.Start(ctx, "account.ExportUsers")
+	defer span.End()
+	f := repo.UserFilter{}
+	if filter != nil {
+		f = *filter
+	}
+	if err := uc.attributeFilter(&f); err != nil {
+		return 0, err
+	}
+	enc, err := newUserEncoder(w, format, uc.attributeNames())
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for {
+		us, err := uc.userRepo.List(ctx, &f, 0, exportPageSize)
+		if err != nil {
+			return n, err
+		}
+		for _, u := range us {
+			if err := enc.encode(u); err != nil {
+				return n, err
+			}
+			n++
+		}
+		if len(us) < exportPageSize {
+			break
+		}
+		f.AfterID = us[len(us)-1].ID
+		if err := enc.flush(); err != nil {
+			return n, err
+		}
+	}
+	return n, enc.flush()
+}
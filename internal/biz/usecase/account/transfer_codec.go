@@ -0,0 +1,245 @@
+package account
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"usm/internal/biz/repo"
+)
+
+const maxNDJSONLineSize = 1 << 20
+
+// importRecord 导入文件中的一行，字段名为源文件中的列名
+type importRecord struct {
+	line   int
+	fields map[string]interface{}
+	// err 不为空表示该行无法解析
+	err error
+}
+
+type recordReader interface {
+	// next 读取下一行，没有更多数据时返回 io.EOF，返回其他错误时导入中止
+	next() (*importRecord, error)
+}
+
+func newRecordReader(r io.Reader, format TransferFormat) (recordReader, error) {
+	switch format {
+	case TransferFormatCSV, "":
+		return newCSVRecordReader(r)
+	case TransferFormatNDJSON:
+		s := bufio.NewScanner(r)
+		s.Buffer(make([]byte, 64*1024), maxNDJSONLineSize)
+		return &ndjsonRecordReader{s: s}, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported format %q", repo.ErrInvalidImport, format)
+}
+
+type csvRecordReader struct {
+	r      *csv.Reader
+	header []string
+}
+
+// newCSVRecordReader 第一行为列名
+func newCSVRecordReader(r io.Reader) (*csvRecordReader, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w: missing csv header", repo.ErrInvalidImport)
+	}
+	if err != nil {
+		return nil, csvError(err)
+	}
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	for i, name := range header {
+		header[i] = strings.TrimSpace(name)
+	}
+	return &csvRecordReader{r: cr, header: header}, nil
+}
+
+func (r *csvRecordReader) next() (*importRecord, error) {
+	row, err := r.r.Read()
+	if err == io.EOF {
+		return nil, err
+	}
+	var perr *csv.ParseError
+	if errors.As(err, &perr) && perr.Err == csv.ErrFieldCount {
+		return &importRecord{line: perr.StartLine, err: fmt.Errorf("expected %d columns, got %d", len(r.header), len(row))}, nil
+	}
+	if err != nil {
+		return nil, csvError(err)
+	}
+	line, _ := r.r.FieldPos(0)
+	rec := &importRecord{line: line, fields: make(map[string]interface{}, len(row))}
+	for i, v := range row {
+		// 空值视为未提供
+		if v != "" {
+			rec.fields[r.header[i]] = v
+		}
+	}
+	return rec, nil
+}
+
+type ndjsonRecordReader struct {
+	s    *bufio.Scanner
+	line int
+}
+
+func (r *ndjsonRecordReader) next() (*importRecord, error) {
+	for r.s.Scan() {
+		r.line++
+		b := bytes.TrimSpace(r.s.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+		rec := &importRecord{line: r.line}
+		if err := json.Unmarshal(b, &rec.fields); err != nil {
+			rec.err = fmt.Errorf("invalid json: %v", err)
+		}
+		for k, v := range rec.fields {
+			if v == nil || v == "" {
+				delete(rec.fields, k)
+			}
+		}
+		return rec, nil
+	}
+	if err := r.s.Err(); err != nil {
+		if err == bufio.ErrTooLong {
+			return nil, fmt.Errorf("%w: line %d is longer than %d bytes", repo.ErrInvalidImport, r.line+1, maxNDJSONLineSize)
+		}
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// csvError 文件格式错误时整个导入失败，读取错误原样返回
+func csvError(err error) error {
+	var perr *csv.ParseError
+	if errors.As(err, &perr) {
+		return fmt.Errorf("%w: %v", repo.ErrInvalidImport, err)
+	}
+	return err
+}
+
+// 导出的列，attributes.<name> 列按属性名排序追加在最后
+var exportColumns = []string{
+	transferFieldUsername, UserFieldEmail, transferFieldEmailVerified, UserFieldDisplayName, UserFieldPhone,
+	UserFieldAvatarURL, UserFieldLocale, UserFieldTimezone, transferFieldExternalID, transferFieldDisabled,
+	transferFieldKind, transferFieldSource, transferFieldCreateTime,
+}
+
+type userEncoder interface {
+	encode(u *repo.User) error
+	flush() error
+}
+
+func newUserEncoder(w io.Writer, format TransferFormat, attributes []string) (userEncoder, error) {
+	switch format {
+	case TransferFormatCSV, "":
+		e := &csvUserEncoder{w: csv.NewWriter(w), attributes: attributes}
+		header := append([]string{}, exportColumns...)
+		for _, name := range attributes {
+			header = append(header, UserFieldAttributes+"."+name)
+		}
+		return e, e.w.Write(header)
+	case TransferFormatNDJSON:
+		return &ndjsonUserEncoder{w: bufio.NewWriter(w)}, nil
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+type csvUserEncoder struct {
+	w          *csv.Writer
+	attributes []string
+}
+
+func (e *csvUserEncoder) encode(u *repo.User) error {
+	row := []string{
+		u.Username, u.Email, strconv.FormatBool(u.EmailVerified), u.DisplayName, u.Phone,
+		u.AvatarURL, u.Locale, u.Timezone, u.ExternalID, strconv.FormatBool(u.Disabled),
+		string(u.Kind), u.Source, u.CreateTime.UTC().Format(time.RFC3339),
+	}
+	for _, name := range e.attributes {
+		v, ok := u.Attributes[name]
+		if !ok {
+			row = append(row, "")
+			continue
+		}
+		row = append(row, fmt.Sprint(v))
+	}
+	return e.w.Write(row)
+}
+
+func (e *csvUserEncoder) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type ndjsonUserEncoder struct {
+	w *bufio.Writer
+}
+
+// exportedUser NDJSON 导出格式，字段名与 CSV 列名相同，可以直接再次导入
+type exportedUser struct {
+	Username      string                 `json:"username"`
+	Email         string                 `json:"email,omitempty"`
+	EmailVerified bool                   `json:"email_verified"`
+	DisplayName   string                 `json:"display_name,omitempty"`
+	Phone         string                 `json:"phone,omitempty"`
+	AvatarURL     string                 `json:"avatar_url,omitempty"`
+	Locale        string                 `json:"locale,omitempty"`
+	Timezone      string                 `json:"timezone,omitempty"`
+	ExternalID    string                 `json:"external_id,omitempty"`
+	Disabled      bool                   `json:"disabled"`
+	Kind          string                 `json:"kind"`
+	Source        string                 `json:"source,omitempty"`
+	CreateTime    string                 `json:"create_time"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+}
+
+func (e *ndjsonUserEncoder) encode(u *repo.User) error {
+	b, err := json.Marshal(&exportedUser{
+		Username:      u.Username,
+		Email:         u.Email,
+		EmailVerified: u.EmailVerified,
+		DisplayName:   u.DisplayName,
+		Phone:         u.Phone,
+		AvatarURL:     u.AvatarURL,
+		Locale:        u.Locale,
+		Timezone:      u.Timezone,
+		ExternalID:    u.ExternalID,
+		Disabled:      u.Disabled,
+		Kind:          string(u.Kind),
+		Source:        u.Source,
+		CreateTime:    u.CreateTime.UTC().Format(time.RFC3339),
+		Attributes:    u.Attributes,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := e.w.Write(b); err != nil {
+		return err
+	}
+	return e.w.WriteByte('\n')
+}
+
+func (e *ndjsonUserEncoder) flush() error {
+	return e.w.Flush()
+}
+
+func (uc *Usecase) attributeNames() []string {
+	names := make([]string, 0, len(uc.attributeSchema))
+	for name := range uc.attributeSchema {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
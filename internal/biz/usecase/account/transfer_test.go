@@ -0,0 +1,216 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"usm/internal/biz/repo"
+	"usm/internal/biz/repo/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUsecase_ImportUsers(t *testing.T) {
+	ctx := context.Background()
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("Secret@123"), bcrypt.MinCost)
+	assert.NoError(t, err)
+	existing := &repo.User{ID: 1, Username: "carol", Email: "carol@example.com", Kind: repo.UserKindHuman, DisplayName: "Carol"}
+	tests := []struct {
+		name        string
+		input       string
+		opts        *ImportOptions
+		wantCreated []string
+		wantUpdated *repo.User
+		wantReport  *ImportReport
+		wantErr     error
+	}{
+		{
+			name: "should create users from csv with mapping",
+			input: "login,email,dept,password_hash\n" +
+				"alice,Alice@Example.com,rd,\n" +
+				"a,a@example.com,rd,\n" +
+				"ALICE,,,\n" +
+				"bob,,qa," + string(bcryptHash) + "\n" +
+				"dave,,qa,$2a$not-a-hash\n",
+			opts:        &ImportOptions{Mapping: map[string]string{"login": "username", "dept": "attributes.department"}},
+			wantCreated: []string{"alice", "bob"},
+			wantReport: &ImportReport{Total: 5, Created: 2, Failed: 3, Errors: []*ImportError{
+				{Line: 3, Username: "a", Err: repo.ErrInvalidUsername},
+				{Line: 4, Username: "ALICE", Err: errors.New("duplicate username of line 2")},
+				{Line: 6, Username: "dave"},
+			}},
+		},
+		{
+			name: "should create users from ndjson",
+			input: `{"username":"alice","email":"alice@example.com","disabled":true,"attributes":{"level":3}}` + "\n\n" +
+				`{"username":"bob","attributes":{"level":"high"}}` + "\n" +
+				`{"username":` + "\n",
+			opts:        &ImportOptions{Format: TransferFormatNDJSON},
+			wantCreated: []string{"alice"},
+			wantReport: &ImportReport{Total: 3, Created: 1, Failed: 2, Errors: []*ImportError{
+				{Line: 3, Username: "bob"},
+				{Line: 4},
+			}},
+		},
+		{
+			name:       "should only validate in dry run",
+			input:      "username,email\nalice,alice@example.com\ncarol,\n",
+			opts:       &ImportOptions{DryRun: true},
+			wantReport: &ImportReport{Total: 2, Created: 1, Failed: 1, DryRun: true, Errors: []*ImportError{{Line: 3, Username: "carol", Err: repo.ErrResourceAlreadyExists}}},
+		},
+		{
+			name:        "should update existing users in upsert mode",
+			input:       "username,display_name,external_id\ncarol,Carol C,ext-1\nalice,Alice,ext-2\n",
+			opts:        &ImportOptions{Upsert: true},
+			wantCreated: []string{"alice"},
+			wantUpdated: &repo.User{ID: 1, Username: "carol", Email: "carol@example.com", Kind: repo.UserKindHuman, DisplayName: "Carol C"},
+			wantReport:  &ImportReport{Total: 2, Created: 1, Updated: 1, Errors: nil},
+		},
+		{
+			name:    "should fail on unknown column",
+			input:   "username,nickname\nalice,al\n",
+			opts:    &ImportOptions{},
+			wantErr: repo.ErrInvalidImport,
+		},
+		{
+			name:    "should fail on unknown mapping target",
+			input:   "username\nalice\n",
+			opts:    &ImportOptions{Mapping: map[string]string{"username": "login"}},
+			wantErr: repo.ErrInvalidImport,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockTran := mock.NewMockTransaction(ctrl)
+			mockTran.EXPECT().WithTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			})
+			mockRepo := mock.NewMockUserRepo(ctrl)
+			mockRepo.EXPECT().GetByUsername(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, username string) (*repo.User, error) {
+				if username == existing.Username {
+					c := *existing
+					return &c, nil
+				}
+				return nil, repo.ErrResourceNotFound
+			})
+			mockRepo.EXPECT().GetByEmail(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, repo.ErrResourceNotFound)
+			var created []string
+			mockRepo.EXPECT().CreateBulk(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, us []*repo.User) ([]*repo.User, error) {
+				for _, u := range us {
+					created = append(created, u.Username)
+				}
+				return us, nil
+			})
+			var updated *repo.User
+			mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, u *repo.User) (*repo.User, error) {
+				updated = u
+				return u, nil
+			})
+			uc := &Usecase{
+				tran:            mockTran,
+				userRepo:        mockRepo,
+				attributeSchema: map[string]attributeType{"department": attributeTypeString, "level": attributeTypeNumber},
+			}
+
+			report, err := uc.ImportUsers(ctx, strings.NewReader(tt.input), tt.opts)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "error=%v, wantErr=%v", err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCreated, created)
+			assert.Equal(t, tt.wantUpdated, updated)
+			assert.Equal(t, tt.wantReport.Total, report.Total)
+			assert.Equal(t, tt.wantReport.Created, report.Created)
+			assert.Equal(t, tt.wantReport.Updated, report.Updated)
+			assert.Equal(t, tt.wantReport.Failed, report.Failed)
+			assert.Equal(t, tt.wantReport.DryRun, report.DryRun)
+			assert.Equal(t, len(tt.wantReport.Errors), len(report.Errors))
+			for i, e := range report.Errors {
+				want := tt.wantReport.Errors[i]
+				assert.Equal(t, want.Line, e.Line)
+				assert.Equal(t, want.Username, e.Username)
+				if want.Err != nil {
+					assert.Equal(t, want.Err.Error(), e.Err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestUsecase_ImportUsersPasswordHash(t *testing.T) {
+	ctx := context.Background()
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("short"), bcrypt.MinCost)
+	assert.NoError(t, err)
+	ctrl := gomock.NewController(t)
+	mockRepo := mock.NewMockUserRepo(ctrl)
+	var created []*repo.User
+	mockRepo.EXPECT().CreateBulk(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, us []*repo.User) ([]*repo.User, error) {
+		created = us
+		return us, nil
+	})
+	uc := &Usecase{userRepo: mockRepo, passwordPolicy: passwordPolicy{minLength: 8}}
+
+	input := "username,password,password_hash\nalice,,\nbob,short,\ncarol,," + string(bcryptHash) + "\n"
+	report, err := uc.ImportUsers(ctx, strings.NewReader(input), &ImportOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Created)
+	assert.Equal(t, 1, report.Failed)
+	// 导入的哈希不检查密码复杂度，原样保存
+	assert.Equal(t, "", created[0].Password)
+	assert.Equal(t, string(bcryptHash), created[1].Password)
+	assert.True(t, checkPassword(created[1].Password, "short"))
+}
+
+func TestUsecase_ExportUsers(t *testing.T) {
+	ctx := context.Background()
+	createTime := time.Date(2022, 6, 1, 8, 0, 0, 0, time.UTC)
+	users := []*repo.User{
+		{ID: 1, Username: "alice", Email: "alice@example.com", Password: "Secret@123", Kind: repo.UserKindHuman, Source: repo.UserSourceLocal,
+			CreateTime: createTime, Attributes: map[string]interface{}{"level": float64(3)}},
+		{ID: 2, Username: "bob", Kind: repo.UserKindHuman, Disabled: true, CreateTime: createTime},
+	}
+	tests := []struct {
+		name   string
+		format TransferFormat
+		want   string
+	}{
+		{
+			name:   "should export csv",
+			format: TransferFormatCSV,
+			want: "username,email,email_verified,display_name,phone,avatar_url,locale,timezone,external_id,disabled,kind,source,create_time,attributes.department,attributes.level\n" +
+				"alice,alice@example.com,false,,,,,,,false,human,local,2022-06-01T08:00:00Z,,3\n" +
+				"bob,,false,,,,,,,true,human,,2022-06-01T08:00:00Z,,\n",
+		},
+		{
+			name:   "should export ndjson",
+			format: TransferFormatNDJSON,
+			want: `{"username":"alice","email":"alice@example.com","email_verified":false,"disabled":false,"kind":"human","source":"local","create_time":"2022-06-01T08:00:00Z","attributes":{"level":3}}` + "\n" +
+				`{"username":"bob","email_verified":false,"disabled":true,"kind":"human","create_time":"2022-06-01T08:00:00Z"}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := mock.NewMockUserRepo(ctrl)
+			mockRepo.EXPECT().List(gomock.Any(), &repo.UserFilter{Kind: repo.UserKindHuman}, 0, exportPageSize).Return(users, nil)
+			uc := &Usecase{
+				userRepo:        mockRepo,
+				attributeSchema: map[string]attributeType{"department": attributeTypeString, "level": attributeTypeNumber},
+			}
+
+			var buf bytes.Buffer
+			n, err := uc.ExportUsers(ctx, &buf, &repo.UserFilter{Kind: repo.UserKindHuman}, tt.format)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, n)
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
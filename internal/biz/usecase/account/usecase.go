@@ -2,50 +2,372 @@ package account
 
 import (
 	"context"
+	"sync/atomic"
+	"time"
 
 	"usm/internal/biz/repo"
+	"usm/internal/conf"
 )
 
 type Usecase struct {
-	tran     repo.Transaction
-	userRepo repo.UserRepo
+	tran             repo.Transaction
+	userRepo         repo.UserRepo
+	groupRepo        repo.GroupRepo
+	apiKeyRepo       repo.APIKeyRepo
+	verificationRepo repo.EmailVerificationRepo
+	resetRepo        repo.PasswordResetRepo
+	tokenRepo        repo.OAuthTokenRepo
+	invitationRepo   repo.InvitationRepo
 	// other repos...
+
+	authenticators []repo.Authenticator
+	mailer         repo.Mailer
+	notifier       repo.Notifier
+	metrics        repo.Metrics
+
+	passwordPolicy  passwordPolicy
+	attributeSchema map[string]attributeType
+	verificationURL string
+	resetURL        string
+	invitationURL   string
+	ttls            atomic.Value // tokenTTLs
 }
 
-func NewUsecase(tran repo.Transaction, userRepo repo.UserRepo) *Usecase {
-	return &Usecase{
-		tran:     tran,
-		userRepo: userRepo,
+func NewUsecase(c *conf.Auth, ac *conf.Account, tran repo.Transaction, userRepo repo.UserRepo, groupRepo repo.GroupRepo, apiKeyRepo repo.APIKeyRepo,
+	verificationRepo repo.EmailVerificationRepo, resetRepo repo.PasswordResetRepo, tokenRepo repo.OAuthTokenRepo,
+	invitationRepo repo.InvitationRepo, mailer repo.Mailer, notifier repo.Notifier, external repo.ExternalAuthenticators,
+	metrics repo.Metrics) (*Usecase, error) {
+	attributeSchema, err := newAttributeSchema(ac)
+	if err != nil {
+		return nil, err
+	}
+	identifiers, err := newLoginIdentifiers(c.GetLoginIdentifiers())
+	if err != nil {
+		return nil, err
+	}
+	authenticators := []repo.Authenticator{&passwordAuthenticator{userRepo: userRepo, identifiers: identifiers}}
+	uc := &Usecase{
+		tran:             tran,
+		userRepo:         userRepo,
+		groupRepo:        groupRepo,
+		apiKeyRepo:       apiKeyRepo,
+		verificationRepo: verificationRepo,
+		resetRepo:        resetRepo,
+		tokenRepo:        tokenRepo,
+		invitationRepo:   invitationRepo,
+		authenticators:   append(authenticators, external...),
+		mailer:           mailer,
+		notifier:         notifier,
+		metrics:          metrics,
+		passwordPolicy:   newPasswordPolicy(c.GetPasswordPolicy()),
+		attributeSchema:  attributeSchema,
+		verificationURL:  c.GetEmailVerification().GetUrl(),
+		resetURL:         c.GetPasswordReset().GetUrl(),
+		invitationURL:    c.GetInvitation().GetUrl(),
 	}
+	uc.SetTokenTTLs(c)
+	return uc, nil
+}
+
+// tokenTTLs 邮箱验证、密码重置和邀请令牌的有效期，可以在运行时修改
+type tokenTTLs struct {
+	verification time.Duration
+	reset        time.Duration
+	invitation   time.Duration
+}
+
+// SetTokenTTLs 修改令牌有效期，只影响之后创建的令牌，没有设置的使用默认值
+func (uc *Usecase) SetTokenTTLs(c *conf.Auth) {
+	ttls := tokenTTLs{
+		verification: defaultVerificationTTL,
+		reset:        defaultPasswordResetTTL,
+		invitation:   defaultInvitationTTL,
+	}
+	if d := c.GetEmailVerification().GetTokenTtl(); d != nil {
+		ttls.verification = d.AsDuration()
+	}
+	if d := c.GetPasswordReset().GetTokenTtl(); d != nil {
+		ttls.reset = d.AsDuration()
+	}
+	if d := c.GetInvitation().GetTokenTtl(); d != nil {
+		ttls.invitation = d.AsDuration()
+	}
+	uc.ttls.Store(ttls)
+}
+
+func (uc *Usecase) tokenTTLs() tokenTTLs {
+	return uc.ttls.Load().(tokenTTLs)
 }
 
 func (uc *Usecase) CreateUser(ctx context.Context, user *repo.User) (*repo.User, error) {
-	return uc.userRepo.Create(ctx, user)
+	ctx, span := tracer.Start(ctx, "account.CreateUser")
+	defer span.End()
+	if err := uc.prepareUser(ctx, user); err != nil {
+		return nil, err
+	}
+	u, err := uc.userRepo.Create(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	uc.usersCreated(repo.UserOriginAPI, 1)
+	return u, nil
+}
+
+// prepareUser 创建用户前的规范化和校验
+func (uc *Usecase) prepareUser(ctx context.Context, user *repo.User) error {
+	if err := normalizeUser(user); err != nil {
+		return err
+	}
+	if user.Kind == "" {
+		user.Kind = repo.UserKindHuman
+	}
+	switch user.Kind {
+	case repo.UserKindHuman:
+		if user.OwnerKind != "" || user.OwnerID != 0 {
+			return repo.ErrInvalidOwner
+		}
+	case repo.UserKindService:
+		if err := uc.checkOwner(ctx, user.OwnerKind, user.OwnerID); err != nil {
+			return err
+		}
+		// 服务账号不允许使用密码登录
+		user.Password = ""
+	}
+	if user.Password != "" {
+		if err := uc.passwordPolicy.check(user.Password); err != nil {
+			return err
+		}
+	}
+	return uc.validateProfile(user)
+}
+
+// UpdateUser 更新 paths 指定的字段，paths 为空时只更新邮箱
+func (uc *Usecase) UpdateUser(ctx context.Context, user *repo.User, paths []string) (*repo.User, error) {
+	ctx, span := tracer.Start(ctx, "account.UpdateUser")
+	defer span.End()
+	if len(paths) == 0 {
+		paths = []string{UserFieldEmail}
+	}
+	user.Email = repo.CanonicalIdentifier(user.Email)
+	var u *repo.User
+	err := uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		cur, err := uc.userRepo.Get(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		if err := applyUpdateMask(cur, user, paths); err != nil {
+			return err
+		}
+		if err := uc.validateProfile(cur); err != nil {
+			return err
+		}
+		u, err = uc.userRepo.Update(ctx, cur)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
 }
 
-func (uc *Usecase) UpdateUser(ctx context.Context, user *repo.User) (*repo.User, error) {
-	return uc.userRepo.Update(ctx, user)
+// ReplaceUser 整体更新用户，包括启用状态，Password 不为空时同时设置密码，用户资料保持不变
+func (uc *Usecase) ReplaceUser(ctx context.Context, user *repo.User) (*repo.User, error) {
+	ctx, span := tracer.Start(ctx, "account.ReplaceUser")
+	defer span.End()
+	if err := normalizeUser(user); err != nil {
+		return nil, err
+	}
+	if user.Password != "" {
+		if err := uc.passwordPolicy.check(user.Password); err != nil {
+			return nil, err
+		}
+	}
+	var u *repo.User
+	err := uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		cur, err := uc.userRepo.Get(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		if cur.IsServiceAccount() {
+			return repo.ErrHumanOnly
+		}
+		if user.Password != "" && cur.IsExternal() {
+			return repo.ErrExternalUser
+		}
+		user.DisplayName, user.Phone, user.AvatarURL = cur.DisplayName, cur.Phone, cur.AvatarURL
+		user.Locale, user.Timezone, user.Attributes = cur.Locale, cur.Timezone, cur.Attributes
+		if _, err := uc.userRepo.Update(ctx, user); err != nil {
+			return err
+		}
+		if user.Disabled != cur.Disabled {
+			if user.Disabled {
+				err = uc.userRepo.Disable(ctx, user.ID)
+			} else {
+				err = uc.userRepo.Enable(ctx, user.ID)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if user.Password != "" {
+			if err := uc.userRepo.SetPassword(ctx, user.ID, user.Password); err != nil {
+				return err
+			}
+		}
+		u, err = uc.userRepo.Get(ctx, user.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
 }
 
 func (uc *Usecase) DeleteUser(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "account.DeleteUser")
+	defer span.End()
 	return uc.userRepo.Delete(ctx, id)
 }
 
 func (uc *Usecase) GetUser(ctx context.Context, id int) (*repo.User, error) {
+	ctx, span := tracer.Start(ctx, "account.GetUser")
+	defer span.End()
 	return uc.userRepo.Get(ctx, id)
 }
 
-func (uc *Usecase) ListUsers(ctx context.Context, offset, limit int) ([]*repo.User, error) {
-	return uc.userRepo.List(ctx, offset, limit)
+func (uc *Usecase) ListUsers(ctx context.Context, filter *repo.UserFilter, offset, limit int) ([]*repo.User, error) {
+	ctx, span := tracer.Start(ctx, "account.ListUsers")
+	defer span.End()
+	if err := uc.attributeFilter(filter); err != nil {
+		return nil, err
+	}
+	return uc.userRepo.List(ctx, filter, offset, limit)
+}
+
+func (uc *Usecase) CountUsers(ctx context.Context, filter *repo.UserFilter) (int, error) {
+	ctx, span := tracer.Start(ctx, "account.CountUsers")
+	defer span.End()
+	if err := uc.attributeFilter(filter); err != nil {
+		return 0, err
+	}
+	return uc.userRepo.Count(ctx, filter)
 }
 
 func (uc *Usecase) SetUserPassword(ctx context.Context, id int, password string) error {
+	ctx, span := tracer.Start(ctx, "account.SetUserPassword")
+	defer span.End()
+	if err := uc.passwordPolicy.check(password); err != nil {
+		return err
+	}
 	// transaction example
 	return uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		u, err := uc.userRepo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if u.IsServiceAccount() {
+			return repo.ErrHumanOnly
+		}
+		if u.IsExternal() {
+			return repo.ErrExternalUser
+		}
 		return uc.userRepo.SetPassword(ctx, id, password)
 	})
 }
 
 func (uc *Usecase) GetUserByUsername(ctx context.Context, username string) (*repo.User, error) {
+	ctx, span := tracer.Start(ctx, "account.GetUserByUsername")
+	defer span.End()
 	return uc.userRepo.GetByUsername(ctx, username)
 }
+
+// AuthenticateBasic 使用用户名密码认证，依次尝试本地密码和外部认证后端，服务账号不允许使用密码登录
+func (uc *Usecase) AuthenticateBasic(ctx context.Context, username, password string) (*repo.User, error) {
+	ctx, span := tracer.Start(ctx, "account.AuthenticateBasic")
+	defer span.End()
+	u, err := uc.authenticateBasic(ctx, username, password)
+	uc.loginAttempted(repo.LoginMethodBasic, err)
+	return u, err
+}
+
+func (uc *Usecase) authenticateBasic(ctx context.Context, username, password string) (*repo.User, error) {
+	for _, a := range uc.authenticators {
+		id, err := a.Authenticate(ctx, username, password)
+		if err == repo.ErrInvalidCredentials {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return uc.userFromIdentity(ctx, id)
+	}
+	return nil, repo.ErrInvalidCredentials
+}
+
+// userFromIdentity 返回身份对应的本地用户，外部用户首次登录时自动创建，之后同步邮箱
+func (uc *Usecase) userFromIdentity(ctx context.Context, id *repo.Identity) (*repo.User, error) {
+	u, err := uc.userRepo.GetByUsername(ctx, id.Username)
+	if err == repo.ErrResourceNotFound && id.Source != repo.UserSourceLocal {
+		// 外部认证源的用户名不做字符校验，只做规范化
+		u, err = uc.userRepo.Create(ctx, &repo.User{
+			Username: repo.CanonicalIdentifier(id.Username),
+			Email:    repo.CanonicalIdentifier(id.Email),
+			Kind:     repo.UserKindHuman,
+			Source:   id.Source,
+		})
+		// 并发登录时其他请求已创建
+		if err == repo.ErrResourceAlreadyExists {
+			u, err = uc.userRepo.GetByUsername(ctx, id.Username)
+		} else if err == nil {
+			uc.usersCreated(repo.UserOriginExternal, 1)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 同名的本地用户或其他来源的用户不能通过外部认证登录
+	source := u.Source
+	if source == "" {
+		source = repo.UserSourceLocal
+	}
+	if source != id.Source {
+		return nil, repo.ErrInvalidCredentials
+	}
+	// 被禁用的用户（例如通过 SCIM 停用）不能登录
+	if u.Disabled {
+		return nil, repo.ErrInvalidCredentials
+	}
+	email := repo.CanonicalIdentifier(id.Email)
+	if id.Source != repo.UserSourceLocal && email != "" && email != u.Email {
+		u.Email = email
+		return uc.userRepo.Update(ctx, u)
+	}
+	return u, nil
+}
+
+func (uc *Usecase) checkOwner(ctx context.Context, kind repo.OwnerKind, id int) error {
+	switch kind {
+	case repo.OwnerKindUser:
+		owner, err := uc.userRepo.Get(ctx, id)
+		if err != nil {
+			if err == repo.ErrResourceNotFound {
+				return repo.ErrInvalidOwner
+			}
+			return err
+		}
+		if owner.IsServiceAccount() {
+			return repo.ErrInvalidOwner
+		}
+		return nil
+	case repo.OwnerKindGroup:
+		if _, err := uc.groupRepo.Get(ctx, id); err != nil {
+			if err == repo.ErrResourceNotFound {
+				return repo.ErrInvalidOwner
+			}
+			return err
+		}
+		return nil
+	default:
+		return repo.ErrInvalidOwner
+	}
+}
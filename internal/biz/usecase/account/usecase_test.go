@@ -35,6 +35,13 @@ func TestUsecase_CreateUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockTran := mock.NewMockTransaction(ctrl)
 	mockRepo := mock.NewMockUserRepo(ctrl)
+	mockRepo.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) (*repo.User, error) {
+		store, ok := testUserStore[id]
+		if !ok {
+			return nil, repo.ErrResourceNotFound
+		}
+		return store, nil
+	})
 	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, u *repo.User) (*repo.User, error) {
 		if u.Username == "duplicated" {
 			return nil, testErrAlreadyExisted
@@ -48,6 +55,13 @@ func TestUsecase_CreateUser(t *testing.T) {
 			UpdateTime: testNow,
 		}, nil
 	})
+	mockGroupRepo := mock.NewMockGroupRepo(ctrl)
+	mockGroupRepo.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) (*repo.Group, error) {
+		if id != 1 {
+			return nil, repo.ErrResourceNotFound
+		}
+		return &repo.Group{ID: 1, DisplayName: "sre"}, nil
+	})
 	type args struct {
 		user *repo.User
 	}
@@ -86,12 +100,119 @@ func TestUsecase_CreateUser(t *testing.T) {
 			want:    nil,
 			wantErr: testErrAlreadyExisted,
 		},
+		{
+			name: "should normalize username and email to NFKC",
+			args: args{
+				user: &repo.User{
+					Username: " Ｌｉｕｂｏ ",
+					Email:    "Findliubo@163.com",
+				},
+			},
+			want: &repo.User{
+				ID:         1,
+				Username:   "Liubo",
+				Email:      "Findliubo@163.com",
+				Password:   "Admin@169+-",
+				CreateTime: testNow,
+				UpdateTime: testNow,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should create user failed if username contains invalid characters",
+			args: args{
+				user: &repo.User{
+					Username: "liu bo",
+				},
+			},
+			want:    nil,
+			wantErr: repo.ErrInvalidUsername,
+		},
+		{
+			name: "should create user failed if username is too short",
+			args: args{
+				user: &repo.User{
+					Username: "l",
+				},
+			},
+			want:    nil,
+			wantErr: repo.ErrInvalidUsername,
+		},
+		{
+			name: "should create user failed if human user has an owner",
+			args: args{
+				user: &repo.User{
+					Username:  "liubo",
+					OwnerKind: repo.OwnerKindUser,
+					OwnerID:   1,
+				},
+			},
+			want:    nil,
+			wantErr: repo.ErrInvalidOwner,
+		},
+		{
+			name: "should create service account failed if owner not found",
+			args: args{
+				user: &repo.User{
+					Username:  "robot",
+					Kind:      repo.UserKindService,
+					OwnerKind: repo.OwnerKindUser,
+					OwnerID:   3,
+				},
+			},
+			want:    nil,
+			wantErr: repo.ErrInvalidOwner,
+		},
+		{
+			name: "should create service account owned by group successfully",
+			args: args{
+				user: &repo.User{
+					Username:  "robot",
+					Kind:      repo.UserKindService,
+					OwnerKind: repo.OwnerKindGroup,
+					OwnerID:   1,
+				},
+			},
+			want: &repo.User{
+				ID:         1,
+				Username:   "robot",
+				Password:   "Admin@169+-",
+				CreateTime: testNow,
+				UpdateTime: testNow,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should create service account failed if owner group not found",
+			args: args{
+				user: &repo.User{
+					Username:  "robot",
+					Kind:      repo.UserKindService,
+					OwnerKind: repo.OwnerKindGroup,
+					OwnerID:   2,
+				},
+			},
+			want:    nil,
+			wantErr: repo.ErrInvalidOwner,
+		},
+		{
+			name: "should create service account failed without owner",
+			args: args{
+				user: &repo.User{
+					Username: "robot",
+					Kind:     repo.UserKindService,
+				},
+			},
+			want:    nil,
+			wantErr: repo.ErrInvalidOwner,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			uc := &Usecase{
-				tran:     mockTran,
-				userRepo: mockRepo,
+				tran:      mockTran,
+				userRepo:  mockRepo,
+				groupRepo: mockGroupRepo,
 			}
 			got, err := uc.CreateUser(ctx, tt.args.user)
 			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
@@ -105,16 +226,29 @@ func TestUsecase_UpdateUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockTran := mock.NewMockTransaction(ctrl)
 	mockRepo := mock.NewMockUserRepo(ctrl)
+	mockTran.EXPECT().WithTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockRepo.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) (*repo.User, error) {
+		store, ok := testUserStore[id]
+		if !ok {
+			return nil, testErrNotFound
+		}
+		u := *store
+		return &u, nil
+	})
 	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, u *repo.User) (*repo.User, error) {
 		store, ok := testUserStore[u.ID]
 		if !ok {
 			return nil, testErrNotFound
 		}
 		store.Email = u.Email
+		store.DisplayName = u.DisplayName
 		return store, nil
 	})
 	type args struct {
-		user *repo.User
+		user  *repo.User
+		paths []string
 	}
 	tests := []struct {
 		name    string
@@ -134,6 +268,28 @@ func TestUsecase_UpdateUser(t *testing.T) {
 			want:    testUserStore[1],
 			wantErr: nil,
 		},
+		{
+			name: "should update display name only",
+			args: args{
+				user: &repo.User{
+					ID:          1,
+					Email:       "ignored@361.com",
+					DisplayName: "Liu Bo",
+				},
+				paths: []string{"display_name"},
+			},
+			want:    testUserStore[1],
+			wantErr: nil,
+		},
+		{
+			name: "should update user failed if path is unknown",
+			args: args{
+				user:  &repo.User{ID: 1},
+				paths: []string{"username"},
+			},
+			want:    nil,
+			wantErr: repo.ErrInvalidProfile,
+		},
 		{
 			name: "should update user failed if not found",
 			args: args{
@@ -152,7 +308,7 @@ func TestUsecase_UpdateUser(t *testing.T) {
 				tran:     mockTran,
 				userRepo: mockRepo,
 			}
-			got, err := uc.UpdateUser(ctx, tt.args.user)
+			got, err := uc.UpdateUser(ctx, tt.args.user, tt.args.paths)
 			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
 			assert.Equal(t, tt.want, got, "mismatch: got=%v, want=%v", got, tt.want)
 		})
@@ -262,6 +418,7 @@ func TestUsecase_ListUsers(t *testing.T) {
 	}
 	type args struct {
 		ctx    context.Context
+		filter *repo.UserFilter
 		offset int
 		limit  int
 	}
@@ -280,7 +437,7 @@ func TestUsecase_ListUsers(t *testing.T) {
 				tran:     tt.fields.tran,
 				userRepo: tt.fields.userRepo,
 			}
-			got, err := uc.ListUsers(tt.args.ctx, tt.args.offset, tt.args.limit)
+			got, err := uc.ListUsers(tt.args.ctx, tt.args.filter, tt.args.offset, tt.args.limit)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Usecase.ListUsers() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -300,6 +457,16 @@ func TestUsecase_SetUserPassword(t *testing.T) {
 		return fn(ctx)
 	})
 	mockRepo := mock.NewMockUserRepo(ctrl)
+	mockRepo.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) (*repo.User, error) {
+		if id == 2 {
+			return &repo.User{ID: 2, Username: "robot", Kind: repo.UserKindService}, nil
+		}
+		store, ok := testUserStore[id]
+		if !ok {
+			return nil, testErrNotFound
+		}
+		return store, nil
+	})
 	mockRepo.EXPECT().SetPassword(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int, pass string) error {
 		store, ok := testUserStore[id]
 		if !ok {
@@ -333,6 +500,14 @@ func TestUsecase_SetUserPassword(t *testing.T) {
 			},
 			wantErr: testErrNotFound,
 		},
+		{
+			name: "should set user password failed if user is a service account",
+			args: args{
+				id:   2,
+				pass: "Admin@169--",
+			},
+			wantErr: repo.ErrHumanOnly,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -412,3 +587,76 @@ func TestUsecase_GetUserByUsername(t *testing.T) {
 		})
 	}
 }
+
+func TestUsecase_ReplaceUser(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockTran := mock.NewMockTransaction(ctrl)
+	mockTran.EXPECT().WithTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+	users := map[int]*repo.User{
+		1: {ID: 1, Username: "alice", Kind: repo.UserKindHuman, Source: repo.UserSourceLocal},
+		2: {ID: 2, Username: "robot", Kind: repo.UserKindService, Source: repo.UserSourceLocal},
+		3: {ID: 3, Username: "carol", Kind: repo.UserKindHuman, Source: "ldap"},
+	}
+	mockRepo := mock.NewMockUserRepo(ctrl)
+	mockRepo.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) (*repo.User, error) {
+		u, ok := users[id]
+		if !ok {
+			return nil, testErrNotFound
+		}
+		cp := *u
+		return &cp, nil
+	})
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, m *repo.User) (*repo.User, error) {
+		users[m.ID].Username = m.Username
+		return users[m.ID], nil
+	})
+	mockRepo.EXPECT().Disable(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) error {
+		users[id].Disabled = true
+		return nil
+	})
+	mockRepo.EXPECT().SetPassword(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int, pass string) error {
+		users[id].Password = pass
+		return nil
+	})
+	tests := []struct {
+		name    string
+		user    *repo.User
+		want    *repo.User
+		wantErr error
+	}{
+		{
+			name: "should replace user and disable it",
+			user: &repo.User{ID: 1, Username: "alice2", Disabled: true, Password: "Admin@169--"},
+			want: &repo.User{ID: 1, Username: "alice2", Kind: repo.UserKindHuman, Source: repo.UserSourceLocal, Disabled: true, Password: "Admin@169--"},
+		},
+		{
+			name:    "should replace user failed if not found",
+			user:    &repo.User{ID: 9, Username: "ghost"},
+			wantErr: testErrNotFound,
+		},
+		{
+			name:    "should replace user failed if user is a service account",
+			user:    &repo.User{ID: 2, Username: "robot"},
+			wantErr: repo.ErrHumanOnly,
+		},
+		{
+			name:    "should replace user failed if setting password of an external user",
+			user:    &repo.User{ID: 3, Username: "carol", Password: "Admin@169--"},
+			wantErr: repo.ErrExternalUser,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &Usecase{
+				tran:     mockTran,
+				userRepo: mockRepo,
+			}
+			got, err := uc.ReplaceUser(ctx, tt.user)
+			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package group
+
+import (
+	"context"
+
+	"usm/internal/biz/repo"
+)
+
+type Usecase struct {
+	tran      repo.Transaction
+	userRepo  repo.UserRepo
+	groupRepo repo.GroupRepo
+}
+
+func NewUsecase(tran repo.Transaction, userRepo repo.UserRepo, groupRepo repo.GroupRepo) *Usecase {
+	return &Usecase{
+		tran:      tran,
+		userRepo:  userRepo,
+		groupRepo: groupRepo,
+	}
+}
+
+// CreateGroup 创建用户组，memberIDs 中的用户必须存在
+func (uc *Usecase) CreateGroup(ctx context.Context, group *repo.Group, memberIDs []int) (*repo.Group, error) {
+	var g *repo.Group
+	err := uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		g, err = uc.groupRepo.Create(ctx, group)
+		if err != nil {
+			return err
+		}
+		return uc.addMembers(ctx, g.ID, memberIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (uc *Usecase) UpdateGroup(ctx context.Context, group *repo.Group) (*repo.Group, error) {
+	return uc.groupRepo.Update(ctx, group)
+}
+
+// ReplaceGroup 更新用户组并将成员替换为 memberIDs
+func (uc *Usecase) ReplaceGroup(ctx context.Context, group *repo.Group, memberIDs []int) (*repo.Group, error) {
+	var g *repo.Group
+	err := uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		g, err = uc.groupRepo.Update(ctx, group)
+		if err != nil {
+			return err
+		}
+		if err := uc.groupRepo.ClearMembers(ctx, g.ID); err != nil {
+			return err
+		}
+		return uc.addMembers(ctx, g.ID, memberIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (uc *Usecase) DeleteGroup(ctx context.Context, id int) error {
+	return uc.groupRepo.Delete(ctx, id)
+}
+
+func (uc *Usecase) GetGroup(ctx context.Context, id int) (*repo.Group, error) {
+	return uc.groupRepo.Get(ctx, id)
+}
+
+func (uc *Usecase) ListGroups(ctx context.Context, filter *repo.GroupFilter, offset, limit int) ([]*repo.Group, error) {
+	return uc.groupRepo.List(ctx, filter, offset, limit)
+}
+
+func (uc *Usecase) CountGroups(ctx context.Context, filter *repo.GroupFilter) (int, error) {
+	return uc.groupRepo.Count(ctx, filter)
+}
+
+func (uc *Usecase) ListMembers(ctx context.Context, id int) ([]*repo.User, error) {
+	if _, err := uc.groupRepo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	return uc.groupRepo.ListMembers(ctx, id)
+}
+
+func (uc *Usecase) AddMembers(ctx context.Context, id int, userIDs []int) error {
+	return uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.groupRepo.Get(ctx, id); err != nil {
+			return err
+		}
+		return uc.addMembers(ctx, id, userIDs)
+	})
+}
+
+func (uc *Usecase) RemoveMembers(ctx context.Context, id int, userIDs []int) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return uc.groupRepo.RemoveMembers(ctx, id, userIDs)
+}
+
+func (uc *Usecase) ListUserGroups(ctx context.Context, userID int) ([]*repo.Group, error) {
+	return uc.groupRepo.ListByUser(ctx, userID)
+}
+
+// addMembers 校验用户存在后加入用户组，需在事务中调用
+func (uc *Usecase) addMembers(ctx context.Context, id int, userIDs []int) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	for _, uid := range userIDs {
+		if _, err := uc.userRepo.Get(ctx, uid); err != nil {
+			return err
+		}
+	}
+	return uc.groupRepo.AddMembers(ctx, id, userIDs)
+}
@@ -0,0 +1,110 @@
+package oauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"time"
+
+	"usm/internal/biz/repo"
+)
+
+const (
+	CodeChallengeMethodPlain = "plain"
+	CodeChallengeMethodS256  = "S256"
+)
+
+type AuthorizeRequest struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Nonce               string
+}
+
+// ValidateAuthorizeRequest 校验授权请求，返回 redirect 为 false 的错误时不能重定向到客户端
+func (uc *Usecase) ValidateAuthorizeRequest(ctx context.Context, req *AuthorizeRequest) (client *repo.OAuthClient, redirect bool, err error) {
+	client, err = uc.clientRepo.GetByClientID(ctx, req.ClientID)
+	if err != nil {
+		if err == repo.ErrResourceNotFound {
+			return nil, false, newError(ErrCodeInvalidClient, "unknown client %q", req.ClientID)
+		}
+		return nil, false, err
+	}
+	if !client.AllowRedirectURI(req.RedirectURI) {
+		return nil, false, newError(ErrCodeInvalidRequest, "redirect uri %q is not registered", req.RedirectURI)
+	}
+	if req.ResponseType != "code" {
+		return client, true, newError(ErrCodeUnsupportedResponseType, "only response type code is supported")
+	}
+	if !client.AllowGrantType(repo.GrantTypeAuthorizationCode) {
+		return client, true, newError(ErrCodeUnauthorizedClient, "client is not allowed to use authorization code grant")
+	}
+	if !client.AllowScopes(parseScope(req.Scope)) {
+		return client, true, newError(ErrCodeInvalidScope, "requested scope is not allowed")
+	}
+	switch req.CodeChallengeMethod {
+	case "":
+		if req.CodeChallenge != "" {
+			req.CodeChallengeMethod = CodeChallengeMethodPlain
+		}
+	case CodeChallengeMethodPlain, CodeChallengeMethodS256:
+	default:
+		return client, true, newError(ErrCodeInvalidRequest, "unsupported code challenge method %q", req.CodeChallengeMethod)
+	}
+	if client.Public && req.CodeChallenge == "" {
+		return client, true, newError(ErrCodeInvalidRequest, "public clients must use PKCE")
+	}
+	return client, true, nil
+}
+
+// Authorize 为已认证的用户签发授权码
+func (uc *Usecase) Authorize(ctx context.Context, req *AuthorizeRequest, user *repo.User) (string, error) {
+	if _, _, err := uc.ValidateAuthorizeRequest(ctx, req); err != nil {
+		return "", err
+	}
+	if user.Disabled {
+		return "", newError(ErrCodeAccessDenied, "user is disabled")
+	}
+	code, err := randomToken(32)
+	if err != nil {
+		return "", err
+	}
+	_, err = uc.codeRepo.Create(ctx, &repo.OAuthCode{
+		CodeHash:            hashToken(code),
+		ClientID:            req.ClientID,
+		UserID:              user.ID,
+		RedirectURI:         req.RedirectURI,
+		Scopes:              parseScope(req.Scope),
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		Nonce:               req.Nonce,
+		ExpireTime:          time.Now().Add(uc.tokenTTLs().code),
+	})
+	if err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+func verifyCodeChallenge(method, challenge, verifier string) bool {
+	if challenge == "" {
+		return true
+	}
+	if verifier == "" {
+		return false
+	}
+	switch method {
+	case CodeChallengeMethodS256:
+		sum := sha256.Sum256([]byte(verifier))
+		verifier = base64.RawURLEncoding.EncodeToString(sum[:])
+	case CodeChallengeMethodPlain:
+	default:
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(challenge), []byte(verifier)) == 1
+}
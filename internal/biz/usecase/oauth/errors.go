@@ -0,0 +1,35 @@
+package oauth
+
+import (
+	"fmt"
+)
+
+// OAuth 2.0 错误码，见 RFC 6749 5.2、RFC 6750 3.1
+const (
+	ErrCodeInvalidRequest          = "invalid_request"
+	ErrCodeInvalidClient           = "invalid_client"
+	ErrCodeInvalidGrant            = "invalid_grant"
+	ErrCodeInvalidScope            = "invalid_scope"
+	ErrCodeInvalidToken            = "invalid_token"
+	ErrCodeUnauthorizedClient      = "unauthorized_client"
+	ErrCodeUnsupportedGrantType    = "unsupported_grant_type"
+	ErrCodeUnsupportedResponseType = "unsupported_response_type"
+	ErrCodeAccessDenied            = "access_denied"
+)
+
+// Error 协议层错误，由 service 按照 OAuth 2.0 规范输出给客户端
+type Error struct {
+	Code        string
+	Description string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Description)
+}
+
+func newError(code, format string, args ...interface{}) *Error {
+	return &Error{
+		Code:        code,
+		Description: fmt.Sprintf(format, args...),
+	}
+}
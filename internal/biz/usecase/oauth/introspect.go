@@ -0,0 +1,124 @@
+package oauth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"usm/internal/biz/repo"
+)
+
+// Introspection 令牌自省结果，见 RFC 7662
+type Introspection struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+}
+
+func (uc *Usecase) Introspect(ctx context.Context, clientID, clientSecret, token string) (*Introspection, error) {
+	if _, err := uc.AuthenticateClient(ctx, clientID, clientSecret); err != nil {
+		return nil, err
+	}
+	t, err := uc.tokenRepo.GetByHash(ctx, hashToken(token))
+	if err != nil {
+		if err == repo.ErrResourceNotFound {
+			return &Introspection{Active: false}, nil
+		}
+		return nil, err
+	}
+	if !t.Active(time.Now()) {
+		return &Introspection{Active: false}, nil
+	}
+	user, err := uc.userRepo.Get(ctx, t.UserID)
+	if err != nil {
+		if err == repo.ErrResourceNotFound {
+			return &Introspection{Active: false}, nil
+		}
+		return nil, err
+	}
+	if user.Disabled {
+		return &Introspection{Active: false}, nil
+	}
+	tokenType := "access_token"
+	if t.Kind == repo.TokenKindRefresh {
+		tokenType = "refresh_token"
+	}
+	return &Introspection{
+		Active:    true,
+		Scope:     strings.Join(t.Scopes, " "),
+		ClientID:  t.ClientID,
+		Username:  user.Username,
+		TokenType: tokenType,
+		Exp:       t.ExpireTime.Unix(),
+		Iat:       t.CreateTime.Unix(),
+		Sub:       strconv.Itoa(user.ID),
+		Iss:       uc.issuer,
+	}, nil
+}
+
+// Revoke 吊销令牌，见 RFC 7009，未知令牌或属于其他客户端的令牌直接忽略
+func (uc *Usecase) Revoke(ctx context.Context, clientID, clientSecret, token string) error {
+	client, err := uc.AuthenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return err
+	}
+	t, err := uc.tokenRepo.GetByHash(ctx, hashToken(token))
+	if err != nil {
+		if err == repo.ErrResourceNotFound {
+			return nil
+		}
+		return err
+	}
+	if t.ClientID != client.ClientID || t.Revoked {
+		return nil
+	}
+	if err := uc.tokenRepo.Revoke(ctx, t.ID); err != repo.ErrResourceNotFound {
+		return err
+	}
+	return nil
+}
+
+// UserInfo 返回 access token 对应用户的 OIDC claims
+func (uc *Usecase) UserInfo(ctx context.Context, accessToken string) (map[string]interface{}, error) {
+	claims, err := uc.verify(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	t, err := uc.tokenRepo.GetByHash(ctx, hashToken(accessToken))
+	if err != nil {
+		if err == repo.ErrResourceNotFound {
+			return nil, newError(ErrCodeInvalidToken, "invalid token")
+		}
+		return nil, err
+	}
+	if t.Kind != repo.TokenKindAccess || !t.Active(time.Now()) {
+		return nil, newError(ErrCodeInvalidToken, "token is expired or revoked")
+	}
+	scopes := parseScope(claimString(claims, "scope"))
+	if !contains(scopes, ScopeOpenID) {
+		return nil, newError(ErrCodeInvalidToken, "token does not contain openid scope")
+	}
+	user, err := uc.userRepo.Get(ctx, t.UserID)
+	if err != nil {
+		if err == repo.ErrResourceNotFound {
+			return nil, newError(ErrCodeInvalidToken, "invalid token")
+		}
+		return nil, err
+	}
+	if user.Disabled {
+		return nil, newError(ErrCodeInvalidToken, "user is disabled")
+	}
+	return uc.userClaims(user, scopes), nil
+}
+
+func claimString(claims map[string]interface{}, key string) string {
+	s, _ := claims[key].(string)
+	return s
+}
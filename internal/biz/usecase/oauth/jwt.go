@@ -0,0 +1,63 @@
+package oauth
+
+import (
+	"context"
+	"time"
+
+	"usm/internal/biz/repo"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func (uc *Usecase) sign(ctx context.Context, claims map[string]interface{}, ttl time.Duration) (string, error) {
+	key, err := uc.activeKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	mc := jwt.MapClaims{
+		"iss": uc.issuer,
+		"iat": now.Unix(),
+		"exp": now.Add(ttl).Unix(),
+	}
+	for k, v := range claims {
+		mc[k] = v
+	}
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), mc)
+	token.Header["kid"] = key.KID
+	return token.SignedString(key.PrivateKey)
+}
+
+// verify 校验 JWT 签名、签发者和有效期
+func (uc *Usecase) verify(ctx context.Context, raw string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	// 加载密钥失败时返回原始错误，而不是 invalid_token
+	var keyErr error
+	_, err := jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		k, err := uc.findKey(ctx, kid, t.Method.Alg())
+		if err != nil {
+			keyErr = err
+			return nil, err
+		}
+		if k == nil {
+			return nil, newError(ErrCodeInvalidToken, "unknown signing key %q", kid)
+		}
+		return &k.PrivateKey.PublicKey, nil
+	})
+	if keyErr != nil {
+		return nil, keyErr
+	}
+	if err != nil {
+		return nil, newError(ErrCodeInvalidToken, "invalid token")
+	}
+	if !claims.VerifyIssuer(uc.issuer, true) {
+		return nil, newError(ErrCodeInvalidToken, "invalid token issuer")
+	}
+	return claims, nil
+}
+
+// SigningKeys 返回用于 JWKS 的全部验证密钥
+func (uc *Usecase) SigningKeys(ctx context.Context) ([]*repo.SigningKey, error) {
+	return uc.cachedKeys(ctx)
+}
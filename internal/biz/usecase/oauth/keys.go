@@ -0,0 +1,273 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"sync"
+	"time"
+
+	"usm/internal/biz/repo"
+)
+
+const (
+	signingKeyAlgorithm = "RS256"
+	signingKeyBits      = 2048
+	// keyPublishDelay 新密钥在 JWKS 中发布多久后才开始签名，需大于 JWKS 的缓存时间
+	keyPublishDelay = 10 * time.Minute
+	// keyReloadInterval 验证时遇到未知 kid 重新加载密钥的最小间隔，避免伪造的 kid 导致频繁查询
+	keyReloadInterval = 5 * time.Second
+)
+
+// keyCache 缓存解析后的签名密钥，签名、验证和 JWKS 不再每次查询和解析私钥。
+// 本实例的 MaintainSigningKeys 和 RotateSigningKey 执行后刷新，其他实例的修改在下次定时检查时加载
+type keyCache struct {
+	mu     sync.RWMutex
+	keys   []*repo.SigningKey
+	loaded time.Time
+}
+
+// KeyPublishDelay 返回新密钥发布后多久开始签名
+func (uc *Usecase) KeyPublishDelay() time.Duration {
+	return keyPublishDelay
+}
+
+// KeyCheckInterval 返回定时检查密钥轮换的间隔
+func (uc *Usecase) KeyCheckInterval() time.Duration {
+	return uc.keyCheckInterval
+}
+
+// RotateSigningKey 生成 pending 密钥并在 JWKS 中发布，由 MaintainSigningKeys 在 keyPublishDelay 后启用，
+// 已有 pending 密钥时直接返回该密钥。没有签名密钥或 force 为 true 时立即启用，
+// 用于密钥泄露等场景，缓存了旧 JWKS 的依赖方在缓存过期前会拒绝新签发的令牌
+func (uc *Usecase) RotateSigningKey(ctx context.Context, force bool) (*repo.SigningKey, error) {
+	unlock, err := uc.keyRepo.Lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	var key *repo.SigningKey
+	err = uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		keys, err := uc.keyRepo.List(ctx)
+		if err != nil {
+			return err
+		}
+		hasActive := false
+		for _, k := range keys {
+			switch k.Status {
+			case repo.SigningKeyStatusActive:
+				hasActive = true
+			case repo.SigningKeyStatusPending:
+				if key == nil || k.CreateTime.Before(key.CreateTime) {
+					key = k
+				}
+			}
+		}
+		if key == nil {
+			if key, err = uc.createSigningKey(ctx); err != nil {
+				return err
+			}
+		}
+		if hasActive && !force {
+			return nil
+		}
+		key, err = uc.activateSigningKey(ctx, key, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.reloadKeys(ctx); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// MaintainSigningKeys 由定时任务调用，按以下顺序推进密钥生命周期：
+//  1. 没有签名密钥时立即生成并启用
+//  2. 当前密钥超过轮换周期时生成 pending 密钥，先在 JWKS 中发布
+//  3. pending 密钥发布超过 keyPublishDelay 后启用，旧密钥退役
+//  4. 删除退役超过重叠窗口的密钥
+//
+// 多个实例通过 keyRepo.Lock 串行执行，完成后重新加载密钥缓存
+func (uc *Usecase) MaintainSigningKeys(ctx context.Context) error {
+	unlock, err := uc.keyRepo.Lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	err = uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		keys, err := uc.keyRepo.List(ctx)
+		if err != nil {
+			return err
+		}
+		var active, pending *repo.SigningKey
+		for _, k := range keys {
+			switch k.Status {
+			case repo.SigningKeyStatusActive:
+				if active == nil || k.ActivateTime.After(active.ActivateTime) {
+					active = k
+				}
+			case repo.SigningKeyStatusPending:
+				if pending == nil || k.CreateTime.Before(pending.CreateTime) {
+					pending = k
+				}
+			}
+		}
+		switch {
+		case active == nil && pending != nil:
+			if _, err := uc.activateSigningKey(ctx, pending, now); err != nil {
+				return err
+			}
+		case active == nil:
+			k, err := uc.createSigningKey(ctx)
+			if err != nil {
+				return err
+			}
+			if _, err := uc.activateSigningKey(ctx, k, now); err != nil {
+				return err
+			}
+		case pending != nil:
+			if now.Sub(pending.CreateTime) >= keyPublishDelay {
+				if _, err := uc.activateSigningKey(ctx, pending, now); err != nil {
+					return err
+				}
+			}
+		case now.Sub(active.ActivateTime) >= uc.keyRotationPeriod:
+			if _, err := uc.createSigningKey(ctx); err != nil {
+				return err
+			}
+		}
+		_, err = uc.keyRepo.DeleteRetiredBefore(ctx, now.Add(-uc.keyOverlap))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = uc.reloadKeys(ctx)
+	return err
+}
+
+// cachedKeys 返回缓存的密钥，还没有加载时从数据库加载
+func (uc *Usecase) cachedKeys(ctx context.Context) ([]*repo.SigningKey, error) {
+	uc.keys.mu.RLock()
+	keys, loaded := uc.keys.keys, !uc.keys.loaded.IsZero()
+	uc.keys.mu.RUnlock()
+	if loaded {
+		return keys, nil
+	}
+	return uc.reloadKeys(ctx)
+}
+
+func (uc *Usecase) reloadKeys(ctx context.Context) ([]*repo.SigningKey, error) {
+	keys, err := uc.keyRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uc.keys.mu.Lock()
+	uc.keys.keys, uc.keys.loaded = keys, time.Now()
+	uc.keys.mu.Unlock()
+	return keys, nil
+}
+
+// findKey 在缓存中查找 kid，找不到时（例如其他实例刚启用的密钥）按 keyReloadInterval 限制频率重新加载
+func (uc *Usecase) findKey(ctx context.Context, kid, alg string) (*repo.SigningKey, error) {
+	keys, err := uc.cachedKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if k := keyByID(keys, kid, alg); k != nil {
+		return k, nil
+	}
+	uc.keys.mu.RLock()
+	stale := time.Since(uc.keys.loaded) >= keyReloadInterval
+	uc.keys.mu.RUnlock()
+	if !stale {
+		return nil, nil
+	}
+	if keys, err = uc.reloadKeys(ctx); err != nil {
+		return nil, err
+	}
+	return keyByID(keys, kid, alg), nil
+}
+
+func keyByID(keys []*repo.SigningKey, kid, alg string) *repo.SigningKey {
+	for _, k := range keys {
+		if k.KID == kid && k.Algorithm == alg {
+			return k
+		}
+	}
+	return nil
+}
+
+// activeKey 返回最近启用的密钥，缓存中没有时重新加载，仍然没有时返回 ErrResourceNotFound
+func (uc *Usecase) activeKey(ctx context.Context) (*repo.SigningKey, error) {
+	keys, err := uc.cachedKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if k := latestActive(keys); k != nil {
+		return k, nil
+	}
+	if keys, err = uc.reloadKeys(ctx); err != nil {
+		return nil, err
+	}
+	if k := latestActive(keys); k != nil {
+		return k, nil
+	}
+	return nil, repo.ErrResourceNotFound
+}
+
+func latestActive(keys []*repo.SigningKey) *repo.SigningKey {
+	var active *repo.SigningKey
+	for _, k := range keys {
+		if k.Status == repo.SigningKeyStatusActive && (active == nil || k.ActivateTime.After(active.ActivateTime)) {
+			active = k
+		}
+	}
+	return active
+}
+
+func (uc *Usecase) createSigningKey(ctx context.Context) (*repo.SigningKey, error) {
+	pk, err := rsa.GenerateKey(rand.Reader, signingKeyBits)
+	if err != nil {
+		return nil, err
+	}
+	return uc.keyRepo.Create(ctx, &repo.SigningKey{
+		KID:        keyID(&pk.PublicKey),
+		Algorithm:  signingKeyAlgorithm,
+		PrivateKey: pk,
+		Status:     repo.SigningKeyStatusPending,
+	})
+}
+
+// activateSigningKey 启用 key 并退役其他所有 active 密钥，需在事务中调用
+func (uc *Usecase) activateSigningKey(ctx context.Context, key *repo.SigningKey, now time.Time) (*repo.SigningKey, error) {
+	keys, err := uc.keyRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		if k.ID != key.ID && k.Status == repo.SigningKeyStatusActive {
+			if err := uc.keyRepo.Retire(ctx, k.ID, now); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := uc.keyRepo.Activate(ctx, key.ID, now); err != nil {
+		return nil, err
+	}
+	key.Status = repo.SigningKeyStatusActive
+	key.ActivateTime = now
+	return key, nil
+}
+
+// keyID 使用公钥 DER 编码的 SHA-256 作为 kid
+func keyID(pub *rsa.PublicKey) string {
+	sum := sha256.Sum256(x509.MarshalPKCS1PublicKey(pub))
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
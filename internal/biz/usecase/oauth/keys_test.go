@@ -0,0 +1,209 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"testing"
+	"time"
+
+	"usm/internal/biz/repo"
+	"usm/internal/biz/repo/mock"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyUsecase(ctrl *gomock.Controller, keys *[]*repo.SigningKey) *Usecase {
+	mockTran := mock.NewMockTransaction(ctrl)
+	mockTran.EXPECT().WithTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+	find := func(id int) *repo.SigningKey {
+		for _, k := range *keys {
+			if k.ID == id {
+				return k
+			}
+		}
+		return nil
+	}
+	mockKeyRepo := mock.NewMockSigningKeyRepo(ctrl)
+	mockKeyRepo.EXPECT().Lock(gomock.Any()).AnyTimes().Return(func() {}, nil)
+	mockKeyRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, k *repo.SigningKey) (*repo.SigningKey, error) {
+		k.ID = len(*keys) + 1
+		k.CreateTime = time.Now()
+		*keys = append(*keys, k)
+		return k, nil
+	})
+	mockKeyRepo.EXPECT().List(gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context) ([]*repo.SigningKey, error) {
+		return *keys, nil
+	})
+	mockKeyRepo.EXPECT().Activate(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int, now time.Time) error {
+		k := find(id)
+		k.Status = repo.SigningKeyStatusActive
+		k.ActivateTime = now
+		return nil
+	})
+	mockKeyRepo.EXPECT().Retire(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int, now time.Time) error {
+		k := find(id)
+		k.Status = repo.SigningKeyStatusRetired
+		k.RetireTime = now
+		return nil
+	})
+	mockKeyRepo.EXPECT().DeleteRetiredBefore(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, t time.Time) (int, error) {
+		var kept []*repo.SigningKey
+		for _, k := range *keys {
+			if k.Status != repo.SigningKeyStatusRetired || !k.RetireTime.Before(t) {
+				kept = append(kept, k)
+			}
+		}
+		n := len(*keys) - len(kept)
+		*keys = kept
+		return n, nil
+	})
+	return &Usecase{
+		tran:              mockTran,
+		keyRepo:           mockKeyRepo,
+		keyRotationPeriod: defaultKeyRotationPeriod,
+		keyOverlap:        defaultKeyOverlap,
+	}
+}
+
+func statuses(keys []*repo.SigningKey) []repo.SigningKeyStatus {
+	var res []repo.SigningKeyStatus
+	for _, k := range keys {
+		res = append(res, k.Status)
+	}
+	return res
+}
+
+func TestUsecase_MaintainSigningKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	var keys []*repo.SigningKey
+	uc := newTestKeyUsecase(ctrl, &keys)
+
+	// 没有密钥时立即生成并启用
+	assert.NoError(t, uc.MaintainSigningKeys(ctx))
+	assert.Equal(t, []repo.SigningKeyStatus{repo.SigningKeyStatusActive}, statuses(keys))
+	assert.NotEmpty(t, keys[0].KID)
+
+	// 未到轮换周期时不变
+	assert.NoError(t, uc.MaintainSigningKeys(ctx))
+	assert.Len(t, keys, 1)
+
+	// 到达轮换周期后先发布 pending 密钥
+	keys[0].ActivateTime = time.Now().Add(-defaultKeyRotationPeriod)
+	assert.NoError(t, uc.MaintainSigningKeys(ctx))
+	assert.Equal(t, []repo.SigningKeyStatus{repo.SigningKeyStatusActive, repo.SigningKeyStatusPending}, statuses(keys))
+
+	// 发布时间不足时不启用
+	assert.NoError(t, uc.MaintainSigningKeys(ctx))
+	assert.Equal(t, repo.SigningKeyStatusPending, keys[1].Status)
+
+	// 发布足够久后启用，旧密钥退役但仍保留用于验证
+	keys[1].CreateTime = time.Now().Add(-keyPublishDelay)
+	assert.NoError(t, uc.MaintainSigningKeys(ctx))
+	assert.Equal(t, []repo.SigningKeyStatus{repo.SigningKeyStatusRetired, repo.SigningKeyStatusActive}, statuses(keys))
+
+	// 超过重叠窗口后删除退役密钥
+	keys[0].RetireTime = time.Now().Add(-defaultKeyOverlap - time.Minute)
+	assert.NoError(t, uc.MaintainSigningKeys(ctx))
+	assert.Equal(t, []repo.SigningKeyStatus{repo.SigningKeyStatusActive}, statuses(keys))
+	assert.Equal(t, 2, keys[0].ID)
+}
+
+func TestUsecase_RotateSigningKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	var keys []*repo.SigningKey
+	uc := newTestKeyUsecase(ctrl, &keys)
+
+	first, err := uc.RotateSigningKey(ctx, false)
+	assert.NoError(t, err)
+	assert.Equal(t, repo.SigningKeyStatusActive, first.Status, "first key is activated immediately")
+
+	second, err := uc.RotateSigningKey(ctx, false)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.KID, second.KID)
+	assert.Equal(t, []repo.SigningKeyStatus{repo.SigningKeyStatusActive, repo.SigningKeyStatusPending}, statuses(keys), "new key is only published")
+	again, err := uc.RotateSigningKey(ctx, false)
+	assert.NoError(t, err)
+	assert.Equal(t, second.ID, again.ID, "pending key is reused")
+
+	// 发布超过 keyPublishDelay 后由定时任务启用
+	assert.NoError(t, uc.MaintainSigningKeys(ctx))
+	assert.Equal(t, []repo.SigningKeyStatus{repo.SigningKeyStatusActive, repo.SigningKeyStatusPending}, statuses(keys))
+	second.CreateTime = second.CreateTime.Add(-keyPublishDelay)
+	assert.NoError(t, uc.MaintainSigningKeys(ctx))
+	assert.Equal(t, []repo.SigningKeyStatus{repo.SigningKeyStatusRetired, repo.SigningKeyStatusActive}, statuses(keys))
+
+	forced, err := uc.RotateSigningKey(ctx, true)
+	assert.NoError(t, err)
+	assert.Equal(t, repo.SigningKeyStatusActive, forced.Status)
+	assert.Equal(t, []repo.SigningKeyStatus{repo.SigningKeyStatusRetired, repo.SigningKeyStatusRetired, repo.SigningKeyStatusActive}, statuses(keys))
+}
+
+func TestUsecase_MaintainSigningKeysLocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	locked := false
+	mockTran := mock.NewMockTransaction(ctrl)
+	mockKeyRepo := mock.NewMockSigningKeyRepo(ctrl)
+	gomock.InOrder(
+		mockKeyRepo.EXPECT().Lock(gomock.Any()).DoAndReturn(func(ctx context.Context) (func(), error) {
+			locked = true
+			return func() { locked = false }, nil
+		}),
+		mockTran.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			assert.True(t, locked, "transaction must run while holding the lock")
+			return errors.New("db down")
+		}),
+	)
+	uc := &Usecase{tran: mockTran, keyRepo: mockKeyRepo}
+	assert.EqualError(t, uc.MaintainSigningKeys(context.Background()), "db down")
+	assert.False(t, locked, "lock must be released")
+}
+
+func TestUsecase_SigningKeyCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	newKey := func(kid string) *repo.SigningKey {
+		pk, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		return &repo.SigningKey{KID: kid, Algorithm: signingKeyAlgorithm, PrivateKey: pk, Status: repo.SigningKeyStatusActive, ActivateTime: time.Now()}
+	}
+	current, other := newKey("current"), newKey("other")
+	mockKeyRepo := mock.NewMockSigningKeyRepo(ctrl)
+	gomock.InOrder(
+		mockKeyRepo.EXPECT().List(gomock.Any()).Return([]*repo.SigningKey{current}, nil),
+		mockKeyRepo.EXPECT().List(gomock.Any()).Return([]*repo.SigningKey{current, other}, nil),
+	)
+	uc := &Usecase{keyRepo: mockKeyRepo, issuer: "https://usm.example.com"}
+
+	// 多次签名和验证只加载一次密钥
+	for i := 0; i < 3; i++ {
+		raw, err := uc.sign(ctx, map[string]interface{}{"sub": "1"}, time.Minute)
+		require.NoError(t, err)
+		_, err = uc.verify(ctx, raw)
+		assert.NoError(t, err)
+	}
+
+	// 其他实例启用的密钥，重新加载间隔内不查询数据库
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"iss": "https://usm.example.com", "exp": time.Now().Add(time.Minute).Unix()})
+	token.Header["kid"] = other.KID
+	raw, err := token.SignedString(other.PrivateKey)
+	require.NoError(t, err)
+	_, err = uc.verify(ctx, raw)
+	assert.Error(t, err)
+
+	uc.keys.loaded = uc.keys.loaded.Add(-keyReloadInterval)
+	_, err = uc.verify(ctx, raw)
+	assert.NoError(t, err)
+}
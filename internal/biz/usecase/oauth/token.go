@@ -0,0 +1,241 @@
+package oauth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"usm/internal/biz/repo"
+)
+
+type TokenRequest struct {
+	GrantType    string
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+	Scope        string
+}
+
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+func (uc *Usecase) Token(ctx context.Context, req *TokenRequest) (*TokenResponse, error) {
+	client, err := uc.AuthenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if !contains(SupportedGrantTypes, req.GrantType) {
+		return nil, newError(ErrCodeUnsupportedGrantType, "unsupported grant type %q", req.GrantType)
+	}
+	if !client.AllowGrantType(req.GrantType) {
+		return nil, newError(ErrCodeUnauthorizedClient, "client is not allowed to use grant type %q", req.GrantType)
+	}
+	var code *repo.OAuthCode
+	if req.GrantType == repo.GrantTypeAuthorizationCode {
+		if code, err = uc.consumeCode(ctx, req.Code); err != nil {
+			return nil, err
+		}
+	}
+	var resp *TokenResponse
+	err = uc.tran.WithTx(ctx, func(ctx context.Context) error {
+		switch req.GrantType {
+		case repo.GrantTypeAuthorizationCode:
+			resp, err = uc.exchangeCode(ctx, client, code, req)
+		case repo.GrantTypeClientCredentials:
+			resp, err = uc.clientCredentials(ctx, client, req)
+		case repo.GrantTypeRefreshToken:
+			resp, err = uc.refresh(ctx, client, req)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// consumeCode 查找并删除授权码。授权码只能使用一次，删除在签发令牌的事务之外提交，
+// 校验失败时不会因事务回滚而恢复，避免持有授权码的攻击者反复尝试 code_verifier
+func (uc *Usecase) consumeCode(ctx context.Context, raw string) (*repo.OAuthCode, error) {
+	code, err := uc.codeRepo.GetByHash(ctx, hashToken(raw))
+	if err == nil {
+		// 并发使用同一授权码时只有一个请求能删除成功
+		err = uc.codeRepo.Delete(ctx, code.ID)
+	}
+	if err != nil {
+		if err == repo.ErrResourceNotFound {
+			return nil, newError(ErrCodeInvalidGrant, "invalid authorization code")
+		}
+		return nil, err
+	}
+	return code, nil
+}
+
+func (uc *Usecase) exchangeCode(ctx context.Context, client *repo.OAuthClient, code *repo.OAuthCode, req *TokenRequest) (*TokenResponse, error) {
+	if code.ClientID != client.ClientID || code.RedirectURI != req.RedirectURI || time.Now().After(code.ExpireTime) {
+		return nil, newError(ErrCodeInvalidGrant, "invalid authorization code")
+	}
+	if !verifyCodeChallenge(code.CodeChallengeMethod, code.CodeChallenge, req.CodeVerifier) {
+		return nil, newError(ErrCodeInvalidGrant, "code verifier mismatch")
+	}
+	user, err := uc.loadUser(ctx, code.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.issue(ctx, client, user, code.Scopes, code.Nonce)
+}
+
+func (uc *Usecase) clientCredentials(ctx context.Context, client *repo.OAuthClient, req *TokenRequest) (*TokenResponse, error) {
+	scopes := parseScope(req.Scope)
+	if contains(scopes, ScopeOpenID) || !client.AllowScopes(scopes) {
+		return nil, newError(ErrCodeInvalidScope, "requested scope is not allowed")
+	}
+	sa, err := uc.loadUser(ctx, client.ServiceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if !sa.IsServiceAccount() {
+		return nil, newError(ErrCodeUnauthorizedClient, "client is not bound to a service account")
+	}
+	access, exp, err := uc.issueAccessToken(ctx, client, sa, scopes)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenResponse{
+		AccessToken: access,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(exp.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
+
+func (uc *Usecase) refresh(ctx context.Context, client *repo.OAuthClient, req *TokenRequest) (*TokenResponse, error) {
+	t, err := uc.tokenRepo.GetByHash(ctx, hashToken(req.RefreshToken))
+	if err != nil {
+		if err == repo.ErrResourceNotFound {
+			return nil, newError(ErrCodeInvalidGrant, "invalid refresh token")
+		}
+		return nil, err
+	}
+	if t.Kind != repo.TokenKindRefresh || t.ClientID != client.ClientID || !t.Active(time.Now()) {
+		return nil, newError(ErrCodeInvalidGrant, "invalid refresh token")
+	}
+	scopes := t.Scopes
+	if req.Scope != "" {
+		scopes = parseScope(req.Scope)
+		for _, s := range scopes {
+			if !contains(t.Scopes, s) {
+				return nil, newError(ErrCodeInvalidScope, "requested scope exceeds the original grant")
+			}
+		}
+	}
+	user, err := uc.loadUser(ctx, t.UserID)
+	if err != nil {
+		return nil, err
+	}
+	// refresh token 轮换，旧令牌立即失效，并发使用同一令牌时只有一个请求能吊销成功
+	if err := uc.tokenRepo.Revoke(ctx, t.ID); err != nil {
+		if err == repo.ErrResourceNotFound {
+			return nil, newError(ErrCodeInvalidGrant, "invalid refresh token")
+		}
+		return nil, err
+	}
+	return uc.issue(ctx, client, user, scopes, "")
+}
+
+func (uc *Usecase) issue(ctx context.Context, client *repo.OAuthClient, user *repo.User, scopes []string, nonce string) (*TokenResponse, error) {
+	access, exp, err := uc.issueAccessToken(ctx, client, user, scopes)
+	if err != nil {
+		return nil, err
+	}
+	resp := &TokenResponse{
+		AccessToken: access,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(exp.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	}
+	if client.AllowGrantType(repo.GrantTypeRefreshToken) {
+		refresh, err := randomToken(32)
+		if err != nil {
+			return nil, err
+		}
+		_, err = uc.tokenRepo.Create(ctx, &repo.OAuthToken{
+			TokenHash:  hashToken(refresh),
+			Kind:       repo.TokenKindRefresh,
+			ClientID:   client.ClientID,
+			UserID:     user.ID,
+			Scopes:     scopes,
+			ExpireTime: time.Now().Add(uc.tokenTTLs().refreshToken),
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.RefreshToken = refresh
+	}
+	if contains(scopes, ScopeOpenID) {
+		claims := uc.userClaims(user, scopes)
+		claims["aud"] = client.ClientID
+		if nonce != "" {
+			claims["nonce"] = nonce
+		}
+		if resp.IDToken, err = uc.sign(ctx, claims, uc.tokenTTLs().accessToken); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (uc *Usecase) issueAccessToken(ctx context.Context, client *repo.OAuthClient, user *repo.User, scopes []string) (string, time.Duration, error) {
+	jti, err := randomToken(16)
+	if err != nil {
+		return "", 0, err
+	}
+	ttl := uc.tokenTTLs().accessToken
+	token, err := uc.sign(ctx, map[string]interface{}{
+		"sub":       strconv.Itoa(user.ID),
+		"aud":       client.ClientID,
+		"client_id": client.ClientID,
+		"scope":     strings.Join(scopes, " "),
+		"jti":       jti,
+	}, ttl)
+	if err != nil {
+		return "", 0, err
+	}
+	_, err = uc.tokenRepo.Create(ctx, &repo.OAuthToken{
+		TokenHash:  hashToken(token),
+		Kind:       repo.TokenKindAccess,
+		ClientID:   client.ClientID,
+		UserID:     user.ID,
+		Scopes:     scopes,
+		ExpireTime: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	return token, ttl, nil
+}
+
+// userClaims 按照 OIDC 标准 claims 映射 repo.User
+func (uc *Usecase) userClaims(user *repo.User, scopes []string) map[string]interface{} {
+	claims := map[string]interface{}{
+		"sub": strconv.Itoa(user.ID),
+	}
+	if contains(scopes, ScopeProfile) {
+		claims["preferred_username"] = user.Username
+		claims["updated_at"] = user.UpdateTime.Unix()
+	}
+	if contains(scopes, ScopeEmail) && user.Email != "" {
+		claims["email"] = user.Email
+	}
+	return claims
+}
@@ -0,0 +1,256 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
+)
+
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+
+	defaultCodeTTL         = time.Minute
+	defaultAccessTokenTTL  = time.Hour
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+	defaultKeyRotationPeriod = 30 * 24 * time.Hour
+	defaultKeyOverlap        = 24 * time.Hour
+	defaultKeyCheckInterval  = 10 * time.Minute
+)
+
+var (
+	SupportedScopes     = []string{ScopeOpenID, ScopeProfile, ScopeEmail}
+	SupportedGrantTypes = []string{repo.GrantTypeAuthorizationCode, repo.GrantTypeClientCredentials, repo.GrantTypeRefreshToken}
+)
+
+type Usecase struct {
+	tran       repo.Transaction
+	userRepo   repo.UserRepo
+	clientRepo repo.OAuthClientRepo
+	codeRepo   repo.OAuthCodeRepo
+	tokenRepo  repo.OAuthTokenRepo
+	keyRepo    repo.SigningKeyRepo
+
+	issuer string
+	ttls   atomic.Value // tokenTTLs
+
+	keyRotationPeriod time.Duration
+	keyOverlap        time.Duration
+	keyCheckInterval  time.Duration
+	keys              keyCache
+}
+
+func NewUsecase(c *conf.Auth, tran repo.Transaction, userRepo repo.UserRepo, clientRepo repo.OAuthClientRepo,
+	codeRepo repo.OAuthCodeRepo, tokenRepo repo.OAuthTokenRepo, keyRepo repo.SigningKeyRepo) *Usecase {
+	uc := &Usecase{
+		tran:              tran,
+		userRepo:          userRepo,
+		clientRepo:        clientRepo,
+		codeRepo:          codeRepo,
+		tokenRepo:         tokenRepo,
+		keyRepo:           keyRepo,
+		issuer:            c.GetOauth().GetIssuer(),
+		keyRotationPeriod: defaultKeyRotationPeriod,
+		keyOverlap:        defaultKeyOverlap,
+		keyCheckInterval:  defaultKeyCheckInterval,
+	}
+	uc.SetTokenTTLs(c)
+	if d := c.GetOauth().GetKeyRotationPeriod(); d != nil {
+		uc.keyRotationPeriod = d.AsDuration()
+	}
+	if d := c.GetOauth().GetKeyOverlap(); d != nil {
+		uc.keyOverlap = d.AsDuration()
+	}
+	if d := c.GetOauth().GetKeyCheckInterval(); d != nil {
+		uc.keyCheckInterval = d.AsDuration()
+	}
+	return uc
+}
+
+// tokenTTLs 授权码和令牌的有效期，可以在运行时修改
+type tokenTTLs struct {
+	code         time.Duration
+	accessToken  time.Duration
+	refreshToken time.Duration
+}
+
+// CheckConfig 校验无法通过 proto 规则表达的配置：密钥退役后的重叠窗口需大于 access token 的有效期，
+// 否则密钥会在其签发的令牌过期之前从 JWKS 中删除
+func CheckConfig(c *conf.Auth) error {
+	access, overlap := defaultAccessTokenTTL, defaultKeyOverlap
+	if d := c.GetOauth().GetAccessTokenTtl(); d != nil {
+		access = d.AsDuration()
+	}
+	if d := c.GetOauth().GetKeyOverlap(); d != nil {
+		overlap = d.AsDuration()
+	}
+	if overlap <= access {
+		return fmt.Errorf("key_overlap %s must be longer than access_token_ttl %s", overlap, access)
+	}
+	return nil
+}
+
+// SetTokenTTLs 修改授权码和令牌的有效期，只影响之后签发的令牌，没有设置的使用默认值
+func (uc *Usecase) SetTokenTTLs(c *conf.Auth) {
+	ttls := tokenTTLs{
+		code:         defaultCodeTTL,
+		accessToken:  defaultAccessTokenTTL,
+		refreshToken: defaultRefreshTokenTTL,
+	}
+	if d := c.GetOauth().GetCodeTtl(); d != nil {
+		ttls.code = d.AsDuration()
+	}
+	if d := c.GetOauth().GetAccessTokenTtl(); d != nil {
+		ttls.accessToken = d.AsDuration()
+	}
+	if d := c.GetOauth().GetRefreshTokenTtl(); d != nil {
+		ttls.refreshToken = d.AsDuration()
+	}
+	uc.ttls.Store(ttls)
+}
+
+func (uc *Usecase) tokenTTLs() tokenTTLs {
+	return uc.ttls.Load().(tokenTTLs)
+}
+
+func (uc *Usecase) Issuer() string {
+	return uc.issuer
+}
+
+// RegisterClient 注册客户端，返回的密钥明文仅此一次可见，Public 客户端没有密钥
+func (uc *Usecase) RegisterClient(ctx context.Context, client *repo.OAuthClient) (*repo.OAuthClient, string, error) {
+	if len(client.GrantTypes) == 0 {
+		client.GrantTypes = []string{repo.GrantTypeAuthorizationCode, repo.GrantTypeRefreshToken}
+	}
+	if len(client.Scopes) == 0 {
+		client.Scopes = SupportedScopes
+	}
+	for _, gt := range client.GrantTypes {
+		if !contains(SupportedGrantTypes, gt) {
+			return nil, "", newError(ErrCodeInvalidRequest, "unsupported grant type %q", gt)
+		}
+	}
+	if client.AllowGrantType(repo.GrantTypeAuthorizationCode) && len(client.RedirectURIs) == 0 {
+		return nil, "", newError(ErrCodeInvalidRequest, "redirect uris are required for authorization code grant")
+	}
+	if client.AllowGrantType(repo.GrantTypeClientCredentials) {
+		if client.Public {
+			return nil, "", newError(ErrCodeInvalidRequest, "public clients can not use client credentials grant")
+		}
+		sa, err := uc.userRepo.Get(ctx, client.ServiceAccountID)
+		if err != nil {
+			if err == repo.ErrResourceNotFound {
+				return nil, "", newError(ErrCodeInvalidRequest, "service account %d not found", client.ServiceAccountID)
+			}
+			return nil, "", err
+		}
+		if !sa.IsServiceAccount() {
+			return nil, "", repo.ErrServiceAccountOnly
+		}
+	}
+	clientID, err := randomToken(16)
+	if err != nil {
+		return nil, "", err
+	}
+	client.ClientID = clientID
+	var secret string
+	if !client.Public {
+		if secret, err = randomToken(32); err != nil {
+			return nil, "", err
+		}
+		client.SecretHash = hashToken(secret)
+	}
+	client, err = uc.clientRepo.Create(ctx, client)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, secret, nil
+}
+
+func (uc *Usecase) GetClient(ctx context.Context, id int) (*repo.OAuthClient, error) {
+	return uc.clientRepo.Get(ctx, id)
+}
+
+func (uc *Usecase) ListClients(ctx context.Context, offset, limit int) ([]*repo.OAuthClient, error) {
+	return uc.clientRepo.List(ctx, offset, limit)
+}
+
+func (uc *Usecase) DeleteClient(ctx context.Context, id int) error {
+	return uc.clientRepo.Delete(ctx, id)
+}
+
+// AuthenticateClient 校验客户端身份，Public 客户端不需要密钥
+func (uc *Usecase) AuthenticateClient(ctx context.Context, clientID, secret string) (*repo.OAuthClient, error) {
+	if clientID == "" {
+		return nil, newError(ErrCodeInvalidClient, "client authentication required")
+	}
+	client, err := uc.clientRepo.GetByClientID(ctx, clientID)
+	if err != nil {
+		if err == repo.ErrResourceNotFound {
+			return nil, newError(ErrCodeInvalidClient, "client authentication failed")
+		}
+		return nil, err
+	}
+	if client.Public {
+		if secret != "" {
+			return nil, newError(ErrCodeInvalidClient, "public client must not use a secret")
+		}
+		return client, nil
+	}
+	if subtle.ConstantTimeCompare([]byte(client.SecretHash), []byte(hashToken(secret))) != 1 {
+		return nil, newError(ErrCodeInvalidClient, "client authentication failed")
+	}
+	return client, nil
+}
+
+func (uc *Usecase) loadUser(ctx context.Context, id int) (*repo.User, error) {
+	u, err := uc.userRepo.Get(ctx, id)
+	if err != nil {
+		if err == repo.ErrResourceNotFound {
+			return nil, newError(ErrCodeInvalidGrant, "subject not found")
+		}
+		return nil, err
+	}
+	if u.Disabled {
+		return nil, newError(ErrCodeInvalidGrant, "subject is disabled")
+	}
+	return u, nil
+}
+
+func parseScope(scope string) []string {
+	return strings.Fields(scope)
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
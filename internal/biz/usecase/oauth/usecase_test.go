@@ -0,0 +1,558 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"usm/internal/biz/repo"
+	"usm/internal/biz/repo/mock"
+	"usm/internal/conf"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const testVerifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r0W4Y-g1WRK6bU"
+
+var (
+	testUser = &repo.User{
+		ID:       1,
+		Username: "liubo",
+		Email:    "findliubo@163.com",
+		Kind:     repo.UserKindHuman,
+	}
+	testServiceAccount = &repo.User{
+		ID:        2,
+		Username:  "robot",
+		Kind:      repo.UserKindService,
+		OwnerKind: repo.OwnerKindUser,
+		OwnerID:   1,
+	}
+	testWebClient = &repo.OAuthClient{
+		ID:           1,
+		ClientID:     "web",
+		SecretHash:   hashToken("secret"),
+		Name:         "web",
+		RedirectURIs: []string{"https://app.example.com/callback"},
+		GrantTypes:   []string{repo.GrantTypeAuthorizationCode, repo.GrantTypeRefreshToken},
+		Scopes:       SupportedScopes,
+	}
+	testSPAClient = &repo.OAuthClient{
+		ID:           2,
+		ClientID:     "spa",
+		Name:         "spa",
+		RedirectURIs: []string{"https://spa.example.com/callback"},
+		GrantTypes:   []string{repo.GrantTypeAuthorizationCode},
+		Scopes:       SupportedScopes,
+		Public:       true,
+	}
+	testRobotClient = &repo.OAuthClient{
+		ID:               3,
+		ClientID:         "robot",
+		SecretHash:       hashToken("secret"),
+		Name:             "robot",
+		GrantTypes:       []string{repo.GrantTypeClientCredentials},
+		Scopes:           []string{"usm.read"},
+		ServiceAccountID: testServiceAccount.ID,
+	}
+)
+
+type testStore struct {
+	codes  map[string]*repo.OAuthCode
+	tokens map[string]*repo.OAuthToken
+}
+
+func newTestUsecase(t *testing.T, ctrl *gomock.Controller) (*Usecase, *testStore) {
+	store := &testStore{
+		codes:  map[string]*repo.OAuthCode{},
+		tokens: map[string]*repo.OAuthToken{},
+	}
+	mockTran := mock.NewMockTransaction(ctrl)
+	mockTran.EXPECT().WithTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		// 模拟事务回滚：出错时恢复事务中删除的授权码
+		codes := make(map[string]*repo.OAuthCode, len(store.codes))
+		for h, c := range store.codes {
+			codes[h] = c
+		}
+		err := fn(ctx)
+		if err != nil {
+			store.codes = codes
+		}
+		return err
+	})
+	mockUserRepo := mock.NewMockUserRepo(ctrl)
+	mockUserRepo.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) (*repo.User, error) {
+		for _, u := range []*repo.User{testUser, testServiceAccount} {
+			if u.ID == id {
+				return u, nil
+			}
+		}
+		return nil, repo.ErrResourceNotFound
+	})
+	mockClientRepo := mock.NewMockOAuthClientRepo(ctrl)
+	mockClientRepo.EXPECT().GetByClientID(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, clientID string) (*repo.OAuthClient, error) {
+		for _, c := range []*repo.OAuthClient{testWebClient, testSPAClient, testRobotClient} {
+			if c.ClientID == clientID {
+				return c, nil
+			}
+		}
+		return nil, repo.ErrResourceNotFound
+	})
+	mockCodeRepo := mock.NewMockOAuthCodeRepo(ctrl)
+	mockCodeRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, c *repo.OAuthCode) (*repo.OAuthCode, error) {
+		c.ID = len(store.codes) + 1
+		store.codes[c.CodeHash] = c
+		return c, nil
+	})
+	mockCodeRepo.EXPECT().GetByHash(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, hash string) (*repo.OAuthCode, error) {
+		c, ok := store.codes[hash]
+		if !ok {
+			return nil, repo.ErrResourceNotFound
+		}
+		return c, nil
+	})
+	mockCodeRepo.EXPECT().Delete(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) error {
+		for h, c := range store.codes {
+			if c.ID == id {
+				delete(store.codes, h)
+				return nil
+			}
+		}
+		return repo.ErrResourceNotFound
+	})
+	mockTokenRepo := mock.NewMockOAuthTokenRepo(ctrl)
+	mockTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, tk *repo.OAuthToken) (*repo.OAuthToken, error) {
+		tk.ID = len(store.tokens) + 1
+		store.tokens[tk.TokenHash] = tk
+		return tk, nil
+	})
+	mockTokenRepo.EXPECT().GetByHash(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, hash string) (*repo.OAuthToken, error) {
+		tk, ok := store.tokens[hash]
+		if !ok {
+			return nil, repo.ErrResourceNotFound
+		}
+		return tk, nil
+	})
+	mockTokenRepo.EXPECT().Revoke(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, id int) error {
+		for _, tk := range store.tokens {
+			if tk.ID == id && !tk.Revoked {
+				tk.Revoked = true
+				return nil
+			}
+		}
+		return repo.ErrResourceNotFound
+	})
+	pk, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	key := &repo.SigningKey{KID: "test", Algorithm: "RS256", PrivateKey: pk, Status: repo.SigningKeyStatusActive}
+	mockKeyRepo := mock.NewMockSigningKeyRepo(ctrl)
+	mockKeyRepo.EXPECT().List(gomock.Any()).AnyTimes().Return([]*repo.SigningKey{key}, nil)
+	uc := &Usecase{
+		tran:       mockTran,
+		userRepo:   mockUserRepo,
+		clientRepo: mockClientRepo,
+		codeRepo:   mockCodeRepo,
+		tokenRepo:  mockTokenRepo,
+		keyRepo:    mockKeyRepo,
+		issuer:     "https://usm.example.com",
+	}
+	uc.SetTokenTTLs(&conf.Auth{})
+	return uc, store
+}
+
+func TestUsecase_SetTokenTTLs(t *testing.T) {
+	uc := NewUsecase(&conf.Auth{}, nil, nil, nil, nil, nil, nil)
+	assert.Equal(t, tokenTTLs{code: defaultCodeTTL, accessToken: defaultAccessTokenTTL, refreshToken: defaultRefreshTokenTTL}, uc.tokenTTLs())
+
+	uc.SetTokenTTLs(&conf.Auth{Oauth: &conf.Auth_OAuth{
+		AccessTokenTtl:  durationpb.New(5 * time.Minute),
+		RefreshTokenTtl: durationpb.New(time.Hour),
+	}})
+	assert.Equal(t, tokenTTLs{code: defaultCodeTTL, accessToken: 5 * time.Minute, refreshToken: time.Hour}, uc.tokenTTLs())
+}
+
+func s256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func TestUsecase_ValidateAuthorizeRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	uc, _ := newTestUsecase(t, ctrl)
+	tests := []struct {
+		name         string
+		req          *AuthorizeRequest
+		wantRedirect bool
+		wantCode     string
+	}{
+		{
+			name: "should accept valid request",
+			req: &AuthorizeRequest{
+				ResponseType: "code",
+				ClientID:     "web",
+				RedirectURI:  "https://app.example.com/callback",
+				Scope:        "openid profile",
+			},
+			wantRedirect: true,
+		},
+		{
+			name: "should not redirect to unknown client",
+			req: &AuthorizeRequest{
+				ResponseType: "code",
+				ClientID:     "unknown",
+				RedirectURI:  "https://app.example.com/callback",
+			},
+			wantCode: ErrCodeInvalidClient,
+		},
+		{
+			name: "should not redirect to unregistered redirect uri",
+			req: &AuthorizeRequest{
+				ResponseType: "code",
+				ClientID:     "web",
+				RedirectURI:  "https://evil.example.com/callback",
+			},
+			wantCode: ErrCodeInvalidRequest,
+		},
+		{
+			name: "should reject unsupported response type",
+			req: &AuthorizeRequest{
+				ResponseType: "token",
+				ClientID:     "web",
+				RedirectURI:  "https://app.example.com/callback",
+			},
+			wantRedirect: true,
+			wantCode:     ErrCodeUnsupportedResponseType,
+		},
+		{
+			name: "should reject unknown scope",
+			req: &AuthorizeRequest{
+				ResponseType: "code",
+				ClientID:     "web",
+				RedirectURI:  "https://app.example.com/callback",
+				Scope:        "openid admin",
+			},
+			wantRedirect: true,
+			wantCode:     ErrCodeInvalidScope,
+		},
+		{
+			name: "should require pkce for public client",
+			req: &AuthorizeRequest{
+				ResponseType: "code",
+				ClientID:     "spa",
+				RedirectURI:  "https://spa.example.com/callback",
+			},
+			wantRedirect: true,
+			wantCode:     ErrCodeInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, redirect, err := uc.ValidateAuthorizeRequest(context.Background(), tt.req)
+			assert.Equal(t, tt.wantRedirect, redirect)
+			if tt.wantCode == "" {
+				assert.NoError(t, err)
+				return
+			}
+			e, ok := err.(*Error)
+			assert.True(t, ok, "error=%v", err)
+			assert.Equal(t, tt.wantCode, e.Code)
+		})
+	}
+}
+
+func TestUsecase_AuthorizationCodeFlow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	uc, store := newTestUsecase(t, ctrl)
+
+	code, err := uc.Authorize(ctx, &AuthorizeRequest{
+		ResponseType:        "code",
+		ClientID:            "spa",
+		RedirectURI:         "https://spa.example.com/callback",
+		Scope:               "openid email",
+		CodeChallenge:       s256(testVerifier),
+		CodeChallengeMethod: CodeChallengeMethodS256,
+		Nonce:               "n-0S6_WzA2Mj",
+	}, testUser)
+	assert.NoError(t, err)
+	_, ok := store.codes[code]
+	assert.False(t, ok, "authorization code must not be stored in plain text")
+
+	req := &TokenRequest{
+		GrantType:    repo.GrantTypeAuthorizationCode,
+		ClientID:     "spa",
+		Code:         code,
+		RedirectURI:  "https://spa.example.com/callback",
+		CodeVerifier: testVerifier,
+	}
+	resp, err := uc.Token(ctx, req)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.AccessToken)
+	assert.NotEmpty(t, resp.IDToken)
+	assert.Empty(t, resp.RefreshToken, "client without refresh_token grant must not get refresh token")
+	assert.Equal(t, "openid email", resp.Scope)
+
+	claims, err := uc.verify(ctx, resp.IDToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", claims["sub"])
+	assert.Equal(t, "spa", claims["aud"])
+	assert.Equal(t, "n-0S6_WzA2Mj", claims["nonce"])
+	assert.Equal(t, "findliubo@163.com", claims["email"])
+
+	info, err := uc.UserInfo(ctx, resp.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"sub": "1", "email": "findliubo@163.com"}, info)
+
+	_, err = uc.Token(ctx, req)
+	assert.Equal(t, ErrCodeInvalidGrant, err.(*Error).Code, "authorization code must be single use")
+}
+
+func TestUsecase_TokenWithInvalidVerifier(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	uc, _ := newTestUsecase(t, ctrl)
+	code, err := uc.Authorize(ctx, &AuthorizeRequest{
+		ResponseType:        "code",
+		ClientID:            "spa",
+		RedirectURI:         "https://spa.example.com/callback",
+		Scope:               "openid",
+		CodeChallenge:       s256(testVerifier),
+		CodeChallengeMethod: CodeChallengeMethodS256,
+	}, testUser)
+	assert.NoError(t, err)
+	_, err = uc.Token(ctx, &TokenRequest{
+		GrantType:    repo.GrantTypeAuthorizationCode,
+		ClientID:     "spa",
+		Code:         code,
+		RedirectURI:  "https://spa.example.com/callback",
+		CodeVerifier: "wrong",
+	})
+	assert.Equal(t, ErrCodeInvalidGrant, err.(*Error).Code)
+}
+
+func TestUsecase_TokenConsumesCodeOnFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	uc, store := newTestUsecase(t, ctrl)
+	tests := []struct {
+		name string
+		req  TokenRequest
+	}{
+		{name: "wrong code verifier", req: TokenRequest{RedirectURI: "https://spa.example.com/callback", CodeVerifier: "wrong"}},
+		{name: "wrong redirect uri", req: TokenRequest{RedirectURI: "https://evil.example.com/callback", CodeVerifier: testVerifier}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := uc.Authorize(ctx, &AuthorizeRequest{
+				ResponseType:        "code",
+				ClientID:            "spa",
+				RedirectURI:         "https://spa.example.com/callback",
+				Scope:               "openid",
+				CodeChallenge:       s256(testVerifier),
+				CodeChallengeMethod: CodeChallengeMethodS256,
+			}, testUser)
+			assert.NoError(t, err)
+			req := tt.req
+			req.GrantType = repo.GrantTypeAuthorizationCode
+			req.ClientID = "spa"
+			req.Code = code
+			_, err = uc.Token(ctx, &req)
+			assert.Equal(t, ErrCodeInvalidGrant, err.(*Error).Code)
+			assert.Empty(t, store.codes, "failed exchange must not restore the code")
+
+			_, err = uc.Token(ctx, &TokenRequest{
+				GrantType:    repo.GrantTypeAuthorizationCode,
+				ClientID:     "spa",
+				Code:         code,
+				RedirectURI:  "https://spa.example.com/callback",
+				CodeVerifier: testVerifier,
+			})
+			assert.Equal(t, ErrCodeInvalidGrant, err.(*Error).Code, "code must be unusable after a failed exchange")
+		})
+	}
+}
+
+// staleTokenRepo 返回未吊销的令牌副本，模拟并发请求在吊销之前读取了同一令牌
+type staleTokenRepo struct {
+	repo.OAuthTokenRepo
+}
+
+func (r staleTokenRepo) GetByHash(ctx context.Context, hash string) (*repo.OAuthToken, error) {
+	t, err := r.OAuthTokenRepo.GetByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	stale := *t
+	stale.Revoked = false
+	return &stale, nil
+}
+
+func TestUsecase_RefreshTokenConcurrent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	uc, _ := newTestUsecase(t, ctrl)
+	code, err := uc.Authorize(ctx, &AuthorizeRequest{
+		ResponseType: "code",
+		ClientID:     "web",
+		RedirectURI:  "https://app.example.com/callback",
+		Scope:        "openid",
+	}, testUser)
+	assert.NoError(t, err)
+	resp, err := uc.Token(ctx, &TokenRequest{
+		GrantType:    repo.GrantTypeAuthorizationCode,
+		ClientID:     "web",
+		ClientSecret: "secret",
+		Code:         code,
+		RedirectURI:  "https://app.example.com/callback",
+	})
+	assert.NoError(t, err)
+
+	uc.tokenRepo = staleTokenRepo{uc.tokenRepo}
+	req := &TokenRequest{
+		GrantType:    repo.GrantTypeRefreshToken,
+		ClientID:     "web",
+		ClientSecret: "secret",
+		RefreshToken: resp.RefreshToken,
+	}
+	_, err = uc.Token(ctx, req)
+	assert.NoError(t, err)
+	_, err = uc.Token(ctx, req)
+	assert.Equal(t, ErrCodeInvalidGrant, err.(*Error).Code, "only one request may rotate the refresh token")
+}
+
+func TestUsecase_RefreshToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	uc, _ := newTestUsecase(t, ctrl)
+	code, err := uc.Authorize(ctx, &AuthorizeRequest{
+		ResponseType: "code",
+		ClientID:     "web",
+		RedirectURI:  "https://app.example.com/callback",
+		Scope:        "openid profile",
+	}, testUser)
+	assert.NoError(t, err)
+	resp, err := uc.Token(ctx, &TokenRequest{
+		GrantType:    repo.GrantTypeAuthorizationCode,
+		ClientID:     "web",
+		ClientSecret: "secret",
+		Code:         code,
+		RedirectURI:  "https://app.example.com/callback",
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.RefreshToken)
+
+	_, err = uc.Token(ctx, &TokenRequest{
+		GrantType:    repo.GrantTypeRefreshToken,
+		ClientID:     "web",
+		ClientSecret: "secret",
+		RefreshToken: resp.RefreshToken,
+		Scope:        "openid email",
+	})
+	assert.Equal(t, ErrCodeInvalidScope, err.(*Error).Code, "refresh must not widen scope")
+
+	refreshed, err := uc.Token(ctx, &TokenRequest{
+		GrantType:    repo.GrantTypeRefreshToken,
+		ClientID:     "web",
+		ClientSecret: "secret",
+		RefreshToken: resp.RefreshToken,
+	})
+	assert.NoError(t, err)
+	assert.NotEqual(t, resp.RefreshToken, refreshed.RefreshToken)
+
+	_, err = uc.Token(ctx, &TokenRequest{
+		GrantType:    repo.GrantTypeRefreshToken,
+		ClientID:     "web",
+		ClientSecret: "secret",
+		RefreshToken: resp.RefreshToken,
+	})
+	assert.Equal(t, ErrCodeInvalidGrant, err.(*Error).Code, "rotated refresh token must be rejected")
+
+	got, err := uc.Introspect(ctx, "web", "secret", refreshed.AccessToken)
+	assert.NoError(t, err)
+	assert.True(t, got.Active)
+	assert.Equal(t, "liubo", got.Username)
+	assert.Equal(t, "openid profile", got.Scope)
+
+	assert.NoError(t, uc.Revoke(ctx, "web", "secret", refreshed.AccessToken))
+	got, err = uc.Introspect(ctx, "web", "secret", refreshed.AccessToken)
+	assert.NoError(t, err)
+	assert.False(t, got.Active)
+}
+
+func TestUsecase_ClientCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	uc, _ := newTestUsecase(t, ctrl)
+	tests := []struct {
+		name     string
+		req      *TokenRequest
+		wantCode string
+	}{
+		{
+			name: "should issue token for service account",
+			req: &TokenRequest{
+				GrantType:    repo.GrantTypeClientCredentials,
+				ClientID:     "robot",
+				ClientSecret: "secret",
+				Scope:        "usm.read",
+			},
+		},
+		{
+			name: "should reject wrong secret",
+			req: &TokenRequest{
+				GrantType:    repo.GrantTypeClientCredentials,
+				ClientID:     "robot",
+				ClientSecret: "wrong",
+			},
+			wantCode: ErrCodeInvalidClient,
+		},
+		{
+			name: "should reject openid scope",
+			req: &TokenRequest{
+				GrantType:    repo.GrantTypeClientCredentials,
+				ClientID:     "robot",
+				ClientSecret: "secret",
+				Scope:        "openid",
+			},
+			wantCode: ErrCodeInvalidScope,
+		},
+		{
+			name: "should reject client without grant",
+			req: &TokenRequest{
+				GrantType:    repo.GrantTypeClientCredentials,
+				ClientID:     "web",
+				ClientSecret: "secret",
+			},
+			wantCode: ErrCodeUnauthorizedClient,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := uc.Token(ctx, tt.req)
+			if tt.wantCode != "" {
+				assert.Equal(t, tt.wantCode, err.(*Error).Code, "error=%v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Empty(t, resp.RefreshToken)
+			assert.Equal(t, int64(time.Hour.Seconds()), resp.ExpiresIn)
+			claims, err := uc.verify(ctx, resp.AccessToken)
+			assert.NoError(t, err)
+			assert.Equal(t, "2", claims["sub"])
+			assert.Equal(t, "usm.read", claims["scope"])
+		})
+	}
+}
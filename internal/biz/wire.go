@@ -2,10 +2,14 @@ package biz
 
 import (
 	"usm/internal/biz/usecase/account"
+	"usm/internal/biz/usecase/group"
+	"usm/internal/biz/usecase/oauth"
 
 	"github.com/google/wire"
 )
 
 var ProviderSet = wire.NewSet(
 	account.NewUsecase,
+	group.NewUsecase,
+	oauth.NewUsecase,
 )
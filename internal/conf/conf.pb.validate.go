@@ -0,0 +1,4950 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: conf/conf.proto
+
+package conf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Bootstrap with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Bootstrap) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Bootstrap with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BootstrapMultiError, or nil
+// if none found.
+func (m *Bootstrap) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Bootstrap) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetServer() == nil {
+		err := BootstrapValidationError{
+			field:  "Server",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetServer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Server",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Server",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetServer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BootstrapValidationError{
+				field:  "Server",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetData() == nil {
+		err := BootstrapValidationError{
+			field:  "Data",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BootstrapValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAuth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Auth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Auth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BootstrapValidationError{
+				field:  "Auth",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BootstrapValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTrace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Trace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Trace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTrace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BootstrapValidationError{
+				field:  "Trace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Log",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Log",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BootstrapValidationError{
+				field:  "Log",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BootstrapMultiError(errors)
+	}
+
+	return nil
+}
+
+// BootstrapMultiError is an error wrapping multiple validation errors returned
+// by Bootstrap.ValidateAll() if the designated constraints aren't met.
+type BootstrapMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BootstrapMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BootstrapMultiError) AllErrors() []error { return m }
+
+// BootstrapValidationError is the validation error returned by
+// Bootstrap.Validate if the designated constraints aren't met.
+type BootstrapValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BootstrapValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BootstrapValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BootstrapValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BootstrapValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BootstrapValidationError) ErrorName() string { return "BootstrapValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BootstrapValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBootstrap.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BootstrapValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BootstrapValidationError{}
+
+// Validate checks the field values on SecretRef with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretRef) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretRef with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretRefMultiError, or nil
+// if none found.
+func (m *SecretRef) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretRef) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	// no validation rules for Env
+
+	if len(errors) > 0 {
+		return SecretRefMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretRefMultiError is an error wrapping multiple validation errors returned
+// by SecretRef.ValidateAll() if the designated constraints aren't met.
+type SecretRefMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretRefMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretRefMultiError) AllErrors() []error { return m }
+
+// SecretRefValidationError is the validation error returned by
+// SecretRef.Validate if the designated constraints aren't met.
+type SecretRefValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretRefValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretRefValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretRefValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretRefValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretRefValidationError) ErrorName() string { return "SecretRefValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretRefValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretRef.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretRefValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretRefValidationError{}
+
+// Validate checks the field values on Server with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Server) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Server with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ServerMultiError, or nil if none found.
+func (m *Server) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Server) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetHttp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Http",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Http",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHttp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServerValidationError{
+				field:  "Http",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGrpc()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Grpc",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Grpc",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGrpc()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServerValidationError{
+				field:  "Grpc",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if d := m.GetDrainPeriod(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ServerValidationError{
+				field:  "DrainPeriod",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := ServerValidationError{
+					field:  "DrainPeriod",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAdmin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Admin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Admin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAdmin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServerValidationError{
+				field:  "Admin",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ServerMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServerMultiError is an error wrapping multiple validation errors returned by
+// Server.ValidateAll() if the designated constraints aren't met.
+type ServerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServerMultiError) AllErrors() []error { return m }
+
+// ServerValidationError is the validation error returned by Server.Validate if
+// the designated constraints aren't met.
+type ServerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServerValidationError) ErrorName() string { return "ServerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServerValidationError{}
+
+// Validate checks the field values on Data with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Data) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in DataMultiError, or nil if none found.
+func (m *Data) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDatabase() == nil {
+		err := DataValidationError{
+			field:  "Database",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDatabase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Database",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Database",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDatabase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Database",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMail()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Mail",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Mail",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMail()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Mail",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSigningKeyEncryption()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "SigningKeyEncryption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "SigningKeyEncryption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSigningKeyEncryption()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "SigningKeyEncryption",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DataMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataMultiError is an error wrapping multiple validation errors returned by
+// Data.ValidateAll() if the designated constraints aren't met.
+type DataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataMultiError) AllErrors() []error { return m }
+
+// DataValidationError is the validation error returned by Data.Validate if the
+// designated constraints aren't met.
+type DataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataValidationError) ErrorName() string { return "DataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataValidationError{}
+
+// Validate checks the field values on Auth with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Auth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Auth with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AuthMultiError, or nil if none found.
+func (m *Auth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Auth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOauth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "Oauth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "Oauth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOauth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthValidationError{
+				field:  "Oauth",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLdap()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "Ldap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "Ldap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLdap()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthValidationError{
+				field:  "Ldap",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetScim()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "Scim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "Scim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScim()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthValidationError{
+				field:  "Scim",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEmailVerification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "EmailVerification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "EmailVerification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmailVerification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthValidationError{
+				field:  "EmailVerification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPasswordReset()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "PasswordReset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "PasswordReset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPasswordReset()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthValidationError{
+				field:  "PasswordReset",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPasswordPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "PasswordPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "PasswordPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPasswordPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthValidationError{
+				field:  "PasswordPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInvitation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvitation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthValidationError{
+				field:  "Invitation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	_Auth_LoginIdentifiers_Unique := make(map[string]struct{}, len(m.GetLoginIdentifiers()))
+
+	for idx, item := range m.GetLoginIdentifiers() {
+		_, _ = idx, item
+
+		if _, exists := _Auth_LoginIdentifiers_Unique[item]; exists {
+			err := AuthValidationError{
+				field:  fmt.Sprintf("LoginIdentifiers[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_Auth_LoginIdentifiers_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _Auth_LoginIdentifiers_InLookup[item]; !ok {
+			err := AuthValidationError{
+				field:  fmt.Sprintf("LoginIdentifiers[%v]", idx),
+				reason: "value must be in list [username email phone]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuthMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthMultiError is an error wrapping multiple validation errors returned by
+// Auth.ValidateAll() if the designated constraints aren't met.
+type AuthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthMultiError) AllErrors() []error { return m }
+
+// AuthValidationError is the validation error returned by Auth.Validate if the
+// designated constraints aren't met.
+type AuthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthValidationError) ErrorName() string { return "AuthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthValidationError{}
+
+var _Auth_LoginIdentifiers_InLookup = map[string]struct{}{
+	"username": {},
+	"email":    {},
+	"phone":    {},
+}
+
+// Validate checks the field values on Account with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Account) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Account with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AccountMultiError, or nil if none found.
+func (m *Account) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Account) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccountValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccountValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccountValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccountMultiError is an error wrapping multiple validation errors returned
+// by Account.ValidateAll() if the designated constraints aren't met.
+type AccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountMultiError) AllErrors() []error { return m }
+
+// AccountValidationError is the validation error returned by Account.Validate
+// if the designated constraints aren't met.
+type AccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountValidationError) ErrorName() string { return "AccountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountValidationError{}
+
+// Validate checks the field values on Trace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Trace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Trace with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TraceMultiError, or nil if none found.
+func (m *Trace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Trace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Trace_Exporter_InLookup[m.GetExporter()]; !ok {
+		err := TraceValidationError{
+			field:  "Exporter",
+			reason: "value must be in list [ otlp stdout]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Endpoint
+
+	// no validation rules for Insecure
+
+	if wrapper := m.GetSampleRatio(); wrapper != nil {
+
+		if val := wrapper.GetValue(); val < 0 || val > 1 {
+			err := TraceValidationError{
+				field:  "SampleRatio",
+				reason: "value must be inside range [0, 1]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// TraceMultiError is an error wrapping multiple validation errors returned by
+// Trace.ValidateAll() if the designated constraints aren't met.
+type TraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TraceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TraceMultiError) AllErrors() []error { return m }
+
+// TraceValidationError is the validation error returned by Trace.Validate if
+// the designated constraints aren't met.
+type TraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TraceValidationError) ErrorName() string { return "TraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TraceValidationError{}
+
+var _Trace_Exporter_InLookup = map[string]struct{}{
+	"":       {},
+	"otlp":   {},
+	"stdout": {},
+}
+
+// Validate checks the field values on Log with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Log) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Log with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LogMultiError, or nil if none found.
+func (m *Log) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Log) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Log_Level_InLookup[m.GetLevel()]; !ok {
+		err := LogValidationError{
+			field:  "Level",
+			reason: "value must be in list [ debug info warn error]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetModules()))
+		i := 0
+		for key := range m.GetModules() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetModules()[key]
+			_ = val
+
+			// no validation rules for Modules[key]
+
+			if !_Log_Modules_Pattern.MatchString(val) {
+				err := LogValidationError{
+					field:  fmt.Sprintf("Modules[%v]", key),
+					reason: "value does not match regex pattern \"^(debug|info|warn|error)$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	for idx, item := range m.GetOutputs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LogValidationError{
+						field:  fmt.Sprintf("Outputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LogValidationError{
+						field:  fmt.Sprintf("Outputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LogValidationError{
+					field:  fmt.Sprintf("Outputs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSampling()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LogValidationError{
+					field:  "Sampling",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LogValidationError{
+					field:  "Sampling",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSampling()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LogValidationError{
+				field:  "Sampling",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRateLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LogValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LogValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LogValidationError{
+				field:  "RateLimit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Dev
+
+	// no validation rules for Prefix
+
+	if _, ok := _Log_Encoding_InLookup[m.GetEncoding()]; !ok {
+		err := LogValidationError{
+			field:  "Encoding",
+			reason: "value must be in list [ console json]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LogValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LogValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LogValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _Log_StacktraceLevel_InLookup[m.GetStacktraceLevel()]; !ok {
+		err := LogValidationError{
+			field:  "StacktraceLevel",
+			reason: "value must be in list [ debug info warn error]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for KeepEmails
+
+	if len(errors) > 0 {
+		return LogMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogMultiError is an error wrapping multiple validation errors returned by
+// Log.ValidateAll() if the designated constraints aren't met.
+type LogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogMultiError) AllErrors() []error { return m }
+
+// LogValidationError is the validation error returned by Log.Validate if the
+// designated constraints aren't met.
+type LogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogValidationError) ErrorName() string { return "LogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogValidationError{}
+
+var _Log_Level_InLookup = map[string]struct{}{
+	"":      {},
+	"debug": {},
+	"info":  {},
+	"warn":  {},
+	"error": {},
+}
+
+var _Log_Modules_Pattern = regexp.MustCompile("^(debug|info|warn|error)$")
+
+var _Log_Encoding_InLookup = map[string]struct{}{
+	"":        {},
+	"console": {},
+	"json":    {},
+}
+
+var _Log_StacktraceLevel_InLookup = map[string]struct{}{
+	"":      {},
+	"debug": {},
+	"info":  {},
+	"warn":  {},
+	"error": {},
+}
+
+// Validate checks the field values on Server_HTTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Server_HTTP) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Server_HTTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Server_HTTPMultiError, or
+// nil if none found.
+func (m *Server_HTTP) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Server_HTTP) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	if utf8.RuneCountInString(m.GetAddr()) < 1 {
+		err := Server_HTTPValidationError{
+			field:  "Addr",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Server_HTTPValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Server_HTTPValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCors()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Cors",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Cors",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCors()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_HTTPValidationError{
+				field:  "Cors",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Server_HTTPMultiError(errors)
+	}
+
+	return nil
+}
+
+// Server_HTTPMultiError is an error wrapping multiple validation errors
+// returned by Server_HTTP.ValidateAll() if the designated constraints aren't met.
+type Server_HTTPMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Server_HTTPMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Server_HTTPMultiError) AllErrors() []error { return m }
+
+// Server_HTTPValidationError is the validation error returned by
+// Server_HTTP.Validate if the designated constraints aren't met.
+type Server_HTTPValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Server_HTTPValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Server_HTTPValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Server_HTTPValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Server_HTTPValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Server_HTTPValidationError) ErrorName() string { return "Server_HTTPValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Server_HTTPValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServer_HTTP.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Server_HTTPValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Server_HTTPValidationError{}
+
+// Validate checks the field values on Server_GRPC with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Server_GRPC) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Server_GRPC with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Server_GRPCMultiError, or
+// nil if none found.
+func (m *Server_GRPC) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Server_GRPC) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	if utf8.RuneCountInString(m.GetAddr()) < 1 {
+		err := Server_GRPCValidationError{
+			field:  "Addr",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Server_GRPCValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Server_GRPCValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return Server_GRPCMultiError(errors)
+	}
+
+	return nil
+}
+
+// Server_GRPCMultiError is an error wrapping multiple validation errors
+// returned by Server_GRPC.ValidateAll() if the designated constraints aren't met.
+type Server_GRPCMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Server_GRPCMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Server_GRPCMultiError) AllErrors() []error { return m }
+
+// Server_GRPCValidationError is the validation error returned by
+// Server_GRPC.Validate if the designated constraints aren't met.
+type Server_GRPCValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Server_GRPCValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Server_GRPCValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Server_GRPCValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Server_GRPCValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Server_GRPCValidationError) ErrorName() string { return "Server_GRPCValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Server_GRPCValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServer_GRPC.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Server_GRPCValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Server_GRPCValidationError{}
+
+// Validate checks the field values on Server_Admin with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Server_Admin) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Server_Admin with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Server_AdminMultiError, or
+// nil if none found.
+func (m *Server_Admin) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Server_Admin) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBearerTokensRef()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_AdminValidationError{
+					field:  "BearerTokensRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_AdminValidationError{
+					field:  "BearerTokensRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBearerTokensRef()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_AdminValidationError{
+				field:  "BearerTokensRef",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Server_AdminMultiError(errors)
+	}
+
+	return nil
+}
+
+// Server_AdminMultiError is an error wrapping multiple validation errors
+// returned by Server_Admin.ValidateAll() if the designated constraints aren't met.
+type Server_AdminMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Server_AdminMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Server_AdminMultiError) AllErrors() []error { return m }
+
+// Server_AdminValidationError is the validation error returned by
+// Server_Admin.Validate if the designated constraints aren't met.
+type Server_AdminValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Server_AdminValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Server_AdminValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Server_AdminValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Server_AdminValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Server_AdminValidationError) ErrorName() string { return "Server_AdminValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Server_AdminValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServer_Admin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Server_AdminValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Server_AdminValidationError{}
+
+// Validate checks the field values on Server_HTTP_CORS with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Server_HTTP_CORS) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Server_HTTP_CORS with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Server_HTTP_CORSMultiError, or nil if none found.
+func (m *Server_HTTP_CORS) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Server_HTTP_CORS) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AllowCredentials
+
+	if d := m.GetMaxAge(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Server_HTTP_CORSValidationError{
+				field:  "MaxAge",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := Server_HTTP_CORSValidationError{
+					field:  "MaxAge",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return Server_HTTP_CORSMultiError(errors)
+	}
+
+	return nil
+}
+
+// Server_HTTP_CORSMultiError is an error wrapping multiple validation errors
+// returned by Server_HTTP_CORS.ValidateAll() if the designated constraints
+// aren't met.
+type Server_HTTP_CORSMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Server_HTTP_CORSMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Server_HTTP_CORSMultiError) AllErrors() []error { return m }
+
+// Server_HTTP_CORSValidationError is the validation error returned by
+// Server_HTTP_CORS.Validate if the designated constraints aren't met.
+type Server_HTTP_CORSValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Server_HTTP_CORSValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Server_HTTP_CORSValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Server_HTTP_CORSValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Server_HTTP_CORSValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Server_HTTP_CORSValidationError) ErrorName() string { return "Server_HTTP_CORSValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Server_HTTP_CORSValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServer_HTTP_CORS.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Server_HTTP_CORSValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Server_HTTP_CORSValidationError{}
+
+// Validate checks the field values on Data_Database with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Database) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Database with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_DatabaseMultiError, or
+// nil if none found.
+func (m *Data_Database) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Database) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDriver()) < 1 {
+		err := Data_DatabaseValidationError{
+			field:  "Driver",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Source
+
+	// no validation rules for Debug
+
+	if all {
+		switch v := interface{}(m.GetSourceRef()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_DatabaseValidationError{
+					field:  "SourceRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_DatabaseValidationError{
+					field:  "SourceRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSourceRef()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_DatabaseValidationError{
+				field:  "SourceRef",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPasswordRef()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_DatabaseValidationError{
+					field:  "PasswordRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_DatabaseValidationError{
+					field:  "PasswordRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPasswordRef()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_DatabaseValidationError{
+				field:  "PasswordRef",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Data_DatabaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_DatabaseMultiError is an error wrapping multiple validation errors
+// returned by Data_Database.ValidateAll() if the designated constraints
+// aren't met.
+type Data_DatabaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_DatabaseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_DatabaseMultiError) AllErrors() []error { return m }
+
+// Data_DatabaseValidationError is the validation error returned by
+// Data_Database.Validate if the designated constraints aren't met.
+type Data_DatabaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_DatabaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_DatabaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_DatabaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_DatabaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_DatabaseValidationError) ErrorName() string { return "Data_DatabaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_DatabaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Database.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_DatabaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_DatabaseValidationError{}
+
+// Validate checks the field values on Data_Mail with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Mail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Mail with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_MailMultiError, or nil
+// if none found.
+func (m *Data_Mail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Mail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for From
+
+	if all {
+		switch v := interface{}(m.GetSmtp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_MailValidationError{
+					field:  "Smtp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_MailValidationError{
+					field:  "Smtp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSmtp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_MailValidationError{
+				field:  "Smtp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for File
+
+	if len(errors) > 0 {
+		return Data_MailMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_MailMultiError is an error wrapping multiple validation errors returned
+// by Data_Mail.ValidateAll() if the designated constraints aren't met.
+type Data_MailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_MailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_MailMultiError) AllErrors() []error { return m }
+
+// Data_MailValidationError is the validation error returned by
+// Data_Mail.Validate if the designated constraints aren't met.
+type Data_MailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_MailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_MailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_MailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_MailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_MailValidationError) ErrorName() string { return "Data_MailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_MailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Mail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_MailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_MailValidationError{}
+
+// Validate checks the field values on Data_KeyEncryption with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Data_KeyEncryption) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_KeyEncryption with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Data_KeyEncryptionMultiError, or nil if none found.
+func (m *Data_KeyEncryption) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_KeyEncryption) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKeysRef()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_KeyEncryptionValidationError{
+					field:  "KeysRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_KeyEncryptionValidationError{
+					field:  "KeysRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKeysRef()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_KeyEncryptionValidationError{
+				field:  "KeysRef",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Data_KeyEncryptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_KeyEncryptionMultiError is an error wrapping multiple validation errors
+// returned by Data_KeyEncryption.ValidateAll() if the designated constraints
+// aren't met.
+type Data_KeyEncryptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_KeyEncryptionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_KeyEncryptionMultiError) AllErrors() []error { return m }
+
+// Data_KeyEncryptionValidationError is the validation error returned by
+// Data_KeyEncryption.Validate if the designated constraints aren't met.
+type Data_KeyEncryptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_KeyEncryptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_KeyEncryptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_KeyEncryptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_KeyEncryptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_KeyEncryptionValidationError) ErrorName() string {
+	return "Data_KeyEncryptionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Data_KeyEncryptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_KeyEncryption.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_KeyEncryptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_KeyEncryptionValidationError{}
+
+// Validate checks the field values on Data_Mail_SMTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Mail_SMTP) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Mail_SMTP with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_Mail_SMTPMultiError,
+// or nil if none found.
+func (m *Data_Mail_SMTP) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Mail_SMTP) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Addr
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	if all {
+		switch v := interface{}(m.GetUsernameRef()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_Mail_SMTPValidationError{
+					field:  "UsernameRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_Mail_SMTPValidationError{
+					field:  "UsernameRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsernameRef()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_Mail_SMTPValidationError{
+				field:  "UsernameRef",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPasswordRef()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_Mail_SMTPValidationError{
+					field:  "PasswordRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_Mail_SMTPValidationError{
+					field:  "PasswordRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPasswordRef()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_Mail_SMTPValidationError{
+				field:  "PasswordRef",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Data_Mail_SMTPMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_Mail_SMTPMultiError is an error wrapping multiple validation errors
+// returned by Data_Mail_SMTP.ValidateAll() if the designated constraints
+// aren't met.
+type Data_Mail_SMTPMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_Mail_SMTPMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_Mail_SMTPMultiError) AllErrors() []error { return m }
+
+// Data_Mail_SMTPValidationError is the validation error returned by
+// Data_Mail_SMTP.Validate if the designated constraints aren't met.
+type Data_Mail_SMTPValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_Mail_SMTPValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_Mail_SMTPValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_Mail_SMTPValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_Mail_SMTPValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_Mail_SMTPValidationError) ErrorName() string { return "Data_Mail_SMTPValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_Mail_SMTPValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Mail_SMTP.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_Mail_SMTPValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_Mail_SMTPValidationError{}
+
+// Validate checks the field values on Auth_OAuth with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Auth_OAuth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Auth_OAuth with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Auth_OAuthMultiError, or
+// nil if none found.
+func (m *Auth_OAuth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Auth_OAuth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetIssuer() != "" {
+
+		if uri, err := url.Parse(m.GetIssuer()); err != nil {
+			err = Auth_OAuthValidationError{
+				field:  "Issuer",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := Auth_OAuthValidationError{
+				field:  "Issuer",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if d := m.GetCodeTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Auth_OAuthValidationError{
+				field:  "CodeTtl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Auth_OAuthValidationError{
+					field:  "CodeTtl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetAccessTokenTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Auth_OAuthValidationError{
+				field:  "AccessTokenTtl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Auth_OAuthValidationError{
+					field:  "AccessTokenTtl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetRefreshTokenTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Auth_OAuthValidationError{
+				field:  "RefreshTokenTtl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Auth_OAuthValidationError{
+					field:  "RefreshTokenTtl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetKeyRotationPeriod(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Auth_OAuthValidationError{
+				field:  "KeyRotationPeriod",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Auth_OAuthValidationError{
+					field:  "KeyRotationPeriod",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetKeyOverlap(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Auth_OAuthValidationError{
+				field:  "KeyOverlap",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Auth_OAuthValidationError{
+					field:  "KeyOverlap",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetKeyCheckInterval(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Auth_OAuthValidationError{
+				field:  "KeyCheckInterval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Auth_OAuthValidationError{
+					field:  "KeyCheckInterval",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return Auth_OAuthMultiError(errors)
+	}
+
+	return nil
+}
+
+// Auth_OAuthMultiError is an error wrapping multiple validation errors
+// returned by Auth_OAuth.ValidateAll() if the designated constraints aren't met.
+type Auth_OAuthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Auth_OAuthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Auth_OAuthMultiError) AllErrors() []error { return m }
+
+// Auth_OAuthValidationError is the validation error returned by
+// Auth_OAuth.Validate if the designated constraints aren't met.
+type Auth_OAuthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Auth_OAuthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Auth_OAuthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Auth_OAuthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Auth_OAuthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Auth_OAuthValidationError) ErrorName() string { return "Auth_OAuthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Auth_OAuthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuth_OAuth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Auth_OAuthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Auth_OAuthValidationError{}
+
+// Validate checks the field values on Auth_LDAP with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Auth_LDAP) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Auth_LDAP with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Auth_LDAPMultiError, or nil
+// if none found.
+func (m *Auth_LDAP) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Auth_LDAP) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUrl() != "" {
+
+		if uri, err := url.Parse(m.GetUrl()); err != nil {
+			err = Auth_LDAPValidationError{
+				field:  "Url",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := Auth_LDAPValidationError{
+				field:  "Url",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for BindDn
+
+	// no validation rules for BindPassword
+
+	// no validation rules for BaseDn
+
+	// no validation rules for UserFilter
+
+	// no validation rules for EmailAttribute
+
+	// no validation rules for StartTls
+
+	// no validation rules for CaFile
+
+	// no validation rules for InsecureSkipVerify
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Auth_LDAPValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Auth_LDAPValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBindPasswordRef()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Auth_LDAPValidationError{
+					field:  "BindPasswordRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Auth_LDAPValidationError{
+					field:  "BindPasswordRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBindPasswordRef()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Auth_LDAPValidationError{
+				field:  "BindPasswordRef",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Auth_LDAPMultiError(errors)
+	}
+
+	return nil
+}
+
+// Auth_LDAPMultiError is an error wrapping multiple validation errors returned
+// by Auth_LDAP.ValidateAll() if the designated constraints aren't met.
+type Auth_LDAPMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Auth_LDAPMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Auth_LDAPMultiError) AllErrors() []error { return m }
+
+// Auth_LDAPValidationError is the validation error returned by
+// Auth_LDAP.Validate if the designated constraints aren't met.
+type Auth_LDAPValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Auth_LDAPValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Auth_LDAPValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Auth_LDAPValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Auth_LDAPValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Auth_LDAPValidationError) ErrorName() string { return "Auth_LDAPValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Auth_LDAPValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuth_LDAP.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Auth_LDAPValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Auth_LDAPValidationError{}
+
+// Validate checks the field values on Auth_SCIM with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Auth_SCIM) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Auth_SCIM with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Auth_SCIMMultiError, or nil
+// if none found.
+func (m *Auth_SCIM) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Auth_SCIM) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBearerTokensRef()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Auth_SCIMValidationError{
+					field:  "BearerTokensRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Auth_SCIMValidationError{
+					field:  "BearerTokensRef",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBearerTokensRef()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Auth_SCIMValidationError{
+				field:  "BearerTokensRef",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Auth_SCIMMultiError(errors)
+	}
+
+	return nil
+}
+
+// Auth_SCIMMultiError is an error wrapping multiple validation errors returned
+// by Auth_SCIM.ValidateAll() if the designated constraints aren't met.
+type Auth_SCIMMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Auth_SCIMMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Auth_SCIMMultiError) AllErrors() []error { return m }
+
+// Auth_SCIMValidationError is the validation error returned by
+// Auth_SCIM.Validate if the designated constraints aren't met.
+type Auth_SCIMValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Auth_SCIMValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Auth_SCIMValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Auth_SCIMValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Auth_SCIMValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Auth_SCIMValidationError) ErrorName() string { return "Auth_SCIMValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Auth_SCIMValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuth_SCIM.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Auth_SCIMValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Auth_SCIMValidationError{}
+
+// Validate checks the field values on Auth_EmailVerification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Auth_EmailVerification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Auth_EmailVerification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Auth_EmailVerificationMultiError, or nil if none found.
+func (m *Auth_EmailVerification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Auth_EmailVerification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if d := m.GetTokenTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Auth_EmailVerificationValidationError{
+				field:  "TokenTtl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Auth_EmailVerificationValidationError{
+					field:  "TokenTtl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return Auth_EmailVerificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// Auth_EmailVerificationMultiError is an error wrapping multiple validation
+// errors returned by Auth_EmailVerification.ValidateAll() if the designated
+// constraints aren't met.
+type Auth_EmailVerificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Auth_EmailVerificationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Auth_EmailVerificationMultiError) AllErrors() []error { return m }
+
+// Auth_EmailVerificationValidationError is the validation error returned by
+// Auth_EmailVerification.Validate if the designated constraints aren't met.
+type Auth_EmailVerificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Auth_EmailVerificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Auth_EmailVerificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Auth_EmailVerificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Auth_EmailVerificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Auth_EmailVerificationValidationError) ErrorName() string {
+	return "Auth_EmailVerificationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Auth_EmailVerificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuth_EmailVerification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Auth_EmailVerificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Auth_EmailVerificationValidationError{}
+
+// Validate checks the field values on Auth_PasswordReset with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Auth_PasswordReset) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Auth_PasswordReset with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Auth_PasswordResetMultiError, or nil if none found.
+func (m *Auth_PasswordReset) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Auth_PasswordReset) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if d := m.GetTokenTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Auth_PasswordResetValidationError{
+				field:  "TokenTtl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Auth_PasswordResetValidationError{
+					field:  "TokenTtl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return Auth_PasswordResetMultiError(errors)
+	}
+
+	return nil
+}
+
+// Auth_PasswordResetMultiError is an error wrapping multiple validation errors
+// returned by Auth_PasswordReset.ValidateAll() if the designated constraints
+// aren't met.
+type Auth_PasswordResetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Auth_PasswordResetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Auth_PasswordResetMultiError) AllErrors() []error { return m }
+
+// Auth_PasswordResetValidationError is the validation error returned by
+// Auth_PasswordReset.Validate if the designated constraints aren't met.
+type Auth_PasswordResetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Auth_PasswordResetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Auth_PasswordResetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Auth_PasswordResetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Auth_PasswordResetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Auth_PasswordResetValidationError) ErrorName() string {
+	return "Auth_PasswordResetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Auth_PasswordResetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuth_PasswordReset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Auth_PasswordResetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Auth_PasswordResetValidationError{}
+
+// Validate checks the field values on Auth_PasswordPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Auth_PasswordPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Auth_PasswordPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Auth_PasswordPolicyMultiError, or nil if none found.
+func (m *Auth_PasswordPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Auth_PasswordPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMinLength() < 0 {
+		err := Auth_PasswordPolicyValidationError{
+			field:  "MinLength",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxLength() < 0 {
+		err := Auth_PasswordPolicyValidationError{
+			field:  "MaxLength",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RequireUppercase
+
+	// no validation rules for RequireLowercase
+
+	// no validation rules for RequireDigit
+
+	// no validation rules for RequireSymbol
+
+	if len(errors) > 0 {
+		return Auth_PasswordPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// Auth_PasswordPolicyMultiError is an error wrapping multiple validation
+// errors returned by Auth_PasswordPolicy.ValidateAll() if the designated
+// constraints aren't met.
+type Auth_PasswordPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Auth_PasswordPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Auth_PasswordPolicyMultiError) AllErrors() []error { return m }
+
+// Auth_PasswordPolicyValidationError is the validation error returned by
+// Auth_PasswordPolicy.Validate if the designated constraints aren't met.
+type Auth_PasswordPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Auth_PasswordPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Auth_PasswordPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Auth_PasswordPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Auth_PasswordPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Auth_PasswordPolicyValidationError) ErrorName() string {
+	return "Auth_PasswordPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Auth_PasswordPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuth_PasswordPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Auth_PasswordPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Auth_PasswordPolicyValidationError{}
+
+// Validate checks the field values on Auth_Invitation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Auth_Invitation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Auth_Invitation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Auth_InvitationMultiError, or nil if none found.
+func (m *Auth_Invitation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Auth_Invitation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if d := m.GetTokenTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Auth_InvitationValidationError{
+				field:  "TokenTtl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Auth_InvitationValidationError{
+					field:  "TokenTtl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return Auth_InvitationMultiError(errors)
+	}
+
+	return nil
+}
+
+// Auth_InvitationMultiError is an error wrapping multiple validation errors
+// returned by Auth_Invitation.ValidateAll() if the designated constraints
+// aren't met.
+type Auth_InvitationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Auth_InvitationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Auth_InvitationMultiError) AllErrors() []error { return m }
+
+// Auth_InvitationValidationError is the validation error returned by
+// Auth_Invitation.Validate if the designated constraints aren't met.
+type Auth_InvitationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Auth_InvitationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Auth_InvitationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Auth_InvitationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Auth_InvitationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Auth_InvitationValidationError) ErrorName() string { return "Auth_InvitationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Auth_InvitationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuth_Invitation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Auth_InvitationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Auth_InvitationValidationError{}
+
+// Validate checks the field values on Account_Attribute with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Account_Attribute) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Account_Attribute with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Account_AttributeMultiError, or nil if none found.
+func (m *Account_Attribute) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Account_Attribute) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_Account_Attribute_Name_Pattern.MatchString(m.GetName()) {
+		err := Account_AttributeValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _Account_Attribute_Type_InLookup[m.GetType()]; !ok {
+		err := Account_AttributeValidationError{
+			field:  "Type",
+			reason: "value must be in list [string number bool]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return Account_AttributeMultiError(errors)
+	}
+
+	return nil
+}
+
+// Account_AttributeMultiError is an error wrapping multiple validation errors
+// returned by Account_Attribute.ValidateAll() if the designated constraints
+// aren't met.
+type Account_AttributeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Account_AttributeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Account_AttributeMultiError) AllErrors() []error { return m }
+
+// Account_AttributeValidationError is the validation error returned by
+// Account_Attribute.Validate if the designated constraints aren't met.
+type Account_AttributeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Account_AttributeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Account_AttributeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Account_AttributeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Account_AttributeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Account_AttributeValidationError) ErrorName() string {
+	return "Account_AttributeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Account_AttributeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccount_Attribute.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Account_AttributeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Account_AttributeValidationError{}
+
+var _Account_Attribute_Name_Pattern = regexp.MustCompile("^[a-z0-9_]+$")
+
+var _Account_Attribute_Type_InLookup = map[string]struct{}{
+	"string": {},
+	"number": {},
+	"bool":   {},
+}
+
+// Validate checks the field values on Log_Output with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Log_Output) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Log_Output with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Log_OutputMultiError, or
+// nil if none found.
+func (m *Log_Output) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Log_Output) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Log_Output_Type_InLookup[m.GetType()]; !ok {
+		err := Log_OutputValidationError{
+			field:  "Type",
+			reason: "value must be in list [stdout stderr file syslog]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _Log_Output_Level_InLookup[m.GetLevel()]; !ok {
+		err := Log_OutputValidationError{
+			field:  "Level",
+			reason: "value must be in list [ debug info warn error]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _Log_Output_Encoding_InLookup[m.GetEncoding()]; !ok {
+		err := Log_OutputValidationError{
+			field:  "Encoding",
+			reason: "value must be in list [ console json]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Log_OutputValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Log_OutputValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Log_OutputValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSyslog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Log_OutputValidationError{
+					field:  "Syslog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Log_OutputValidationError{
+					field:  "Syslog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyslog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Log_OutputValidationError{
+				field:  "Syslog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Log_OutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// Log_OutputMultiError is an error wrapping multiple validation errors
+// returned by Log_Output.ValidateAll() if the designated constraints aren't met.
+type Log_OutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Log_OutputMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Log_OutputMultiError) AllErrors() []error { return m }
+
+// Log_OutputValidationError is the validation error returned by
+// Log_Output.Validate if the designated constraints aren't met.
+type Log_OutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Log_OutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Log_OutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Log_OutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Log_OutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Log_OutputValidationError) ErrorName() string { return "Log_OutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Log_OutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLog_Output.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Log_OutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Log_OutputValidationError{}
+
+var _Log_Output_Type_InLookup = map[string]struct{}{
+	"stdout": {},
+	"stderr": {},
+	"file":   {},
+	"syslog": {},
+}
+
+var _Log_Output_Level_InLookup = map[string]struct{}{
+	"":      {},
+	"debug": {},
+	"info":  {},
+	"warn":  {},
+	"error": {},
+}
+
+var _Log_Output_Encoding_InLookup = map[string]struct{}{
+	"":        {},
+	"console": {},
+	"json":    {},
+}
+
+// Validate checks the field values on Log_Sampling with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Log_Sampling) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Log_Sampling with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Log_SamplingMultiError, or
+// nil if none found.
+func (m *Log_Sampling) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Log_Sampling) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTick()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Log_SamplingValidationError{
+					field:  "Tick",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Log_SamplingValidationError{
+					field:  "Tick",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTick()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Log_SamplingValidationError{
+				field:  "Tick",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetInitial() <= 0 {
+		err := Log_SamplingValidationError{
+			field:  "Initial",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetThereafter() < 0 {
+		err := Log_SamplingValidationError{
+			field:  "Thereafter",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return Log_SamplingMultiError(errors)
+	}
+
+	return nil
+}
+
+// Log_SamplingMultiError is an error wrapping multiple validation errors
+// returned by Log_Sampling.ValidateAll() if the designated constraints aren't met.
+type Log_SamplingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Log_SamplingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Log_SamplingMultiError) AllErrors() []error { return m }
+
+// Log_SamplingValidationError is the validation error returned by
+// Log_Sampling.Validate if the designated constraints aren't met.
+type Log_SamplingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Log_SamplingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Log_SamplingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Log_SamplingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Log_SamplingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Log_SamplingValidationError) ErrorName() string { return "Log_SamplingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Log_SamplingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLog_Sampling.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Log_SamplingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Log_SamplingValidationError{}
+
+// Validate checks the field values on Log_RateLimit with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Log_RateLimit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Log_RateLimit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Log_RateLimitMultiError, or
+// nil if none found.
+func (m *Log_RateLimit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Log_RateLimit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Log_RateLimitValidationError{
+					field:  "Interval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Log_RateLimitValidationError{
+					field:  "Interval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Log_RateLimitValidationError{
+				field:  "Interval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetBurst() <= 0 {
+		err := Log_RateLimitValidationError{
+			field:  "Burst",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return Log_RateLimitMultiError(errors)
+	}
+
+	return nil
+}
+
+// Log_RateLimitMultiError is an error wrapping multiple validation errors
+// returned by Log_RateLimit.ValidateAll() if the designated constraints
+// aren't met.
+type Log_RateLimitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Log_RateLimitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Log_RateLimitMultiError) AllErrors() []error { return m }
+
+// Log_RateLimitValidationError is the validation error returned by
+// Log_RateLimit.Validate if the designated constraints aren't met.
+type Log_RateLimitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Log_RateLimitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Log_RateLimitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Log_RateLimitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Log_RateLimitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Log_RateLimitValidationError) ErrorName() string { return "Log_RateLimitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Log_RateLimitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLog_RateLimit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Log_RateLimitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Log_RateLimitValidationError{}
+
+// Validate checks the field values on Log_Output_File with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Log_Output_File) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Log_Output_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Log_Output_FileMultiError, or nil if none found.
+func (m *Log_Output_File) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Log_Output_File) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for MaxSize
+
+	// no validation rules for MaxBackups
+
+	// no validation rules for MaxAge
+
+	// no validation rules for Compress
+
+	// no validation rules for LocalTime
+
+	if len(errors) > 0 {
+		return Log_Output_FileMultiError(errors)
+	}
+
+	return nil
+}
+
+// Log_Output_FileMultiError is an error wrapping multiple validation errors
+// returned by Log_Output_File.ValidateAll() if the designated constraints
+// aren't met.
+type Log_Output_FileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Log_Output_FileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Log_Output_FileMultiError) AllErrors() []error { return m }
+
+// Log_Output_FileValidationError is the validation error returned by
+// Log_Output_File.Validate if the designated constraints aren't met.
+type Log_Output_FileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Log_Output_FileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Log_Output_FileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Log_Output_FileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Log_Output_FileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Log_Output_FileValidationError) ErrorName() string { return "Log_Output_FileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Log_Output_FileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLog_Output_File.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Log_Output_FileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Log_Output_FileValidationError{}
+
+// Validate checks the field values on Log_Output_Syslog with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Log_Output_Syslog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Log_Output_Syslog with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Log_Output_SyslogMultiError, or nil if none found.
+func (m *Log_Output_Syslog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Log_Output_Syslog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for Address
+
+	// no validation rules for Facility
+
+	// no validation rules for Tag
+
+	if len(errors) > 0 {
+		return Log_Output_SyslogMultiError(errors)
+	}
+
+	return nil
+}
+
+// Log_Output_SyslogMultiError is an error wrapping multiple validation errors
+// returned by Log_Output_Syslog.ValidateAll() if the designated constraints
+// aren't met.
+type Log_Output_SyslogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Log_Output_SyslogMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Log_Output_SyslogMultiError) AllErrors() []error { return m }
+
+// Log_Output_SyslogValidationError is the validation error returned by
+// Log_Output_Syslog.Validate if the designated constraints aren't met.
+type Log_Output_SyslogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Log_Output_SyslogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Log_Output_SyslogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Log_Output_SyslogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Log_Output_SyslogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Log_Output_SyslogValidationError) ErrorName() string {
+	return "Log_Output_SyslogValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Log_Output_SyslogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLog_Output_Syslog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Log_Output_SyslogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Log_Output_SyslogValidationError{}
@@ -0,0 +1,109 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/data/ent"
+	"usm/internal/data/ent/apikey"
+)
+
+type apiKeyRepo struct {
+	data *Data
+}
+
+func NewAPIKeyRepo(data *Data) repo.APIKeyRepo {
+	return &apiKeyRepo{
+		data: data,
+	}
+}
+
+func (r *apiKeyRepo) Create(ctx context.Context, m *repo.APIKey) (*repo.APIKey, error) {
+	c := r.data.DB(ctx).APIKey.
+		Create().
+		SetUserID(int64(m.UserID)).
+		SetName(m.Name).
+		SetPrefix(m.Prefix).
+		SetHash(m.Hash)
+	if !m.ExpireTime.IsZero() {
+		c.SetExpireTime(m.ExpireTime)
+	}
+	k, err := c.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.ErrResourceAlreadyExists
+		}
+		return nil, err
+	}
+	return r.apiKeyFromEntity(k), nil
+}
+
+func (r *apiKeyRepo) Delete(ctx context.Context, id int) error {
+	err := r.data.DB(ctx).APIKey.DeleteOneID(int64(id)).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
+}
+
+func (r *apiKeyRepo) Get(ctx context.Context, id int) (*repo.APIKey, error) {
+	k, err := r.data.DB(ctx).APIKey.Get(ctx, int64(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.apiKeyFromEntity(k), nil
+}
+
+func (r *apiKeyRepo) GetByPrefix(ctx context.Context, prefix string) (*repo.APIKey, error) {
+	k, err := r.data.DB(ctx).APIKey.Query().Where(
+		apikey.Prefix(prefix),
+	).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.apiKeyFromEntity(k), nil
+}
+
+func (r *apiKeyRepo) ListByUser(ctx context.Context, userID int) ([]*repo.APIKey, error) {
+	ents, err := r.data.DB(ctx).APIKey.Query().Where(
+		apikey.UserID(int64(userID)),
+	).Order(ent.Asc(apikey.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*repo.APIKey, 0, len(ents))
+	for _, k := range ents {
+		keys = append(keys, r.apiKeyFromEntity(k))
+	}
+	return keys, nil
+}
+
+func (r *apiKeyRepo) SetLastUsedTime(ctx context.Context, id int, t time.Time) error {
+	err := r.data.DB(ctx).APIKey.UpdateOneID(int64(id)).SetLastUsedTime(t).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
+}
+
+func (r *apiKeyRepo) apiKeyFromEntity(k *ent.APIKey) *repo.APIKey {
+	return &repo.APIKey{
+		ID:           int(k.ID),
+		UserID:       int(k.UserID),
+		Name:         k.Name,
+		Prefix:       k.Prefix,
+		Hash:         k.Hash,
+		ExpireTime:   k.ExpireTime,
+		LastUsedTime: k.LastUsedTime,
+		CreateTime:   k.CreateTime,
+		UpdateTime:   k.UpdateTime,
+	}
+}
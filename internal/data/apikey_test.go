@@ -0,0 +1,129 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestServiceAccount(ctx context.Context, t *testing.T, data *Data) *repo.User {
+	u, err := NewUserRepo(data).Create(ctx, &repo.User{
+		Username:  "robot",
+		Kind:      repo.UserKindService,
+		OwnerKind: repo.OwnerKindGroup,
+		OwnerID:   1,
+	})
+	assert.NoError(t, err)
+	return u
+}
+
+func Test_apiKeyRepo_Create(t *testing.T) {
+	ctx := context.Background()
+	expireTime := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	type args struct {
+		key *repo.APIKey
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *repo.APIKey
+		wantErr error
+	}{
+		{
+			name: "should create api key successfully",
+			args: args{
+				key: &repo.APIKey{
+					UserID:     1,
+					Name:       "ci",
+					Prefix:     "abcd1234",
+					Hash:       "hash",
+					ExpireTime: expireTime,
+				},
+			},
+			want: &repo.APIKey{
+				ID:         1,
+				UserID:     1,
+				Name:       "ci",
+				Prefix:     "abcd1234",
+				Hash:       "hash",
+				ExpireTime: expireTime,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, teardown := NewTestData(t)
+			defer teardown()
+			newTestServiceAccount(ctx, t, data)
+			r := NewAPIKeyRepo(data)
+			got, err := r.Create(ctx, tt.args.key)
+			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
+			got.CreateTime = time.Time{}
+			got.UpdateTime = time.Time{}
+			got.ExpireTime = got.ExpireTime.UTC()
+			assert.Equal(t, tt.want, got, "mismatch: got=%v, want=%v", got, tt.want)
+		})
+	}
+}
+
+func Test_apiKeyRepo_GetByPrefix(t *testing.T) {
+	ctx := context.Background()
+	type args struct {
+		prefix string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantID  int
+		wantErr error
+	}{
+		{
+			name: "should get api key successfully",
+			args: args{
+				prefix: "abcd1234",
+			},
+			wantID:  1,
+			wantErr: nil,
+		},
+		{
+			name: "should get api key failed if not found",
+			args: args{
+				prefix: "notfound",
+			},
+			wantErr: biz.ErrResourceNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, teardown := NewTestData(t)
+			defer teardown()
+			u := newTestServiceAccount(ctx, t, data)
+			r := NewAPIKeyRepo(data)
+			r.Create(ctx, &repo.APIKey{UserID: u.ID, Name: "ci", Prefix: "abcd1234", Hash: "hash"})
+			got, err := r.GetByPrefix(ctx, tt.args.prefix)
+			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantID, got.ID, "mismatch: got=%v, want=%v", got.ID, tt.wantID)
+			}
+		})
+	}
+}
+
+func Test_apiKeyRepo_DeleteWithUser(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	u := newTestServiceAccount(ctx, t, data)
+	r := NewAPIKeyRepo(data)
+	k, err := r.Create(ctx, &repo.APIKey{UserID: u.ID, Name: "ci", Prefix: "abcd1234", Hash: "hash"})
+	assert.NoError(t, err)
+	assert.NoError(t, NewUserRepo(data).Delete(ctx, u.ID))
+	_, err = r.Get(ctx, k.ID)
+	assert.Equal(t, biz.ErrResourceNotFound, err, "api keys should be deleted with their service account")
+}
@@ -2,50 +2,82 @@ package data
 
 import (
 	"context"
+	stdsql "database/sql"
 
 	"usm/internal/biz/repo"
 	"usm/internal/conf"
 	"usm/internal/data/ent"
+	kprom "usm/pkg/kratos/contrib/metrics/prometheus"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 
 	_ "github.com/lib/pq"
 )
 
 type Data struct {
-	db *ent.Client
+	db  *ent.Client
+	drv *sql.Driver
 }
 
-func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
-	drv, err := sql.Open(c.Database.Driver, c.Database.Source)
+func NewData(c *conf.Data, reg prometheus.Registerer, logger log.Logger) (*Data, func(), error) {
+	querySeconds, err := kprom.RegisterHistogramVec(reg, dbQuerySecondsOpts, "operation", "success")
 	if err != nil {
 		return nil, nil, err
 	}
-	sqlDrv := dialect.DebugWithContext(drv, func(ctx context.Context, i ...interface{}) {
-		log.NewHelper(logger).Info(i...)
-	})
-	client := ent.NewClient(ent.Driver(sqlDrv))
+	conn, err := newConnector(c.Database, log.With(logger, "module", "data"))
 	if err != nil {
 		return nil, nil, err
 	}
+	drv := sql.OpenDB(c.Database.Driver, stdsql.OpenDB(conn))
+	var entDrv dialect.Driver = &tracingDriver{Driver: &metricsDriver{Driver: drv, seconds: querySeconds}}
+	if c.Database.Debug {
+		entDrv = newDebugDriver(entDrv, log.With(logger, "module", "data"))
+	}
+	client := ent.NewClient(ent.Driver(entDrv))
+	// 关闭连接池和密钥文件监听，迁移失败时也需要调用，避免连接泄漏
+	closeClient := func() {
+		if err := client.Close(); err != nil {
+			log.Error(err)
+		}
+		conn.Close()
+	}
 	// Run the auto migration tool.
 	if err := client.Schema.Create(context.Background()); err != nil {
+		// 唯一索引创建失败通常是已有数据冲突，给出具体的用户
+		if cerr := checkUserCollisions(context.Background(), client); cerr != nil {
+			err = cerr
+		}
 		log.Errorf("failed creating schema resources: %v", err)
+		closeClient()
 		return nil, nil, err
 	}
-	d := &Data{db: client}
+	if err := migrateUserKeys(context.Background(), client); err != nil {
+		log.Errorf("failed migrating users: %v", err)
+		closeClient()
+		return nil, nil, err
+	}
+	unregister, err := registerDBStats(reg, drv.DB(), c.Database.Driver, log.NewHelper(log.With(logger, "module", "data")))
+	if err != nil {
+		closeClient()
+		return nil, nil, err
+	}
+	d := &Data{db: client, drv: drv}
 	return d, func() {
 		log.Info("message", "closing the data resources")
-		if err := d.db.Close(); err != nil {
-			log.Error(err)
-		}
+		unregister()
+		closeClient()
 	}, nil
 }
 
+// WithTx 在事务中执行 fn，ctx 中已有事务时直接复用，由最外层提交或回滚
 func (d *Data) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(tranCtxKey{}).(*ent.Tx); ok {
+		return fn(ctx)
+	}
 	tx, err := d.db.Tx(ctx)
 	if err != nil {
 		return err
@@ -82,3 +114,18 @@ type tranCtxKey struct{}
 func NewTransaction(d *Data) repo.Transaction {
 	return d
 }
+
+func NewHealthChecker(d *Data) repo.HealthChecker {
+	return d
+}
+
+// CheckHealth 检查数据库连接，并通过查询用户表确认表结构已迁移
+func (d *Data) CheckHealth(ctx context.Context) error {
+	if err := d.drv.DB().PingContext(ctx); err != nil {
+		return errors.Wrap(err, "ping database")
+	}
+	if _, err := d.db.User.Query().Limit(1).IDs(ctx); err != nil {
+		return errors.Wrap(err, "query users")
+	}
+	return nil
+}
@@ -1,16 +1,63 @@
 package data
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
 	"usm/internal/data/ent/enttest"
 
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
 	_ "github.com/mattn/go-sqlite3"
 )
 
 func NewTestData(t *testing.T) (*Data, func()) {
 	db := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
-	return &Data{db}, func() {
+	return &Data{db: db}, func() {
 		db.Close()
 	}
 }
+
+func TestData_WithTxNested(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	r := NewUserRepo(data)
+	errAbort := errors.New("abort")
+	err := data.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := r.Create(ctx, &repo.User{Username: "alice"}); err != nil {
+			return err
+		}
+		// 内层复用外层事务，由外层回滚
+		if err := data.WithTx(ctx, func(ctx context.Context) error {
+			_, err := r.Create(ctx, &repo.User{Username: "bob"})
+			return err
+		}); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	assert.Equal(t, errAbort, err)
+	n, err := r.Count(ctx, nil)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestData_CheckHealth(t *testing.T) {
+	ctx := context.Background()
+	d, cleanup, err := NewData(&conf.Data{Database: &conf.Data_Database{
+		Driver: "sqlite3",
+		Source: "file:health?mode=memory&cache=shared&_fk=1",
+	}}, prometheus.NewRegistry(), log.DefaultLogger)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, d.CheckHealth(ctx))
+	cleanup()
+	assert.Error(t, d.CheckHealth(ctx))
+}
@@ -0,0 +1,162 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 列名包含这些字符串时，日志中隐藏绑定的参数
+var sensitiveColumns = []string{"password", "hash", "secret", "token", "private_key"}
+
+// 不作为列名的关键字，ent 生成的列名都带引号，这里只处理手写的语句
+var sqlKeywords = map[string]bool{
+	"and": true, "or": true, "not": true, "in": true, "is": true, "null": true, "like": true, "ilike": true,
+	"between": true, "where": true, "set": true, "values": true, "limit": true, "offset": true,
+	"lower": true, "upper": true, "any": true, "all": true, "as": true,
+}
+
+var (
+	insertPattern = regexp.MustCompile(`(?is)^\s*INSERT\s+INTO\s+\S+\s*\(([^)]*)\)\s*VALUES`)
+	// 标识符或占位符：`col`、"col"、col、$1、?
+	sqlTokenPattern = regexp.MustCompile("`([^`]+)`|\"([^\"]+)\"|([A-Za-z_][A-Za-z0-9_]*)|(\\$\\d+|\\?)")
+)
+
+// debugDriver 以 debug 级别记录 SQL 语句和参数，敏感列的参数被隐藏
+type debugDriver struct {
+	dialect.Driver
+	log *log.Helper
+}
+
+func newDebugDriver(drv dialect.Driver, logger log.Logger) *debugDriver {
+	return &debugDriver{Driver: drv, log: log.NewHelper(logger)}
+}
+
+func (d *debugDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	logQuery(ctx, d.log, "exec", query, args)
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *debugDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	logQuery(ctx, d.log, "query", query, args)
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *debugDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d.log.WithContext(ctx).Debugw("msg", "sql", "operation", "begin")
+	return &debugTx{Tx: tx, ctx: ctx, log: d.log}, nil
+}
+
+type debugTx struct {
+	dialect.Tx
+	ctx context.Context
+	log *log.Helper
+}
+
+func (tx *debugTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	logQuery(ctx, tx.log, "exec", query, args)
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+func (tx *debugTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	logQuery(ctx, tx.log, "query", query, args)
+	return tx.Tx.Query(ctx, query, args, v)
+}
+
+func (tx *debugTx) Commit() error {
+	tx.log.WithContext(tx.ctx).Debugw("msg", "sql", "operation", "commit")
+	return tx.Tx.Commit()
+}
+
+func (tx *debugTx) Rollback() error {
+	tx.log.WithContext(tx.ctx).Debugw("msg", "sql", "operation", "rollback")
+	return tx.Tx.Rollback()
+}
+
+func logQuery(ctx context.Context, l *log.Helper, operation, query string, args interface{}) {
+	l.WithContext(ctx).Debugw("msg", "sql", "operation", operation, "statement", query, "args", maskArgs(query, args))
+}
+
+// maskArgs 将参数格式化为字符串，对应敏感列的参数替换为 ***。
+// INSERT 按列的顺序对应参数，其他语句中参数对应其前面最近的列名
+func maskArgs(query string, args interface{}) []string {
+	list, ok := args.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil
+	}
+	columns := argColumns(query, len(list))
+	res := make([]string, len(list))
+	for i, a := range list {
+		if isSensitiveColumn(columns[i]) {
+			res[i] = "***"
+			continue
+		}
+		res[i] = fmt.Sprintf("%v", a)
+	}
+	return res
+}
+
+func argColumns(query string, n int) []string {
+	columns := make([]string, n)
+	if m := insertPattern.FindStringSubmatch(query); m != nil {
+		var cols []string
+		for _, c := range strings.Split(m[1], ",") {
+			cols = append(cols, strings.Trim(strings.TrimSpace(c), "`\""))
+		}
+		for i := range columns {
+			columns[i] = cols[i%len(cols)]
+		}
+		return columns
+	}
+	var last string
+	i := 0
+	for _, m := range sqlTokenPattern.FindAllStringSubmatch(query, -1) {
+		switch {
+		case m[4] != "":
+			// PostgreSQL 的 $n 直接对应第 n 个参数
+			j := i
+			if m[4] != "?" {
+				j, _ = strconv.Atoi(m[4][1:])
+				j--
+			}
+			if j >= 0 && j < n {
+				columns[j] = last
+			}
+			i++
+		case m[1] != "":
+			last = m[1]
+		case m[2] != "":
+			last = m[2]
+		case strings.EqualFold(m[3], "limit") || strings.EqualFold(m[3], "offset"):
+			last = ""
+		case !sqlKeywords[strings.ToLower(m[3])]:
+			last = m[3]
+		}
+	}
+	// 无法确定对应关系时全部按敏感处理
+	if i != n {
+		for j := range columns {
+			columns[j] = "password"
+		}
+	}
+	return columns
+}
+
+func isSensitiveColumn(column string) bool {
+	column = strings.ToLower(column)
+	for _, c := range sensitiveColumns {
+		if strings.Contains(column, c) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskArgs(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		args  []interface{}
+		want  []string
+	}{
+		{
+			name:  "should mask insert columns",
+			query: `INSERT INTO "users" ("username", "password", "email") VALUES ($1, $2, $3), ($4, $5, $6)`,
+			args:  []interface{}{"alice", "p1", "a@x.com", "bob", "p2", "b@x.com"},
+			want:  []string{"alice", "***", "a@x.com", "bob", "***", "b@x.com"},
+		},
+		{
+			name:  "should mask update columns",
+			query: "UPDATE `users` SET `password` = ?, `update_time` = ? WHERE `id` = ?",
+			args:  []interface{}{"p1", "2022-01-01", 1},
+			want:  []string{"***", "2022-01-01", "1"},
+		},
+		{
+			name:  "should mask predicates",
+			query: `SELECT "api_keys"."id" FROM "api_keys" WHERE "api_keys"."prefix" = $1 AND "api_keys"."hash" IN ($2, $3) LIMIT $4`,
+			args:  []interface{}{"ab12", "h1", "h2", 1},
+			want:  []string{"ab12", "***", "***", "1"},
+		},
+		{
+			name:  "should mask unquoted columns",
+			query: "UPDATE users SET password = ? WHERE id = ?",
+			args:  []interface{}{"p1", 1},
+			want:  []string{"***", "1"},
+		},
+		{
+			name:  "should mask all args if placeholders mismatch",
+			query: "SELECT 1 WHERE 1 = ?",
+			args:  []interface{}{"a", "b"},
+			want:  []string{"***", "***"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, maskArgs(tt.query, tt.args))
+		})
+	}
+}
+
+// recordLogger 以文本形式记录所有日志
+type recordLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *recordLogger) Log(level log.Level, keyvals ...interface{}) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprint(keyvals...))
+	return nil
+}
+
+func TestNewData_DebugSQL(t *testing.T) {
+	ctx := context.Background()
+	logger := &recordLogger{}
+	d, cleanup, err := NewData(&conf.Data{Database: &conf.Data_Database{
+		Driver: "sqlite3",
+		Source: "file:debug?mode=memory&cache=shared&_fk=1",
+		Debug:  true,
+	}}, prometheus.NewRegistry(), logger)
+	require.NoError(t, err)
+	defer cleanup()
+	const password = "Sup3r-Secret!"
+	r := NewUserRepo(d)
+	u, err := r.Create(ctx, &repo.User{Username: "alice", Password: password, Kind: repo.UserKindHuman})
+	require.NoError(t, err)
+	_, err = r.CreateBulk(ctx, []*repo.User{{Username: "bob", Password: password, Kind: repo.UserKindHuman}})
+	require.NoError(t, err)
+	require.NoError(t, d.WithTx(ctx, func(ctx context.Context) error {
+		return r.SetPassword(ctx, u.ID, password+"2")
+	}))
+
+	logs := strings.Join(logger.lines, "\n")
+	assert.Contains(t, logs, "INSERT INTO")
+	assert.Contains(t, logs, "alice")
+	assert.NotContains(t, logs, password)
+}
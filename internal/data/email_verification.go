@@ -0,0 +1,76 @@
+package data
+
+import (
+	"context"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/data/ent"
+	"usm/internal/data/ent/emailverification"
+)
+
+type emailVerificationRepo struct {
+	data *Data
+}
+
+func NewEmailVerificationRepo(data *Data) repo.EmailVerificationRepo {
+	return &emailVerificationRepo{
+		data: data,
+	}
+}
+
+func (r *emailVerificationRepo) Create(ctx context.Context, m *repo.EmailVerification) (*repo.EmailVerification, error) {
+	v, err := r.data.DB(ctx).EmailVerification.
+		Create().
+		SetUserID(int64(m.UserID)).
+		SetEmail(m.Email).
+		SetTokenHash(m.TokenHash).
+		SetExpireTime(m.ExpireTime).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.ErrResourceAlreadyExists
+		}
+		return nil, err
+	}
+	return r.verificationFromEntity(v), nil
+}
+
+func (r *emailVerificationRepo) Delete(ctx context.Context, id int) error {
+	err := r.data.DB(ctx).EmailVerification.DeleteOneID(int64(id)).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
+}
+
+func (r *emailVerificationRepo) GetByHash(ctx context.Context, hash string) (*repo.EmailVerification, error) {
+	v, err := r.data.DB(ctx).EmailVerification.Query().Where(
+		emailverification.TokenHash(hash),
+	).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.verificationFromEntity(v), nil
+}
+
+func (r *emailVerificationRepo) DeleteByUser(ctx context.Context, userID int) error {
+	_, err := r.data.DB(ctx).EmailVerification.Delete().Where(
+		emailverification.UserID(int64(userID)),
+	).Exec(ctx)
+	return err
+}
+
+func (r *emailVerificationRepo) verificationFromEntity(v *ent.EmailVerification) *repo.EmailVerification {
+	return &repo.EmailVerification{
+		ID:         int(v.ID),
+		UserID:     int(v.UserID),
+		Email:      v.Email,
+		TokenHash:  v.TokenHash,
+		ExpireTime: v.ExpireTime,
+		CreateTime: v.CreateTime,
+	}
+}
@@ -0,0 +1,60 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_emailVerificationRepo(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	r := NewEmailVerificationRepo(data)
+
+	v, err := r.Create(ctx, &repo.EmailVerification{
+		UserID:     1,
+		Email:      "alice@example.com",
+		TokenHash:  "hash-1",
+		ExpireTime: time.Now().Add(time.Hour),
+	})
+	assert.NoError(t, err)
+	_, err = r.Create(ctx, &repo.EmailVerification{UserID: 1, Email: "alice@example.com", TokenHash: "hash-1", ExpireTime: time.Now()})
+	assert.Equal(t, biz.ErrResourceAlreadyExists, err)
+
+	got, err := r.GetByHash(ctx, "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, v.ID, got.ID)
+	assert.Equal(t, "alice@example.com", got.Email)
+
+	assert.NoError(t, r.DeleteByUser(ctx, 1))
+	_, err = r.GetByHash(ctx, "hash-1")
+	assert.Equal(t, biz.ErrResourceNotFound, err)
+	assert.Equal(t, biz.ErrResourceNotFound, r.Delete(ctx, v.ID))
+}
+
+func Test_userRepo_UpdateResetsEmailVerified(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	r := NewUserRepo(data)
+
+	u, err := r.Create(ctx, &repo.User{Username: "alice", Email: "alice@example.com"})
+	assert.NoError(t, err)
+	assert.NoError(t, r.SetEmailVerified(ctx, u.ID, true))
+
+	u, err = r.Update(ctx, &repo.User{ID: u.ID, Email: "alice@example.com"})
+	assert.NoError(t, err)
+	assert.True(t, u.EmailVerified, "unchanged email must stay verified")
+
+	u, err = r.Update(ctx, &repo.User{ID: u.ID, Email: "alice@example.org"})
+	assert.NoError(t, err)
+	assert.False(t, u.EmailVerified)
+
+	assert.Equal(t, biz.ErrResourceNotFound, r.SetEmailVerified(ctx, 99, true))
+}
@@ -0,0 +1,575 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"usm/internal/data/ent/apikey"
+	"usm/internal/data/ent/predicate"
+	"usm/internal/data/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKeyUpdate is the builder for updating APIKey entities.
+type APIKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *APIKeyMutation
+}
+
+// Where appends a list predicates to the APIKeyUpdate builder.
+func (aku *APIKeyUpdate) Where(ps ...predicate.APIKey) *APIKeyUpdate {
+	aku.mutation.Where(ps...)
+	return aku
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (aku *APIKeyUpdate) SetUpdateTime(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetUpdateTime(t)
+	return aku
+}
+
+// SetUserID sets the "user_id" field.
+func (aku *APIKeyUpdate) SetUserID(i int64) *APIKeyUpdate {
+	aku.mutation.SetUserID(i)
+	return aku
+}
+
+// SetName sets the "name" field.
+func (aku *APIKeyUpdate) SetName(s string) *APIKeyUpdate {
+	aku.mutation.SetName(s)
+	return aku
+}
+
+// SetExpireTime sets the "expire_time" field.
+func (aku *APIKeyUpdate) SetExpireTime(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetExpireTime(t)
+	return aku
+}
+
+// SetNillableExpireTime sets the "expire_time" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableExpireTime(t *time.Time) *APIKeyUpdate {
+	if t != nil {
+		aku.SetExpireTime(*t)
+	}
+	return aku
+}
+
+// ClearExpireTime clears the value of the "expire_time" field.
+func (aku *APIKeyUpdate) ClearExpireTime() *APIKeyUpdate {
+	aku.mutation.ClearExpireTime()
+	return aku
+}
+
+// SetLastUsedTime sets the "last_used_time" field.
+func (aku *APIKeyUpdate) SetLastUsedTime(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetLastUsedTime(t)
+	return aku
+}
+
+// SetNillableLastUsedTime sets the "last_used_time" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableLastUsedTime(t *time.Time) *APIKeyUpdate {
+	if t != nil {
+		aku.SetLastUsedTime(*t)
+	}
+	return aku
+}
+
+// ClearLastUsedTime clears the value of the "last_used_time" field.
+func (aku *APIKeyUpdate) ClearLastUsedTime() *APIKeyUpdate {
+	aku.mutation.ClearLastUsedTime()
+	return aku
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (aku *APIKeyUpdate) SetUser(u *User) *APIKeyUpdate {
+	return aku.SetUserID(u.ID)
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (aku *APIKeyUpdate) Mutation() *APIKeyMutation {
+	return aku.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (aku *APIKeyUpdate) ClearUser() *APIKeyUpdate {
+	aku.mutation.ClearUser()
+	return aku
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aku *APIKeyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	aku.defaults()
+	if len(aku.hooks) == 0 {
+		if err = aku.check(); err != nil {
+			return 0, err
+		}
+		affected, err = aku.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*APIKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = aku.check(); err != nil {
+				return 0, err
+			}
+			aku.mutation = mutation
+			affected, err = aku.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(aku.hooks) - 1; i >= 0; i-- {
+			if aku.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aku.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aku.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aku *APIKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := aku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aku *APIKeyUpdate) Exec(ctx context.Context) error {
+	_, err := aku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aku *APIKeyUpdate) ExecX(ctx context.Context) {
+	if err := aku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aku *APIKeyUpdate) defaults() {
+	if _, ok := aku.mutation.UpdateTime(); !ok {
+		v := apikey.UpdateDefaultUpdateTime()
+		aku.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aku *APIKeyUpdate) check() error {
+	if _, ok := aku.mutation.UserID(); aku.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "APIKey.user"`)
+	}
+	return nil
+}
+
+func (aku *APIKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   apikey.Table,
+			Columns: apikey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: apikey.FieldID,
+			},
+		},
+	}
+	if ps := aku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aku.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldUpdateTime,
+		})
+	}
+	if value, ok := aku.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldName,
+		})
+	}
+	if value, ok := aku.mutation.ExpireTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldExpireTime,
+		})
+	}
+	if aku.mutation.ExpireTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apikey.FieldExpireTime,
+		})
+	}
+	if value, ok := aku.mutation.LastUsedTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldLastUsedTime,
+		})
+	}
+	if aku.mutation.LastUsedTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apikey.FieldLastUsedTime,
+		})
+	}
+	if aku.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apikey.UserTable,
+			Columns: []string{apikey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aku.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apikey.UserTable,
+			Columns: []string{apikey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// APIKeyUpdateOne is the builder for updating a single APIKey entity.
+type APIKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *APIKeyMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (akuo *APIKeyUpdateOne) SetUpdateTime(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetUpdateTime(t)
+	return akuo
+}
+
+// SetUserID sets the "user_id" field.
+func (akuo *APIKeyUpdateOne) SetUserID(i int64) *APIKeyUpdateOne {
+	akuo.mutation.SetUserID(i)
+	return akuo
+}
+
+// SetName sets the "name" field.
+func (akuo *APIKeyUpdateOne) SetName(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetName(s)
+	return akuo
+}
+
+// SetExpireTime sets the "expire_time" field.
+func (akuo *APIKeyUpdateOne) SetExpireTime(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetExpireTime(t)
+	return akuo
+}
+
+// SetNillableExpireTime sets the "expire_time" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableExpireTime(t *time.Time) *APIKeyUpdateOne {
+	if t != nil {
+		akuo.SetExpireTime(*t)
+	}
+	return akuo
+}
+
+// ClearExpireTime clears the value of the "expire_time" field.
+func (akuo *APIKeyUpdateOne) ClearExpireTime() *APIKeyUpdateOne {
+	akuo.mutation.ClearExpireTime()
+	return akuo
+}
+
+// SetLastUsedTime sets the "last_used_time" field.
+func (akuo *APIKeyUpdateOne) SetLastUsedTime(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetLastUsedTime(t)
+	return akuo
+}
+
+// SetNillableLastUsedTime sets the "last_used_time" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableLastUsedTime(t *time.Time) *APIKeyUpdateOne {
+	if t != nil {
+		akuo.SetLastUsedTime(*t)
+	}
+	return akuo
+}
+
+// ClearLastUsedTime clears the value of the "last_used_time" field.
+func (akuo *APIKeyUpdateOne) ClearLastUsedTime() *APIKeyUpdateOne {
+	akuo.mutation.ClearLastUsedTime()
+	return akuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (akuo *APIKeyUpdateOne) SetUser(u *User) *APIKeyUpdateOne {
+	return akuo.SetUserID(u.ID)
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (akuo *APIKeyUpdateOne) Mutation() *APIKeyMutation {
+	return akuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (akuo *APIKeyUpdateOne) ClearUser() *APIKeyUpdateOne {
+	akuo.mutation.ClearUser()
+	return akuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (akuo *APIKeyUpdateOne) Select(field string, fields ...string) *APIKeyUpdateOne {
+	akuo.fields = append([]string{field}, fields...)
+	return akuo
+}
+
+// Save executes the query and returns the updated APIKey entity.
+func (akuo *APIKeyUpdateOne) Save(ctx context.Context) (*APIKey, error) {
+	var (
+		err  error
+		node *APIKey
+	)
+	akuo.defaults()
+	if len(akuo.hooks) == 0 {
+		if err = akuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = akuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*APIKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = akuo.check(); err != nil {
+				return nil, err
+			}
+			akuo.mutation = mutation
+			node, err = akuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(akuo.hooks) - 1; i >= 0; i-- {
+			if akuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = akuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, akuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (akuo *APIKeyUpdateOne) SaveX(ctx context.Context) *APIKey {
+	node, err := akuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (akuo *APIKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := akuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akuo *APIKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := akuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (akuo *APIKeyUpdateOne) defaults() {
+	if _, ok := akuo.mutation.UpdateTime(); !ok {
+		v := apikey.UpdateDefaultUpdateTime()
+		akuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (akuo *APIKeyUpdateOne) check() error {
+	if _, ok := akuo.mutation.UserID(); akuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "APIKey.user"`)
+	}
+	return nil
+}
+
+func (akuo *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   apikey.Table,
+			Columns: apikey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: apikey.FieldID,
+			},
+		},
+	}
+	id, ok := akuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "APIKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := akuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apikey.FieldID)
+		for _, f := range fields {
+			if !apikey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apikey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := akuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := akuo.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldUpdateTime,
+		})
+	}
+	if value, ok := akuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldName,
+		})
+	}
+	if value, ok := akuo.mutation.ExpireTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldExpireTime,
+		})
+	}
+	if akuo.mutation.ExpireTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apikey.FieldExpireTime,
+		})
+	}
+	if value, ok := akuo.mutation.LastUsedTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldLastUsedTime,
+		})
+	}
+	if akuo.mutation.LastUsedTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apikey.FieldLastUsedTime,
+		})
+	}
+	if akuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apikey.UserTable,
+			Columns: []string{apikey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := akuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apikey.UserTable,
+			Columns: []string{apikey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &APIKey{config: akuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, akuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -24,7 +24,16 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	User []ent.Hook
+	APIKey            []ent.Hook
+	EmailVerification []ent.Hook
+	Group             []ent.Hook
+	Invitation        []ent.Hook
+	OAuthClient       []ent.Hook
+	OAuthCode         []ent.Hook
+	OAuthToken        []ent.Hook
+	PasswordReset     []ent.Hook
+	SigningKey        []ent.Hook
+	User              []ent.Hook
 }
 
 // Options applies the options on the config object.
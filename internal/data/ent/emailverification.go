@@ -0,0 +1,141 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"usm/internal/data/ent/emailverification"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// EmailVerification is the model entity for the EmailVerification schema.
+type EmailVerification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// ExpireTime holds the value of the "expire_time" field.
+	ExpireTime time.Time `json:"expire_time,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailVerification) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailverification.FieldID, emailverification.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case emailverification.FieldEmail, emailverification.FieldTokenHash:
+			values[i] = new(sql.NullString)
+		case emailverification.FieldCreateTime, emailverification.FieldExpireTime:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type EmailVerification", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailVerification fields.
+func (ev *EmailVerification) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailverification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ev.ID = int64(value.Int64)
+		case emailverification.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ev.CreateTime = value.Time
+			}
+		case emailverification.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ev.UserID = value.Int64
+			}
+		case emailverification.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				ev.Email = value.String
+			}
+		case emailverification.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				ev.TokenHash = value.String
+			}
+		case emailverification.FieldExpireTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expire_time", values[i])
+			} else if value.Valid {
+				ev.ExpireTime = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this EmailVerification.
+// Note that you need to call EmailVerification.Unwrap() before calling this method if this EmailVerification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ev *EmailVerification) Update() *EmailVerificationUpdateOne {
+	return (&EmailVerificationClient{config: ev.config}).UpdateOne(ev)
+}
+
+// Unwrap unwraps the EmailVerification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ev *EmailVerification) Unwrap() *EmailVerification {
+	tx, ok := ev.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailVerification is not a transactional entity")
+	}
+	ev.config.driver = tx.drv
+	return ev
+}
+
+// String implements the fmt.Stringer.
+func (ev *EmailVerification) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailVerification(")
+	builder.WriteString(fmt.Sprintf("id=%v", ev.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(ev.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ev.UserID))
+	builder.WriteString(", email=")
+	builder.WriteString(ev.Email)
+	builder.WriteString(", token_hash=<sensitive>")
+	builder.WriteString(", expire_time=")
+	builder.WriteString(ev.ExpireTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailVerifications is a parsable slice of EmailVerification.
+type EmailVerifications []*EmailVerification
+
+func (ev EmailVerifications) config(cfg config) {
+	for _i := range ev {
+		ev[_i].config = cfg
+	}
+}
@@ -0,0 +1,319 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"usm/internal/data/ent/emailverification"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailVerificationCreate is the builder for creating a EmailVerification entity.
+type EmailVerificationCreate struct {
+	config
+	mutation *EmailVerificationMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (evc *EmailVerificationCreate) SetCreateTime(t time.Time) *EmailVerificationCreate {
+	evc.mutation.SetCreateTime(t)
+	return evc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (evc *EmailVerificationCreate) SetNillableCreateTime(t *time.Time) *EmailVerificationCreate {
+	if t != nil {
+		evc.SetCreateTime(*t)
+	}
+	return evc
+}
+
+// SetUserID sets the "user_id" field.
+func (evc *EmailVerificationCreate) SetUserID(i int64) *EmailVerificationCreate {
+	evc.mutation.SetUserID(i)
+	return evc
+}
+
+// SetEmail sets the "email" field.
+func (evc *EmailVerificationCreate) SetEmail(s string) *EmailVerificationCreate {
+	evc.mutation.SetEmail(s)
+	return evc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (evc *EmailVerificationCreate) SetTokenHash(s string) *EmailVerificationCreate {
+	evc.mutation.SetTokenHash(s)
+	return evc
+}
+
+// SetExpireTime sets the "expire_time" field.
+func (evc *EmailVerificationCreate) SetExpireTime(t time.Time) *EmailVerificationCreate {
+	evc.mutation.SetExpireTime(t)
+	return evc
+}
+
+// SetID sets the "id" field.
+func (evc *EmailVerificationCreate) SetID(i int64) *EmailVerificationCreate {
+	evc.mutation.SetID(i)
+	return evc
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (evc *EmailVerificationCreate) Mutation() *EmailVerificationMutation {
+	return evc.mutation
+}
+
+// Save creates the EmailVerification in the database.
+func (evc *EmailVerificationCreate) Save(ctx context.Context) (*EmailVerification, error) {
+	var (
+		err  error
+		node *EmailVerification
+	)
+	evc.defaults()
+	if len(evc.hooks) == 0 {
+		if err = evc.check(); err != nil {
+			return nil, err
+		}
+		node, err = evc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EmailVerificationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = evc.check(); err != nil {
+				return nil, err
+			}
+			evc.mutation = mutation
+			if node, err = evc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(evc.hooks) - 1; i >= 0; i-- {
+			if evc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = evc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, evc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (evc *EmailVerificationCreate) SaveX(ctx context.Context) *EmailVerification {
+	v, err := evc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (evc *EmailVerificationCreate) Exec(ctx context.Context) error {
+	_, err := evc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evc *EmailVerificationCreate) ExecX(ctx context.Context) {
+	if err := evc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (evc *EmailVerificationCreate) defaults() {
+	if _, ok := evc.mutation.CreateTime(); !ok {
+		v := emailverification.DefaultCreateTime()
+		evc.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (evc *EmailVerificationCreate) check() error {
+	if _, ok := evc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "EmailVerification.create_time"`)}
+	}
+	if _, ok := evc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EmailVerification.user_id"`)}
+	}
+	if _, ok := evc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "EmailVerification.email"`)}
+	}
+	if _, ok := evc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "EmailVerification.token_hash"`)}
+	}
+	if _, ok := evc.mutation.ExpireTime(); !ok {
+		return &ValidationError{Name: "expire_time", err: errors.New(`ent: missing required field "EmailVerification.expire_time"`)}
+	}
+	return nil
+}
+
+func (evc *EmailVerificationCreate) sqlSave(ctx context.Context) (*EmailVerification, error) {
+	_node, _spec := evc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, evc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (evc *EmailVerificationCreate) createSpec() (*EmailVerification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailVerification{config: evc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: emailverification.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: emailverification.FieldID,
+			},
+		}
+	)
+	if id, ok := evc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := evc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: emailverification.FieldCreateTime,
+		})
+		_node.CreateTime = value
+	}
+	if value, ok := evc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: emailverification.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := evc.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: emailverification.FieldEmail,
+		})
+		_node.Email = value
+	}
+	if value, ok := evc.mutation.TokenHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: emailverification.FieldTokenHash,
+		})
+		_node.TokenHash = value
+	}
+	if value, ok := evc.mutation.ExpireTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: emailverification.FieldExpireTime,
+		})
+		_node.ExpireTime = value
+	}
+	return _node, _spec
+}
+
+// EmailVerificationCreateBulk is the builder for creating many EmailVerification entities in bulk.
+type EmailVerificationCreateBulk struct {
+	config
+	builders []*EmailVerificationCreate
+}
+
+// Save creates the EmailVerification entities in the database.
+func (evcb *EmailVerificationCreateBulk) Save(ctx context.Context) ([]*EmailVerification, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(evcb.builders))
+	nodes := make([]*EmailVerification, len(evcb.builders))
+	mutators := make([]Mutator, len(evcb.builders))
+	for i := range evcb.builders {
+		func(i int, root context.Context) {
+			builder := evcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailVerificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, evcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, evcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, evcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evcb *EmailVerificationCreateBulk) SaveX(ctx context.Context) []*EmailVerification {
+	v, err := evcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (evcb *EmailVerificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := evcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evcb *EmailVerificationCreateBulk) ExecX(ctx context.Context) {
+	if err := evcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"usm/internal/data/ent/emailverification"
+	"usm/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailVerificationQuery is the builder for querying EmailVerification entities.
+type EmailVerificationQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.EmailVerification
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EmailVerificationQuery builder.
+func (evq *EmailVerificationQuery) Where(ps ...predicate.EmailVerification) *EmailVerificationQuery {
+	evq.predicates = append(evq.predicates, ps...)
+	return evq
+}
+
+// Limit adds a limit step to the query.
+func (evq *EmailVerificationQuery) Limit(limit int) *EmailVerificationQuery {
+	evq.limit = &limit
+	return evq
+}
+
+// Offset adds an offset step to the query.
+func (evq *EmailVerificationQuery) Offset(offset int) *EmailVerificationQuery {
+	evq.offset = &offset
+	return evq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (evq *EmailVerificationQuery) Unique(unique bool) *EmailVerificationQuery {
+	evq.unique = &unique
+	return evq
+}
+
+// Order adds an order step to the query.
+func (evq *EmailVerificationQuery) Order(o ...OrderFunc) *EmailVerificationQuery {
+	evq.order = append(evq.order, o...)
+	return evq
+}
+
+// First returns the first EmailVerification entity from the query.
+// Returns a *NotFoundError when no EmailVerification was found.
+func (evq *EmailVerificationQuery) First(ctx context.Context) (*EmailVerification, error) {
+	nodes, err := evq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{emailverification.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (evq *EmailVerificationQuery) FirstX(ctx context.Context) *EmailVerification {
+	node, err := evq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EmailVerification ID from the query.
+// Returns a *NotFoundError when no EmailVerification ID was found.
+func (evq *EmailVerificationQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = evq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{emailverification.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (evq *EmailVerificationQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := evq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EmailVerification entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EmailVerification entity is found.
+// Returns a *NotFoundError when no EmailVerification entities are found.
+func (evq *EmailVerificationQuery) Only(ctx context.Context) (*EmailVerification, error) {
+	nodes, err := evq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{emailverification.Label}
+	default:
+		return nil, &NotSingularError{emailverification.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (evq *EmailVerificationQuery) OnlyX(ctx context.Context) *EmailVerification {
+	node, err := evq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EmailVerification ID in the query.
+// Returns a *NotSingularError when more than one EmailVerification ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (evq *EmailVerificationQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = evq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{emailverification.Label}
+	default:
+		err = &NotSingularError{emailverification.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (evq *EmailVerificationQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := evq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EmailVerifications.
+func (evq *EmailVerificationQuery) All(ctx context.Context) ([]*EmailVerification, error) {
+	if err := evq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return evq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (evq *EmailVerificationQuery) AllX(ctx context.Context) []*EmailVerification {
+	nodes, err := evq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EmailVerification IDs.
+func (evq *EmailVerificationQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := evq.Select(emailverification.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (evq *EmailVerificationQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := evq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (evq *EmailVerificationQuery) Count(ctx context.Context) (int, error) {
+	if err := evq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return evq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (evq *EmailVerificationQuery) CountX(ctx context.Context) int {
+	count, err := evq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (evq *EmailVerificationQuery) Exist(ctx context.Context) (bool, error) {
+	if err := evq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return evq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (evq *EmailVerificationQuery) ExistX(ctx context.Context) bool {
+	exist, err := evq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EmailVerificationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (evq *EmailVerificationQuery) Clone() *EmailVerificationQuery {
+	if evq == nil {
+		return nil
+	}
+	return &EmailVerificationQuery{
+		config:     evq.config,
+		limit:      evq.limit,
+		offset:     evq.offset,
+		order:      append([]OrderFunc{}, evq.order...),
+		predicates: append([]predicate.EmailVerification{}, evq.predicates...),
+		// clone intermediate query.
+		sql:    evq.sql.Clone(),
+		path:   evq.path,
+		unique: evq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EmailVerification.Query().
+//		GroupBy(emailverification.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (evq *EmailVerificationQuery) GroupBy(field string, fields ...string) *EmailVerificationGroupBy {
+	group := &EmailVerificationGroupBy{config: evq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := evq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return evq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.EmailVerification.Query().
+//		Select(emailverification.FieldCreateTime).
+//		Scan(ctx, &v)
+//
+func (evq *EmailVerificationQuery) Select(fields ...string) *EmailVerificationSelect {
+	evq.fields = append(evq.fields, fields...)
+	return &EmailVerificationSelect{EmailVerificationQuery: evq}
+}
+
+func (evq *EmailVerificationQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range evq.fields {
+		if !emailverification.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if evq.path != nil {
+		prev, err := evq.path(ctx)
+		if err != nil {
+			return err
+		}
+		evq.sql = prev
+	}
+	return nil
+}
+
+func (evq *EmailVerificationQuery) sqlAll(ctx context.Context) ([]*EmailVerification, error) {
+	var (
+		nodes = []*EmailVerification{}
+		_spec = evq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &EmailVerification{config: evq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, evq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (evq *EmailVerificationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := evq.querySpec()
+	_spec.Node.Columns = evq.fields
+	if len(evq.fields) > 0 {
+		_spec.Unique = evq.unique != nil && *evq.unique
+	}
+	return sqlgraph.CountNodes(ctx, evq.driver, _spec)
+}
+
+func (evq *EmailVerificationQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := evq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (evq *EmailVerificationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   emailverification.Table,
+			Columns: emailverification.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: emailverification.FieldID,
+			},
+		},
+		From:   evq.sql,
+		Unique: true,
+	}
+	if unique := evq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := evq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailverification.FieldID)
+		for i := range fields {
+			if fields[i] != emailverification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := evq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := evq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := evq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := evq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (evq *EmailVerificationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(evq.driver.Dialect())
+	t1 := builder.Table(emailverification.Table)
+	columns := evq.fields
+	if len(columns) == 0 {
+		columns = emailverification.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if evq.sql != nil {
+		selector = evq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if evq.unique != nil && *evq.unique {
+		selector.Distinct()
+	}
+	for _, p := range evq.predicates {
+		p(selector)
+	}
+	for _, p := range evq.order {
+		p(selector)
+	}
+	if offset := evq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := evq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EmailVerificationGroupBy is the group-by builder for EmailVerification entities.
+type EmailVerificationGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (evgb *EmailVerificationGroupBy) Aggregate(fns ...AggregateFunc) *EmailVerificationGroupBy {
+	evgb.fns = append(evgb.fns, fns...)
+	return evgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (evgb *EmailVerificationGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := evgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	evgb.sql = query
+	return evgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (evgb *EmailVerificationGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := evgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (evgb *EmailVerificationGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(evgb.fields) > 1 {
+		return nil, errors.New("ent: EmailVerificationGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := evgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (evgb *EmailVerificationGroupBy) StringsX(ctx context.Context) []string {
+	v, err := evgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (evgb *EmailVerificationGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = evgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{emailverification.Label}
+	default:
+		err = fmt.Errorf("ent: EmailVerificationGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (evgb *EmailVerificationGroupBy) StringX(ctx context.Context) string {
+	v, err := evgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (evgb *EmailVerificationGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(evgb.fields) > 1 {
+		return nil, errors.New("ent: EmailVerificationGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := evgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (evgb *EmailVerificationGroupBy) IntsX(ctx context.Context) []int {
+	v, err := evgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (evgb *EmailVerificationGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = evgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{emailverification.Label}
+	default:
+		err = fmt.Errorf("ent: EmailVerificationGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (evgb *EmailVerificationGroupBy) IntX(ctx context.Context) int {
+	v, err := evgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (evgb *EmailVerificationGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(evgb.fields) > 1 {
+		return nil, errors.New("ent: EmailVerificationGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := evgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (evgb *EmailVerificationGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := evgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (evgb *EmailVerificationGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = evgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{emailverification.Label}
+	default:
+		err = fmt.Errorf("ent: EmailVerificationGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (evgb *EmailVerificationGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := evgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (evgb *EmailVerificationGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(evgb.fields) > 1 {
+		return nil, errors.New("ent: EmailVerificationGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := evgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (evgb *EmailVerificationGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := evgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (evgb *EmailVerificationGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = evgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{emailverification.Label}
+	default:
+		err = fmt.Errorf("ent: EmailVerificationGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (evgb *EmailVerificationGroupBy) BoolX(ctx context.Context) bool {
+	v, err := evgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (evgb *EmailVerificationGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range evgb.fields {
+		if !emailverification.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := evgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := evgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (evgb *EmailVerificationGroupBy) sqlQuery() *sql.Selector {
+	selector := evgb.sql.Select()
+	aggregation := make([]string, 0, len(evgb.fns))
+	for _, fn := range evgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(evgb.fields)+len(evgb.fns))
+		for _, f := range evgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(evgb.fields...)...)
+}
+
+// EmailVerificationSelect is the builder for selecting fields of EmailVerification entities.
+type EmailVerificationSelect struct {
+	*EmailVerificationQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (evs *EmailVerificationSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := evs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	evs.sql = evs.EmailVerificationQuery.sqlQuery(ctx)
+	return evs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (evs *EmailVerificationSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := evs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (evs *EmailVerificationSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(evs.fields) > 1 {
+		return nil, errors.New("ent: EmailVerificationSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := evs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (evs *EmailVerificationSelect) StringsX(ctx context.Context) []string {
+	v, err := evs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (evs *EmailVerificationSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = evs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{emailverification.Label}
+	default:
+		err = fmt.Errorf("ent: EmailVerificationSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (evs *EmailVerificationSelect) StringX(ctx context.Context) string {
+	v, err := evs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (evs *EmailVerificationSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(evs.fields) > 1 {
+		return nil, errors.New("ent: EmailVerificationSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := evs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (evs *EmailVerificationSelect) IntsX(ctx context.Context) []int {
+	v, err := evs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (evs *EmailVerificationSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = evs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{emailverification.Label}
+	default:
+		err = fmt.Errorf("ent: EmailVerificationSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (evs *EmailVerificationSelect) IntX(ctx context.Context) int {
+	v, err := evs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (evs *EmailVerificationSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(evs.fields) > 1 {
+		return nil, errors.New("ent: EmailVerificationSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := evs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (evs *EmailVerificationSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := evs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (evs *EmailVerificationSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = evs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{emailverification.Label}
+	default:
+		err = fmt.Errorf("ent: EmailVerificationSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (evs *EmailVerificationSelect) Float64X(ctx context.Context) float64 {
+	v, err := evs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (evs *EmailVerificationSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(evs.fields) > 1 {
+		return nil, errors.New("ent: EmailVerificationSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := evs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (evs *EmailVerificationSelect) BoolsX(ctx context.Context) []bool {
+	v, err := evs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (evs *EmailVerificationSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = evs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{emailverification.Label}
+	default:
+		err = fmt.Errorf("ent: EmailVerificationSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (evs *EmailVerificationSelect) BoolX(ctx context.Context) bool {
+	v, err := evs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (evs *EmailVerificationSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := evs.sql.Query()
+	if err := evs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,239 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"usm/internal/data/ent/emailverification"
+	"usm/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailVerificationUpdate is the builder for updating EmailVerification entities.
+type EmailVerificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailVerificationMutation
+}
+
+// Where appends a list predicates to the EmailVerificationUpdate builder.
+func (evu *EmailVerificationUpdate) Where(ps ...predicate.EmailVerification) *EmailVerificationUpdate {
+	evu.mutation.Where(ps...)
+	return evu
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (evu *EmailVerificationUpdate) Mutation() *EmailVerificationMutation {
+	return evu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (evu *EmailVerificationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(evu.hooks) == 0 {
+		affected, err = evu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EmailVerificationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			evu.mutation = mutation
+			affected, err = evu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(evu.hooks) - 1; i >= 0; i-- {
+			if evu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = evu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, evu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evu *EmailVerificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := evu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (evu *EmailVerificationUpdate) Exec(ctx context.Context) error {
+	_, err := evu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evu *EmailVerificationUpdate) ExecX(ctx context.Context) {
+	if err := evu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (evu *EmailVerificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   emailverification.Table,
+			Columns: emailverification.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: emailverification.FieldID,
+			},
+		},
+	}
+	if ps := evu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, evu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// EmailVerificationUpdateOne is the builder for updating a single EmailVerification entity.
+type EmailVerificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailVerificationMutation
+}
+
+// Mutation returns the EmailVerificationMutation object of the builder.
+func (evuo *EmailVerificationUpdateOne) Mutation() *EmailVerificationMutation {
+	return evuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (evuo *EmailVerificationUpdateOne) Select(field string, fields ...string) *EmailVerificationUpdateOne {
+	evuo.fields = append([]string{field}, fields...)
+	return evuo
+}
+
+// Save executes the query and returns the updated EmailVerification entity.
+func (evuo *EmailVerificationUpdateOne) Save(ctx context.Context) (*EmailVerification, error) {
+	var (
+		err  error
+		node *EmailVerification
+	)
+	if len(evuo.hooks) == 0 {
+		node, err = evuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EmailVerificationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			evuo.mutation = mutation
+			node, err = evuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(evuo.hooks) - 1; i >= 0; i-- {
+			if evuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = evuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, evuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evuo *EmailVerificationUpdateOne) SaveX(ctx context.Context) *EmailVerification {
+	node, err := evuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (evuo *EmailVerificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := evuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evuo *EmailVerificationUpdateOne) ExecX(ctx context.Context) {
+	if err := evuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (evuo *EmailVerificationUpdateOne) sqlSave(ctx context.Context) (_node *EmailVerification, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   emailverification.Table,
+			Columns: emailverification.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: emailverification.FieldID,
+			},
+		},
+	}
+	id, ok := evuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailVerification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := evuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailverification.FieldID)
+		for _, f := range fields {
+			if !emailverification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailverification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := evuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &EmailVerification{config: evuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, evuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
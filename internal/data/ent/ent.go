@@ -5,6 +5,15 @@ package ent
 import (
 	"errors"
 	"fmt"
+	"usm/internal/data/ent/apikey"
+	"usm/internal/data/ent/emailverification"
+	"usm/internal/data/ent/group"
+	"usm/internal/data/ent/invitation"
+	"usm/internal/data/ent/oauthclient"
+	"usm/internal/data/ent/oauthcode"
+	"usm/internal/data/ent/oauthtoken"
+	"usm/internal/data/ent/passwordreset"
+	"usm/internal/data/ent/signingkey"
 	"usm/internal/data/ent/user"
 
 	"entgo.io/ent"
@@ -29,7 +38,16 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		user.Table: user.ValidColumn,
+		apikey.Table:            apikey.ValidColumn,
+		emailverification.Table: emailverification.ValidColumn,
+		group.Table:             group.ValidColumn,
+		invitation.Table:        invitation.ValidColumn,
+		oauthclient.Table:       oauthclient.ValidColumn,
+		oauthcode.Table:         oauthcode.ValidColumn,
+		oauthtoken.Table:        oauthtoken.ValidColumn,
+		passwordreset.Table:     passwordreset.ValidColumn,
+		signingkey.Table:        signingkey.ValidColumn,
+		user.Table:              user.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
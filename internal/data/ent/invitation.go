@@ -0,0 +1,186 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"usm/internal/data/ent/invitation"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Invitation is the model entity for the Invitation schema.
+type Invitation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// GroupIds holds the value of the "group_ids" field.
+	GroupIds []int `json:"group_ids,omitempty"`
+	// Status holds the value of the "status" field.
+	Status invitation.Status `json:"status,omitempty"`
+	// ExpireTime holds the value of the "expire_time" field.
+	ExpireTime time.Time `json:"expire_time,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// AcceptTime holds the value of the "accept_time" field.
+	AcceptTime time.Time `json:"accept_time,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invitation) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invitation.FieldGroupIds:
+			values[i] = new([]byte)
+		case invitation.FieldID, invitation.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case invitation.FieldEmail, invitation.FieldTokenHash, invitation.FieldStatus:
+			values[i] = new(sql.NullString)
+		case invitation.FieldCreateTime, invitation.FieldUpdateTime, invitation.FieldExpireTime, invitation.FieldAcceptTime:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Invitation", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invitation fields.
+func (i *Invitation) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case invitation.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int64(value.Int64)
+		case invitation.FieldCreateTime:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[j])
+			} else if value.Valid {
+				i.CreateTime = value.Time
+			}
+		case invitation.FieldUpdateTime:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[j])
+			} else if value.Valid {
+				i.UpdateTime = value.Time
+			}
+		case invitation.FieldEmail:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[j])
+			} else if value.Valid {
+				i.Email = value.String
+			}
+		case invitation.FieldTokenHash:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[j])
+			} else if value.Valid {
+				i.TokenHash = value.String
+			}
+		case invitation.FieldGroupIds:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field group_ids", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.GroupIds); err != nil {
+					return fmt.Errorf("unmarshal field group_ids: %w", err)
+				}
+			}
+		case invitation.FieldStatus:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[j])
+			} else if value.Valid {
+				i.Status = invitation.Status(value.String)
+			}
+		case invitation.FieldExpireTime:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expire_time", values[j])
+			} else if value.Valid {
+				i.ExpireTime = value.Time
+			}
+		case invitation.FieldUserID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[j])
+			} else if value.Valid {
+				i.UserID = value.Int64
+			}
+		case invitation.FieldAcceptTime:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accept_time", values[j])
+			} else if value.Valid {
+				i.AcceptTime = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Invitation.
+// Note that you need to call Invitation.Unwrap() before calling this method if this Invitation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invitation) Update() *InvitationUpdateOne {
+	return (&InvitationClient{config: i.config}).UpdateOne(i)
+}
+
+// Unwrap unwraps the Invitation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Invitation) Unwrap() *Invitation {
+	tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invitation is not a transactional entity")
+	}
+	i.config.driver = tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Invitation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invitation(")
+	builder.WriteString(fmt.Sprintf("id=%v", i.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(i.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", update_time=")
+	builder.WriteString(i.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", email=")
+	builder.WriteString(i.Email)
+	builder.WriteString(", token_hash=<sensitive>")
+	builder.WriteString(", group_ids=")
+	builder.WriteString(fmt.Sprintf("%v", i.GroupIds))
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", i.Status))
+	builder.WriteString(", expire_time=")
+	builder.WriteString(i.ExpireTime.Format(time.ANSIC))
+	builder.WriteString(", user_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.UserID))
+	builder.WriteString(", accept_time=")
+	builder.WriteString(i.AcceptTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invitations is a parsable slice of Invitation.
+type Invitations []*Invitation
+
+func (i Invitations) config(cfg config) {
+	for _i := range i {
+		i[_i].config = cfg
+	}
+}
@@ -0,0 +1,429 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"usm/internal/data/ent/oauthclient"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthClientCreate is the builder for creating a OAuthClient entity.
+type OAuthClientCreate struct {
+	config
+	mutation *OAuthClientMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (occ *OAuthClientCreate) SetCreateTime(t time.Time) *OAuthClientCreate {
+	occ.mutation.SetCreateTime(t)
+	return occ
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (occ *OAuthClientCreate) SetNillableCreateTime(t *time.Time) *OAuthClientCreate {
+	if t != nil {
+		occ.SetCreateTime(*t)
+	}
+	return occ
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (occ *OAuthClientCreate) SetUpdateTime(t time.Time) *OAuthClientCreate {
+	occ.mutation.SetUpdateTime(t)
+	return occ
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (occ *OAuthClientCreate) SetNillableUpdateTime(t *time.Time) *OAuthClientCreate {
+	if t != nil {
+		occ.SetUpdateTime(*t)
+	}
+	return occ
+}
+
+// SetClientID sets the "client_id" field.
+func (occ *OAuthClientCreate) SetClientID(s string) *OAuthClientCreate {
+	occ.mutation.SetClientID(s)
+	return occ
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (occ *OAuthClientCreate) SetSecretHash(s string) *OAuthClientCreate {
+	occ.mutation.SetSecretHash(s)
+	return occ
+}
+
+// SetNillableSecretHash sets the "secret_hash" field if the given value is not nil.
+func (occ *OAuthClientCreate) SetNillableSecretHash(s *string) *OAuthClientCreate {
+	if s != nil {
+		occ.SetSecretHash(*s)
+	}
+	return occ
+}
+
+// SetName sets the "name" field.
+func (occ *OAuthClientCreate) SetName(s string) *OAuthClientCreate {
+	occ.mutation.SetName(s)
+	return occ
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (occ *OAuthClientCreate) SetRedirectUris(s []string) *OAuthClientCreate {
+	occ.mutation.SetRedirectUris(s)
+	return occ
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (occ *OAuthClientCreate) SetGrantTypes(s []string) *OAuthClientCreate {
+	occ.mutation.SetGrantTypes(s)
+	return occ
+}
+
+// SetScopes sets the "scopes" field.
+func (occ *OAuthClientCreate) SetScopes(s []string) *OAuthClientCreate {
+	occ.mutation.SetScopes(s)
+	return occ
+}
+
+// SetPublic sets the "public" field.
+func (occ *OAuthClientCreate) SetPublic(b bool) *OAuthClientCreate {
+	occ.mutation.SetPublic(b)
+	return occ
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (occ *OAuthClientCreate) SetNillablePublic(b *bool) *OAuthClientCreate {
+	if b != nil {
+		occ.SetPublic(*b)
+	}
+	return occ
+}
+
+// SetServiceAccountID sets the "service_account_id" field.
+func (occ *OAuthClientCreate) SetServiceAccountID(i int64) *OAuthClientCreate {
+	occ.mutation.SetServiceAccountID(i)
+	return occ
+}
+
+// SetNillableServiceAccountID sets the "service_account_id" field if the given value is not nil.
+func (occ *OAuthClientCreate) SetNillableServiceAccountID(i *int64) *OAuthClientCreate {
+	if i != nil {
+		occ.SetServiceAccountID(*i)
+	}
+	return occ
+}
+
+// SetID sets the "id" field.
+func (occ *OAuthClientCreate) SetID(i int64) *OAuthClientCreate {
+	occ.mutation.SetID(i)
+	return occ
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (occ *OAuthClientCreate) Mutation() *OAuthClientMutation {
+	return occ.mutation
+}
+
+// Save creates the OAuthClient in the database.
+func (occ *OAuthClientCreate) Save(ctx context.Context) (*OAuthClient, error) {
+	var (
+		err  error
+		node *OAuthClient
+	)
+	occ.defaults()
+	if len(occ.hooks) == 0 {
+		if err = occ.check(); err != nil {
+			return nil, err
+		}
+		node, err = occ.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OAuthClientMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = occ.check(); err != nil {
+				return nil, err
+			}
+			occ.mutation = mutation
+			if node, err = occ.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(occ.hooks) - 1; i >= 0; i-- {
+			if occ.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = occ.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, occ.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (occ *OAuthClientCreate) SaveX(ctx context.Context) *OAuthClient {
+	v, err := occ.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occ *OAuthClientCreate) Exec(ctx context.Context) error {
+	_, err := occ.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occ *OAuthClientCreate) ExecX(ctx context.Context) {
+	if err := occ.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (occ *OAuthClientCreate) defaults() {
+	if _, ok := occ.mutation.CreateTime(); !ok {
+		v := oauthclient.DefaultCreateTime()
+		occ.mutation.SetCreateTime(v)
+	}
+	if _, ok := occ.mutation.UpdateTime(); !ok {
+		v := oauthclient.DefaultUpdateTime()
+		occ.mutation.SetUpdateTime(v)
+	}
+	if _, ok := occ.mutation.Public(); !ok {
+		v := oauthclient.DefaultPublic
+		occ.mutation.SetPublic(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (occ *OAuthClientCreate) check() error {
+	if _, ok := occ.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OAuthClient.create_time"`)}
+	}
+	if _, ok := occ.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "OAuthClient.update_time"`)}
+	}
+	if _, ok := occ.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OAuthClient.client_id"`)}
+	}
+	if _, ok := occ.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "OAuthClient.name"`)}
+	}
+	if _, ok := occ.mutation.Public(); !ok {
+		return &ValidationError{Name: "public", err: errors.New(`ent: missing required field "OAuthClient.public"`)}
+	}
+	return nil
+}
+
+func (occ *OAuthClientCreate) sqlSave(ctx context.Context) (*OAuthClient, error) {
+	_node, _spec := occ.createSpec()
+	if err := sqlgraph.CreateNode(ctx, occ.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (occ *OAuthClientCreate) createSpec() (*OAuthClient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthClient{config: occ.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: oauthclient.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthclient.FieldID,
+			},
+		}
+	)
+	if id, ok := occ.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := occ.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: oauthclient.FieldCreateTime,
+		})
+		_node.CreateTime = value
+	}
+	if value, ok := occ.mutation.UpdateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: oauthclient.FieldUpdateTime,
+		})
+		_node.UpdateTime = value
+	}
+	if value, ok := occ.mutation.ClientID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthclient.FieldClientID,
+		})
+		_node.ClientID = value
+	}
+	if value, ok := occ.mutation.SecretHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthclient.FieldSecretHash,
+		})
+		_node.SecretHash = value
+	}
+	if value, ok := occ.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthclient.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := occ.mutation.RedirectUris(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauthclient.FieldRedirectUris,
+		})
+		_node.RedirectUris = value
+	}
+	if value, ok := occ.mutation.GrantTypes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauthclient.FieldGrantTypes,
+		})
+		_node.GrantTypes = value
+	}
+	if value, ok := occ.mutation.Scopes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauthclient.FieldScopes,
+		})
+		_node.Scopes = value
+	}
+	if value, ok := occ.mutation.Public(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: oauthclient.FieldPublic,
+		})
+		_node.Public = value
+	}
+	if value, ok := occ.mutation.ServiceAccountID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: oauthclient.FieldServiceAccountID,
+		})
+		_node.ServiceAccountID = value
+	}
+	return _node, _spec
+}
+
+// OAuthClientCreateBulk is the builder for creating many OAuthClient entities in bulk.
+type OAuthClientCreateBulk struct {
+	config
+	builders []*OAuthClientCreate
+}
+
+// Save creates the OAuthClient entities in the database.
+func (occb *OAuthClientCreateBulk) Save(ctx context.Context) ([]*OAuthClient, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(occb.builders))
+	nodes := make([]*OAuthClient, len(occb.builders))
+	mutators := make([]Mutator, len(occb.builders))
+	for i := range occb.builders {
+		func(i int, root context.Context) {
+			builder := occb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthClientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, occb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, occb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, occb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (occb *OAuthClientCreateBulk) SaveX(ctx context.Context) []*OAuthClient {
+	v, err := occb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occb *OAuthClientCreateBulk) Exec(ctx context.Context) error {
+	_, err := occb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occb *OAuthClientCreateBulk) ExecX(ctx context.Context) {
+	if err := occb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
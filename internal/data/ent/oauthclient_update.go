@@ -0,0 +1,662 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"usm/internal/data/ent/oauthclient"
+	"usm/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthClientUpdate is the builder for updating OAuthClient entities.
+type OAuthClientUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OAuthClientMutation
+}
+
+// Where appends a list predicates to the OAuthClientUpdate builder.
+func (ocu *OAuthClientUpdate) Where(ps ...predicate.OAuthClient) *OAuthClientUpdate {
+	ocu.mutation.Where(ps...)
+	return ocu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ocu *OAuthClientUpdate) SetUpdateTime(t time.Time) *OAuthClientUpdate {
+	ocu.mutation.SetUpdateTime(t)
+	return ocu
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (ocu *OAuthClientUpdate) SetSecretHash(s string) *OAuthClientUpdate {
+	ocu.mutation.SetSecretHash(s)
+	return ocu
+}
+
+// SetNillableSecretHash sets the "secret_hash" field if the given value is not nil.
+func (ocu *OAuthClientUpdate) SetNillableSecretHash(s *string) *OAuthClientUpdate {
+	if s != nil {
+		ocu.SetSecretHash(*s)
+	}
+	return ocu
+}
+
+// ClearSecretHash clears the value of the "secret_hash" field.
+func (ocu *OAuthClientUpdate) ClearSecretHash() *OAuthClientUpdate {
+	ocu.mutation.ClearSecretHash()
+	return ocu
+}
+
+// SetName sets the "name" field.
+func (ocu *OAuthClientUpdate) SetName(s string) *OAuthClientUpdate {
+	ocu.mutation.SetName(s)
+	return ocu
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (ocu *OAuthClientUpdate) SetRedirectUris(s []string) *OAuthClientUpdate {
+	ocu.mutation.SetRedirectUris(s)
+	return ocu
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (ocu *OAuthClientUpdate) ClearRedirectUris() *OAuthClientUpdate {
+	ocu.mutation.ClearRedirectUris()
+	return ocu
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (ocu *OAuthClientUpdate) SetGrantTypes(s []string) *OAuthClientUpdate {
+	ocu.mutation.SetGrantTypes(s)
+	return ocu
+}
+
+// ClearGrantTypes clears the value of the "grant_types" field.
+func (ocu *OAuthClientUpdate) ClearGrantTypes() *OAuthClientUpdate {
+	ocu.mutation.ClearGrantTypes()
+	return ocu
+}
+
+// SetScopes sets the "scopes" field.
+func (ocu *OAuthClientUpdate) SetScopes(s []string) *OAuthClientUpdate {
+	ocu.mutation.SetScopes(s)
+	return ocu
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (ocu *OAuthClientUpdate) ClearScopes() *OAuthClientUpdate {
+	ocu.mutation.ClearScopes()
+	return ocu
+}
+
+// SetPublic sets the "public" field.
+func (ocu *OAuthClientUpdate) SetPublic(b bool) *OAuthClientUpdate {
+	ocu.mutation.SetPublic(b)
+	return ocu
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (ocu *OAuthClientUpdate) SetNillablePublic(b *bool) *OAuthClientUpdate {
+	if b != nil {
+		ocu.SetPublic(*b)
+	}
+	return ocu
+}
+
+// SetServiceAccountID sets the "service_account_id" field.
+func (ocu *OAuthClientUpdate) SetServiceAccountID(i int64) *OAuthClientUpdate {
+	ocu.mutation.ResetServiceAccountID()
+	ocu.mutation.SetServiceAccountID(i)
+	return ocu
+}
+
+// SetNillableServiceAccountID sets the "service_account_id" field if the given value is not nil.
+func (ocu *OAuthClientUpdate) SetNillableServiceAccountID(i *int64) *OAuthClientUpdate {
+	if i != nil {
+		ocu.SetServiceAccountID(*i)
+	}
+	return ocu
+}
+
+// AddServiceAccountID adds i to the "service_account_id" field.
+func (ocu *OAuthClientUpdate) AddServiceAccountID(i int64) *OAuthClientUpdate {
+	ocu.mutation.AddServiceAccountID(i)
+	return ocu
+}
+
+// ClearServiceAccountID clears the value of the "service_account_id" field.
+func (ocu *OAuthClientUpdate) ClearServiceAccountID() *OAuthClientUpdate {
+	ocu.mutation.ClearServiceAccountID()
+	return ocu
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (ocu *OAuthClientUpdate) Mutation() *OAuthClientMutation {
+	return ocu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ocu *OAuthClientUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	ocu.defaults()
+	if len(ocu.hooks) == 0 {
+		affected, err = ocu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OAuthClientMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ocu.mutation = mutation
+			affected, err = ocu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ocu.hooks) - 1; i >= 0; i-- {
+			if ocu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ocu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ocu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocu *OAuthClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := ocu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ocu *OAuthClientUpdate) Exec(ctx context.Context) error {
+	_, err := ocu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocu *OAuthClientUpdate) ExecX(ctx context.Context) {
+	if err := ocu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocu *OAuthClientUpdate) defaults() {
+	if _, ok := ocu.mutation.UpdateTime(); !ok {
+		v := oauthclient.UpdateDefaultUpdateTime()
+		ocu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (ocu *OAuthClientUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   oauthclient.Table,
+			Columns: oauthclient.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthclient.FieldID,
+			},
+		},
+	}
+	if ps := ocu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocu.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: oauthclient.FieldUpdateTime,
+		})
+	}
+	if value, ok := ocu.mutation.SecretHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthclient.FieldSecretHash,
+		})
+	}
+	if ocu.mutation.SecretHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: oauthclient.FieldSecretHash,
+		})
+	}
+	if value, ok := ocu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthclient.FieldName,
+		})
+	}
+	if value, ok := ocu.mutation.RedirectUris(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauthclient.FieldRedirectUris,
+		})
+	}
+	if ocu.mutation.RedirectUrisCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: oauthclient.FieldRedirectUris,
+		})
+	}
+	if value, ok := ocu.mutation.GrantTypes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauthclient.FieldGrantTypes,
+		})
+	}
+	if ocu.mutation.GrantTypesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: oauthclient.FieldGrantTypes,
+		})
+	}
+	if value, ok := ocu.mutation.Scopes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauthclient.FieldScopes,
+		})
+	}
+	if ocu.mutation.ScopesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: oauthclient.FieldScopes,
+		})
+	}
+	if value, ok := ocu.mutation.Public(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: oauthclient.FieldPublic,
+		})
+	}
+	if value, ok := ocu.mutation.ServiceAccountID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: oauthclient.FieldServiceAccountID,
+		})
+	}
+	if value, ok := ocu.mutation.AddedServiceAccountID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: oauthclient.FieldServiceAccountID,
+		})
+	}
+	if ocu.mutation.ServiceAccountIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: oauthclient.FieldServiceAccountID,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ocu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// OAuthClientUpdateOne is the builder for updating a single OAuthClient entity.
+type OAuthClientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OAuthClientMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ocuo *OAuthClientUpdateOne) SetUpdateTime(t time.Time) *OAuthClientUpdateOne {
+	ocuo.mutation.SetUpdateTime(t)
+	return ocuo
+}
+
+// SetSecretHash sets the "secret_hash" field.
+func (ocuo *OAuthClientUpdateOne) SetSecretHash(s string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetSecretHash(s)
+	return ocuo
+}
+
+// SetNillableSecretHash sets the "secret_hash" field if the given value is not nil.
+func (ocuo *OAuthClientUpdateOne) SetNillableSecretHash(s *string) *OAuthClientUpdateOne {
+	if s != nil {
+		ocuo.SetSecretHash(*s)
+	}
+	return ocuo
+}
+
+// ClearSecretHash clears the value of the "secret_hash" field.
+func (ocuo *OAuthClientUpdateOne) ClearSecretHash() *OAuthClientUpdateOne {
+	ocuo.mutation.ClearSecretHash()
+	return ocuo
+}
+
+// SetName sets the "name" field.
+func (ocuo *OAuthClientUpdateOne) SetName(s string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetName(s)
+	return ocuo
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (ocuo *OAuthClientUpdateOne) SetRedirectUris(s []string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetRedirectUris(s)
+	return ocuo
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (ocuo *OAuthClientUpdateOne) ClearRedirectUris() *OAuthClientUpdateOne {
+	ocuo.mutation.ClearRedirectUris()
+	return ocuo
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (ocuo *OAuthClientUpdateOne) SetGrantTypes(s []string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetGrantTypes(s)
+	return ocuo
+}
+
+// ClearGrantTypes clears the value of the "grant_types" field.
+func (ocuo *OAuthClientUpdateOne) ClearGrantTypes() *OAuthClientUpdateOne {
+	ocuo.mutation.ClearGrantTypes()
+	return ocuo
+}
+
+// SetScopes sets the "scopes" field.
+func (ocuo *OAuthClientUpdateOne) SetScopes(s []string) *OAuthClientUpdateOne {
+	ocuo.mutation.SetScopes(s)
+	return ocuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (ocuo *OAuthClientUpdateOne) ClearScopes() *OAuthClientUpdateOne {
+	ocuo.mutation.ClearScopes()
+	return ocuo
+}
+
+// SetPublic sets the "public" field.
+func (ocuo *OAuthClientUpdateOne) SetPublic(b bool) *OAuthClientUpdateOne {
+	ocuo.mutation.SetPublic(b)
+	return ocuo
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (ocuo *OAuthClientUpdateOne) SetNillablePublic(b *bool) *OAuthClientUpdateOne {
+	if b != nil {
+		ocuo.SetPublic(*b)
+	}
+	return ocuo
+}
+
+// SetServiceAccountID sets the "service_account_id" field.
+func (ocuo *OAuthClientUpdateOne) SetServiceAccountID(i int64) *OAuthClientUpdateOne {
+	ocuo.mutation.ResetServiceAccountID()
+	ocuo.mutation.SetServiceAccountID(i)
+	return ocuo
+}
+
+// SetNillableServiceAccountID sets the "service_account_id" field if the given value is not nil.
+func (ocuo *OAuthClientUpdateOne) SetNillableServiceAccountID(i *int64) *OAuthClientUpdateOne {
+	if i != nil {
+		ocuo.SetServiceAccountID(*i)
+	}
+	return ocuo
+}
+
+// AddServiceAccountID adds i to the "service_account_id" field.
+func (ocuo *OAuthClientUpdateOne) AddServiceAccountID(i int64) *OAuthClientUpdateOne {
+	ocuo.mutation.AddServiceAccountID(i)
+	return ocuo
+}
+
+// ClearServiceAccountID clears the value of the "service_account_id" field.
+func (ocuo *OAuthClientUpdateOne) ClearServiceAccountID() *OAuthClientUpdateOne {
+	ocuo.mutation.ClearServiceAccountID()
+	return ocuo
+}
+
+// Mutation returns the OAuthClientMutation object of the builder.
+func (ocuo *OAuthClientUpdateOne) Mutation() *OAuthClientMutation {
+	return ocuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ocuo *OAuthClientUpdateOne) Select(field string, fields ...string) *OAuthClientUpdateOne {
+	ocuo.fields = append([]string{field}, fields...)
+	return ocuo
+}
+
+// Save executes the query and returns the updated OAuthClient entity.
+func (ocuo *OAuthClientUpdateOne) Save(ctx context.Context) (*OAuthClient, error) {
+	var (
+		err  error
+		node *OAuthClient
+	)
+	ocuo.defaults()
+	if len(ocuo.hooks) == 0 {
+		node, err = ocuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OAuthClientMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ocuo.mutation = mutation
+			node, err = ocuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ocuo.hooks) - 1; i >= 0; i-- {
+			if ocuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ocuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ocuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocuo *OAuthClientUpdateOne) SaveX(ctx context.Context) *OAuthClient {
+	node, err := ocuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ocuo *OAuthClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := ocuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocuo *OAuthClientUpdateOne) ExecX(ctx context.Context) {
+	if err := ocuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocuo *OAuthClientUpdateOne) defaults() {
+	if _, ok := ocuo.mutation.UpdateTime(); !ok {
+		v := oauthclient.UpdateDefaultUpdateTime()
+		ocuo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (ocuo *OAuthClientUpdateOne) sqlSave(ctx context.Context) (_node *OAuthClient, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   oauthclient.Table,
+			Columns: oauthclient.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthclient.FieldID,
+			},
+		},
+	}
+	id, ok := ocuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthClient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ocuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthclient.FieldID)
+		for _, f := range fields {
+			if !oauthclient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthclient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ocuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocuo.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: oauthclient.FieldUpdateTime,
+		})
+	}
+	if value, ok := ocuo.mutation.SecretHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthclient.FieldSecretHash,
+		})
+	}
+	if ocuo.mutation.SecretHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: oauthclient.FieldSecretHash,
+		})
+	}
+	if value, ok := ocuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthclient.FieldName,
+		})
+	}
+	if value, ok := ocuo.mutation.RedirectUris(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauthclient.FieldRedirectUris,
+		})
+	}
+	if ocuo.mutation.RedirectUrisCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: oauthclient.FieldRedirectUris,
+		})
+	}
+	if value, ok := ocuo.mutation.GrantTypes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauthclient.FieldGrantTypes,
+		})
+	}
+	if ocuo.mutation.GrantTypesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: oauthclient.FieldGrantTypes,
+		})
+	}
+	if value, ok := ocuo.mutation.Scopes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauthclient.FieldScopes,
+		})
+	}
+	if ocuo.mutation.ScopesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: oauthclient.FieldScopes,
+		})
+	}
+	if value, ok := ocuo.mutation.Public(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: oauthclient.FieldPublic,
+		})
+	}
+	if value, ok := ocuo.mutation.ServiceAccountID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: oauthclient.FieldServiceAccountID,
+		})
+	}
+	if value, ok := ocuo.mutation.AddedServiceAccountID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: oauthclient.FieldServiceAccountID,
+		})
+	}
+	if ocuo.mutation.ServiceAccountIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: oauthclient.FieldServiceAccountID,
+		})
+	}
+	_node = &OAuthClient{config: ocuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ocuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
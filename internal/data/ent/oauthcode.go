@@ -0,0 +1,196 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"usm/internal/data/ent/oauthcode"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// OAuthCode is the model entity for the OAuthCode schema.
+type OAuthCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// CodeHash holds the value of the "code_hash" field.
+	CodeHash string `json:"-"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// RedirectURI holds the value of the "redirect_uri" field.
+	RedirectURI string `json:"redirect_uri,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// CodeChallenge holds the value of the "code_challenge" field.
+	CodeChallenge string `json:"code_challenge,omitempty"`
+	// CodeChallengeMethod holds the value of the "code_challenge_method" field.
+	CodeChallengeMethod string `json:"code_challenge_method,omitempty"`
+	// Nonce holds the value of the "nonce" field.
+	Nonce string `json:"nonce,omitempty"`
+	// ExpireTime holds the value of the "expire_time" field.
+	ExpireTime time.Time `json:"expire_time,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OAuthCode) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthcode.FieldScopes:
+			values[i] = new([]byte)
+		case oauthcode.FieldID, oauthcode.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case oauthcode.FieldCodeHash, oauthcode.FieldClientID, oauthcode.FieldRedirectURI, oauthcode.FieldCodeChallenge, oauthcode.FieldCodeChallengeMethod, oauthcode.FieldNonce:
+			values[i] = new(sql.NullString)
+		case oauthcode.FieldCreateTime, oauthcode.FieldExpireTime:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type OAuthCode", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OAuthCode fields.
+func (oc *OAuthCode) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthcode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oc.ID = int64(value.Int64)
+		case oauthcode.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				oc.CreateTime = value.Time
+			}
+		case oauthcode.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				oc.CodeHash = value.String
+			}
+		case oauthcode.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				oc.ClientID = value.String
+			}
+		case oauthcode.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				oc.UserID = value.Int64
+			}
+		case oauthcode.FieldRedirectURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uri", values[i])
+			} else if value.Valid {
+				oc.RedirectURI = value.String
+			}
+		case oauthcode.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oc.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthcode.FieldCodeChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_challenge", values[i])
+			} else if value.Valid {
+				oc.CodeChallenge = value.String
+			}
+		case oauthcode.FieldCodeChallengeMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_challenge_method", values[i])
+			} else if value.Valid {
+				oc.CodeChallengeMethod = value.String
+			}
+		case oauthcode.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				oc.Nonce = value.String
+			}
+		case oauthcode.FieldExpireTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expire_time", values[i])
+			} else if value.Valid {
+				oc.ExpireTime = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this OAuthCode.
+// Note that you need to call OAuthCode.Unwrap() before calling this method if this OAuthCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oc *OAuthCode) Update() *OAuthCodeUpdateOne {
+	return (&OAuthCodeClient{config: oc.config}).UpdateOne(oc)
+}
+
+// Unwrap unwraps the OAuthCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oc *OAuthCode) Unwrap() *OAuthCode {
+	tx, ok := oc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OAuthCode is not a transactional entity")
+	}
+	oc.config.driver = tx.drv
+	return oc
+}
+
+// String implements the fmt.Stringer.
+func (oc *OAuthCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("OAuthCode(")
+	builder.WriteString(fmt.Sprintf("id=%v", oc.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(oc.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", code_hash=<sensitive>")
+	builder.WriteString(", client_id=")
+	builder.WriteString(oc.ClientID)
+	builder.WriteString(", user_id=")
+	builder.WriteString(fmt.Sprintf("%v", oc.UserID))
+	builder.WriteString(", redirect_uri=")
+	builder.WriteString(oc.RedirectURI)
+	builder.WriteString(", scopes=")
+	builder.WriteString(fmt.Sprintf("%v", oc.Scopes))
+	builder.WriteString(", code_challenge=")
+	builder.WriteString(oc.CodeChallenge)
+	builder.WriteString(", code_challenge_method=")
+	builder.WriteString(oc.CodeChallengeMethod)
+	builder.WriteString(", nonce=")
+	builder.WriteString(oc.Nonce)
+	builder.WriteString(", expire_time=")
+	builder.WriteString(oc.ExpireTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OAuthCodes is a parsable slice of OAuthCode.
+type OAuthCodes []*OAuthCode
+
+func (oc OAuthCodes) config(cfg config) {
+	for _i := range oc {
+		oc[_i].config = cfg
+	}
+}
@@ -0,0 +1,66 @@
+// Code generated by entc, DO NOT EDIT.
+
+package oauthcode
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the oauthcode type in the database.
+	Label = "oauth_code"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldCodeHash holds the string denoting the code_hash field in the database.
+	FieldCodeHash = "code_hash"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldRedirectURI holds the string denoting the redirect_uri field in the database.
+	FieldRedirectURI = "redirect_uri"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldCodeChallenge holds the string denoting the code_challenge field in the database.
+	FieldCodeChallenge = "code_challenge"
+	// FieldCodeChallengeMethod holds the string denoting the code_challenge_method field in the database.
+	FieldCodeChallengeMethod = "code_challenge_method"
+	// FieldNonce holds the string denoting the nonce field in the database.
+	FieldNonce = "nonce"
+	// FieldExpireTime holds the string denoting the expire_time field in the database.
+	FieldExpireTime = "expire_time"
+	// Table holds the table name of the oauthcode in the database.
+	Table = "oauth_codes"
+)
+
+// Columns holds all SQL columns for oauthcode fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldCodeHash,
+	FieldClientID,
+	FieldUserID,
+	FieldRedirectURI,
+	FieldScopes,
+	FieldCodeChallenge,
+	FieldCodeChallengeMethod,
+	FieldNonce,
+	FieldExpireTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+)
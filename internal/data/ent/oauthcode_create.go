@@ -0,0 +1,416 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"usm/internal/data/ent/oauthcode"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthCodeCreate is the builder for creating a OAuthCode entity.
+type OAuthCodeCreate struct {
+	config
+	mutation *OAuthCodeMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (occ *OAuthCodeCreate) SetCreateTime(t time.Time) *OAuthCodeCreate {
+	occ.mutation.SetCreateTime(t)
+	return occ
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (occ *OAuthCodeCreate) SetNillableCreateTime(t *time.Time) *OAuthCodeCreate {
+	if t != nil {
+		occ.SetCreateTime(*t)
+	}
+	return occ
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (occ *OAuthCodeCreate) SetCodeHash(s string) *OAuthCodeCreate {
+	occ.mutation.SetCodeHash(s)
+	return occ
+}
+
+// SetClientID sets the "client_id" field.
+func (occ *OAuthCodeCreate) SetClientID(s string) *OAuthCodeCreate {
+	occ.mutation.SetClientID(s)
+	return occ
+}
+
+// SetUserID sets the "user_id" field.
+func (occ *OAuthCodeCreate) SetUserID(i int64) *OAuthCodeCreate {
+	occ.mutation.SetUserID(i)
+	return occ
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (occ *OAuthCodeCreate) SetRedirectURI(s string) *OAuthCodeCreate {
+	occ.mutation.SetRedirectURI(s)
+	return occ
+}
+
+// SetScopes sets the "scopes" field.
+func (occ *OAuthCodeCreate) SetScopes(s []string) *OAuthCodeCreate {
+	occ.mutation.SetScopes(s)
+	return occ
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (occ *OAuthCodeCreate) SetCodeChallenge(s string) *OAuthCodeCreate {
+	occ.mutation.SetCodeChallenge(s)
+	return occ
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (occ *OAuthCodeCreate) SetNillableCodeChallenge(s *string) *OAuthCodeCreate {
+	if s != nil {
+		occ.SetCodeChallenge(*s)
+	}
+	return occ
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (occ *OAuthCodeCreate) SetCodeChallengeMethod(s string) *OAuthCodeCreate {
+	occ.mutation.SetCodeChallengeMethod(s)
+	return occ
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (occ *OAuthCodeCreate) SetNillableCodeChallengeMethod(s *string) *OAuthCodeCreate {
+	if s != nil {
+		occ.SetCodeChallengeMethod(*s)
+	}
+	return occ
+}
+
+// SetNonce sets the "nonce" field.
+func (occ *OAuthCodeCreate) SetNonce(s string) *OAuthCodeCreate {
+	occ.mutation.SetNonce(s)
+	return occ
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (occ *OAuthCodeCreate) SetNillableNonce(s *string) *OAuthCodeCreate {
+	if s != nil {
+		occ.SetNonce(*s)
+	}
+	return occ
+}
+
+// SetExpireTime sets the "expire_time" field.
+func (occ *OAuthCodeCreate) SetExpireTime(t time.Time) *OAuthCodeCreate {
+	occ.mutation.SetExpireTime(t)
+	return occ
+}
+
+// SetID sets the "id" field.
+func (occ *OAuthCodeCreate) SetID(i int64) *OAuthCodeCreate {
+	occ.mutation.SetID(i)
+	return occ
+}
+
+// Mutation returns the OAuthCodeMutation object of the builder.
+func (occ *OAuthCodeCreate) Mutation() *OAuthCodeMutation {
+	return occ.mutation
+}
+
+// Save creates the OAuthCode in the database.
+func (occ *OAuthCodeCreate) Save(ctx context.Context) (*OAuthCode, error) {
+	var (
+		err  error
+		node *OAuthCode
+	)
+	occ.defaults()
+	if len(occ.hooks) == 0 {
+		if err = occ.check(); err != nil {
+			return nil, err
+		}
+		node, err = occ.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OAuthCodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = occ.check(); err != nil {
+				return nil, err
+			}
+			occ.mutation = mutation
+			if node, err = occ.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(occ.hooks) - 1; i >= 0; i-- {
+			if occ.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = occ.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, occ.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (occ *OAuthCodeCreate) SaveX(ctx context.Context) *OAuthCode {
+	v, err := occ.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occ *OAuthCodeCreate) Exec(ctx context.Context) error {
+	_, err := occ.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occ *OAuthCodeCreate) ExecX(ctx context.Context) {
+	if err := occ.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (occ *OAuthCodeCreate) defaults() {
+	if _, ok := occ.mutation.CreateTime(); !ok {
+		v := oauthcode.DefaultCreateTime()
+		occ.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (occ *OAuthCodeCreate) check() error {
+	if _, ok := occ.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OAuthCode.create_time"`)}
+	}
+	if _, ok := occ.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "OAuthCode.code_hash"`)}
+	}
+	if _, ok := occ.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OAuthCode.client_id"`)}
+	}
+	if _, ok := occ.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OAuthCode.user_id"`)}
+	}
+	if _, ok := occ.mutation.RedirectURI(); !ok {
+		return &ValidationError{Name: "redirect_uri", err: errors.New(`ent: missing required field "OAuthCode.redirect_uri"`)}
+	}
+	if _, ok := occ.mutation.ExpireTime(); !ok {
+		return &ValidationError{Name: "expire_time", err: errors.New(`ent: missing required field "OAuthCode.expire_time"`)}
+	}
+	return nil
+}
+
+func (occ *OAuthCodeCreate) sqlSave(ctx context.Context) (*OAuthCode, error) {
+	_node, _spec := occ.createSpec()
+	if err := sqlgraph.CreateNode(ctx, occ.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (occ *OAuthCodeCreate) createSpec() (*OAuthCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthCode{config: occ.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: oauthcode.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthcode.FieldID,
+			},
+		}
+	)
+	if id, ok := occ.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := occ.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: oauthcode.FieldCreateTime,
+		})
+		_node.CreateTime = value
+	}
+	if value, ok := occ.mutation.CodeHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthcode.FieldCodeHash,
+		})
+		_node.CodeHash = value
+	}
+	if value, ok := occ.mutation.ClientID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthcode.FieldClientID,
+		})
+		_node.ClientID = value
+	}
+	if value, ok := occ.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: oauthcode.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := occ.mutation.RedirectURI(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthcode.FieldRedirectURI,
+		})
+		_node.RedirectURI = value
+	}
+	if value, ok := occ.mutation.Scopes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauthcode.FieldScopes,
+		})
+		_node.Scopes = value
+	}
+	if value, ok := occ.mutation.CodeChallenge(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthcode.FieldCodeChallenge,
+		})
+		_node.CodeChallenge = value
+	}
+	if value, ok := occ.mutation.CodeChallengeMethod(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthcode.FieldCodeChallengeMethod,
+		})
+		_node.CodeChallengeMethod = value
+	}
+	if value, ok := occ.mutation.Nonce(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthcode.FieldNonce,
+		})
+		_node.Nonce = value
+	}
+	if value, ok := occ.mutation.ExpireTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: oauthcode.FieldExpireTime,
+		})
+		_node.ExpireTime = value
+	}
+	return _node, _spec
+}
+
+// OAuthCodeCreateBulk is the builder for creating many OAuthCode entities in bulk.
+type OAuthCodeCreateBulk struct {
+	config
+	builders []*OAuthCodeCreate
+}
+
+// Save creates the OAuthCode entities in the database.
+func (occb *OAuthCodeCreateBulk) Save(ctx context.Context) ([]*OAuthCode, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(occb.builders))
+	nodes := make([]*OAuthCode, len(occb.builders))
+	mutators := make([]Mutator, len(occb.builders))
+	for i := range occb.builders {
+		func(i int, root context.Context) {
+			builder := occb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, occb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, occb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, occb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (occb *OAuthCodeCreateBulk) SaveX(ctx context.Context) []*OAuthCode {
+	v, err := occb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occb *OAuthCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := occb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occb *OAuthCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := occb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"usm/internal/data/ent/oauthcode"
+	"usm/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthCodeDelete is the builder for deleting a OAuthCode entity.
+type OAuthCodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *OAuthCodeMutation
+}
+
+// Where appends a list predicates to the OAuthCodeDelete builder.
+func (ocd *OAuthCodeDelete) Where(ps ...predicate.OAuthCode) *OAuthCodeDelete {
+	ocd.mutation.Where(ps...)
+	return ocd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ocd *OAuthCodeDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ocd.hooks) == 0 {
+		affected, err = ocd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OAuthCodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ocd.mutation = mutation
+			affected, err = ocd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ocd.hooks) - 1; i >= 0; i-- {
+			if ocd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ocd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ocd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocd *OAuthCodeDelete) ExecX(ctx context.Context) int {
+	n, err := ocd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ocd *OAuthCodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: oauthcode.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthcode.FieldID,
+			},
+		},
+	}
+	if ps := ocd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, ocd.driver, _spec)
+}
+
+// OAuthCodeDeleteOne is the builder for deleting a single OAuthCode entity.
+type OAuthCodeDeleteOne struct {
+	ocd *OAuthCodeDelete
+}
+
+// Exec executes the deletion query.
+func (ocdo *OAuthCodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := ocdo.ocd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{oauthcode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocdo *OAuthCodeDeleteOne) ExecX(ctx context.Context) {
+	ocdo.ocd.ExecX(ctx)
+}
@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"usm/internal/data/ent/oauthcode"
+	"usm/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthCodeQuery is the builder for querying OAuthCode entities.
+type OAuthCodeQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.OAuthCode
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OAuthCodeQuery builder.
+func (ocq *OAuthCodeQuery) Where(ps ...predicate.OAuthCode) *OAuthCodeQuery {
+	ocq.predicates = append(ocq.predicates, ps...)
+	return ocq
+}
+
+// Limit adds a limit step to the query.
+func (ocq *OAuthCodeQuery) Limit(limit int) *OAuthCodeQuery {
+	ocq.limit = &limit
+	return ocq
+}
+
+// Offset adds an offset step to the query.
+func (ocq *OAuthCodeQuery) Offset(offset int) *OAuthCodeQuery {
+	ocq.offset = &offset
+	return ocq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ocq *OAuthCodeQuery) Unique(unique bool) *OAuthCodeQuery {
+	ocq.unique = &unique
+	return ocq
+}
+
+// Order adds an order step to the query.
+func (ocq *OAuthCodeQuery) Order(o ...OrderFunc) *OAuthCodeQuery {
+	ocq.order = append(ocq.order, o...)
+	return ocq
+}
+
+// First returns the first OAuthCode entity from the query.
+// Returns a *NotFoundError when no OAuthCode was found.
+func (ocq *OAuthCodeQuery) First(ctx context.Context) (*OAuthCode, error) {
+	nodes, err := ocq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{oauthcode.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ocq *OAuthCodeQuery) FirstX(ctx context.Context) *OAuthCode {
+	node, err := ocq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OAuthCode ID from the query.
+// Returns a *NotFoundError when no OAuthCode ID was found.
+func (ocq *OAuthCodeQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = ocq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{oauthcode.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ocq *OAuthCodeQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := ocq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OAuthCode entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OAuthCode entity is found.
+// Returns a *NotFoundError when no OAuthCode entities are found.
+func (ocq *OAuthCodeQuery) Only(ctx context.Context) (*OAuthCode, error) {
+	nodes, err := ocq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{oauthcode.Label}
+	default:
+		return nil, &NotSingularError{oauthcode.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ocq *OAuthCodeQuery) OnlyX(ctx context.Context) *OAuthCode {
+	node, err := ocq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OAuthCode ID in the query.
+// Returns a *NotSingularError when more than one OAuthCode ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ocq *OAuthCodeQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = ocq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{oauthcode.Label}
+	default:
+		err = &NotSingularError{oauthcode.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ocq *OAuthCodeQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := ocq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OAuthCodes.
+func (ocq *OAuthCodeQuery) All(ctx context.Context) ([]*OAuthCode, error) {
+	if err := ocq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ocq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ocq *OAuthCodeQuery) AllX(ctx context.Context) []*OAuthCode {
+	nodes, err := ocq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OAuthCode IDs.
+func (ocq *OAuthCodeQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := ocq.Select(oauthcode.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ocq *OAuthCodeQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := ocq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ocq *OAuthCodeQuery) Count(ctx context.Context) (int, error) {
+	if err := ocq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ocq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ocq *OAuthCodeQuery) CountX(ctx context.Context) int {
+	count, err := ocq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ocq *OAuthCodeQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ocq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ocq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ocq *OAuthCodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := ocq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OAuthCodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ocq *OAuthCodeQuery) Clone() *OAuthCodeQuery {
+	if ocq == nil {
+		return nil
+	}
+	return &OAuthCodeQuery{
+		config:     ocq.config,
+		limit:      ocq.limit,
+		offset:     ocq.offset,
+		order:      append([]OrderFunc{}, ocq.order...),
+		predicates: append([]predicate.OAuthCode{}, ocq.predicates...),
+		// clone intermediate query.
+		sql:    ocq.sql.Clone(),
+		path:   ocq.path,
+		unique: ocq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OAuthCode.Query().
+//		GroupBy(oauthcode.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (ocq *OAuthCodeQuery) GroupBy(field string, fields ...string) *OAuthCodeGroupBy {
+	group := &OAuthCodeGroupBy{config: ocq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ocq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ocq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.OAuthCode.Query().
+//		Select(oauthcode.FieldCreateTime).
+//		Scan(ctx, &v)
+//
+func (ocq *OAuthCodeQuery) Select(fields ...string) *OAuthCodeSelect {
+	ocq.fields = append(ocq.fields, fields...)
+	return &OAuthCodeSelect{OAuthCodeQuery: ocq}
+}
+
+func (ocq *OAuthCodeQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ocq.fields {
+		if !oauthcode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ocq.path != nil {
+		prev, err := ocq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ocq.sql = prev
+	}
+	return nil
+}
+
+func (ocq *OAuthCodeQuery) sqlAll(ctx context.Context) ([]*OAuthCode, error) {
+	var (
+		nodes = []*OAuthCode{}
+		_spec = ocq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &OAuthCode{config: ocq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ocq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ocq *OAuthCodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ocq.querySpec()
+	_spec.Node.Columns = ocq.fields
+	if len(ocq.fields) > 0 {
+		_spec.Unique = ocq.unique != nil && *ocq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ocq.driver, _spec)
+}
+
+func (ocq *OAuthCodeQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ocq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ocq *OAuthCodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   oauthcode.Table,
+			Columns: oauthcode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthcode.FieldID,
+			},
+		},
+		From:   ocq.sql,
+		Unique: true,
+	}
+	if unique := ocq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ocq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthcode.FieldID)
+		for i := range fields {
+			if fields[i] != oauthcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ocq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ocq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ocq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ocq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ocq *OAuthCodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ocq.driver.Dialect())
+	t1 := builder.Table(oauthcode.Table)
+	columns := ocq.fields
+	if len(columns) == 0 {
+		columns = oauthcode.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ocq.sql != nil {
+		selector = ocq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ocq.unique != nil && *ocq.unique {
+		selector.Distinct()
+	}
+	for _, p := range ocq.predicates {
+		p(selector)
+	}
+	for _, p := range ocq.order {
+		p(selector)
+	}
+	if offset := ocq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ocq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OAuthCodeGroupBy is the group-by builder for OAuthCode entities.
+type OAuthCodeGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ocgb *OAuthCodeGroupBy) Aggregate(fns ...AggregateFunc) *OAuthCodeGroupBy {
+	ocgb.fns = append(ocgb.fns, fns...)
+	return ocgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ocgb *OAuthCodeGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ocgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ocgb.sql = query
+	return ocgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ocgb *OAuthCodeGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ocgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OAuthCodeGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ocgb.fields) > 1 {
+		return nil, errors.New("ent: OAuthCodeGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ocgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ocgb *OAuthCodeGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ocgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OAuthCodeGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ocgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthcode.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthCodeGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ocgb *OAuthCodeGroupBy) StringX(ctx context.Context) string {
+	v, err := ocgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OAuthCodeGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ocgb.fields) > 1 {
+		return nil, errors.New("ent: OAuthCodeGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ocgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ocgb *OAuthCodeGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ocgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OAuthCodeGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ocgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthcode.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthCodeGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ocgb *OAuthCodeGroupBy) IntX(ctx context.Context) int {
+	v, err := ocgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OAuthCodeGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ocgb.fields) > 1 {
+		return nil, errors.New("ent: OAuthCodeGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ocgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ocgb *OAuthCodeGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ocgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OAuthCodeGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ocgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthcode.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthCodeGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ocgb *OAuthCodeGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := ocgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OAuthCodeGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ocgb.fields) > 1 {
+		return nil, errors.New("ent: OAuthCodeGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ocgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ocgb *OAuthCodeGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ocgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OAuthCodeGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ocgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthcode.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthCodeGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ocgb *OAuthCodeGroupBy) BoolX(ctx context.Context) bool {
+	v, err := ocgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ocgb *OAuthCodeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ocgb.fields {
+		if !oauthcode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ocgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ocgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ocgb *OAuthCodeGroupBy) sqlQuery() *sql.Selector {
+	selector := ocgb.sql.Select()
+	aggregation := make([]string, 0, len(ocgb.fns))
+	for _, fn := range ocgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ocgb.fields)+len(ocgb.fns))
+		for _, f := range ocgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ocgb.fields...)...)
+}
+
+// OAuthCodeSelect is the builder for selecting fields of OAuthCode entities.
+type OAuthCodeSelect struct {
+	*OAuthCodeQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ocs *OAuthCodeSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ocs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ocs.sql = ocs.OAuthCodeQuery.sqlQuery(ctx)
+	return ocs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ocs *OAuthCodeSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ocs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ocs *OAuthCodeSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ocs.fields) > 1 {
+		return nil, errors.New("ent: OAuthCodeSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ocs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ocs *OAuthCodeSelect) StringsX(ctx context.Context) []string {
+	v, err := ocs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ocs *OAuthCodeSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ocs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthcode.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthCodeSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ocs *OAuthCodeSelect) StringX(ctx context.Context) string {
+	v, err := ocs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ocs *OAuthCodeSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ocs.fields) > 1 {
+		return nil, errors.New("ent: OAuthCodeSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ocs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ocs *OAuthCodeSelect) IntsX(ctx context.Context) []int {
+	v, err := ocs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ocs *OAuthCodeSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ocs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthcode.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthCodeSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ocs *OAuthCodeSelect) IntX(ctx context.Context) int {
+	v, err := ocs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ocs *OAuthCodeSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ocs.fields) > 1 {
+		return nil, errors.New("ent: OAuthCodeSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ocs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ocs *OAuthCodeSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ocs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ocs *OAuthCodeSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ocs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthcode.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthCodeSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ocs *OAuthCodeSelect) Float64X(ctx context.Context) float64 {
+	v, err := ocs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ocs *OAuthCodeSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ocs.fields) > 1 {
+		return nil, errors.New("ent: OAuthCodeSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ocs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ocs *OAuthCodeSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ocs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ocs *OAuthCodeSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ocs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthcode.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthCodeSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ocs *OAuthCodeSelect) BoolX(ctx context.Context) bool {
+	v, err := ocs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ocs *OAuthCodeSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ocs.sql.Query()
+	if err := ocs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,287 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"usm/internal/data/ent/oauthcode"
+	"usm/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthCodeUpdate is the builder for updating OAuthCode entities.
+type OAuthCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OAuthCodeMutation
+}
+
+// Where appends a list predicates to the OAuthCodeUpdate builder.
+func (ocu *OAuthCodeUpdate) Where(ps ...predicate.OAuthCode) *OAuthCodeUpdate {
+	ocu.mutation.Where(ps...)
+	return ocu
+}
+
+// Mutation returns the OAuthCodeMutation object of the builder.
+func (ocu *OAuthCodeUpdate) Mutation() *OAuthCodeMutation {
+	return ocu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ocu *OAuthCodeUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ocu.hooks) == 0 {
+		affected, err = ocu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OAuthCodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ocu.mutation = mutation
+			affected, err = ocu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ocu.hooks) - 1; i >= 0; i-- {
+			if ocu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ocu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ocu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocu *OAuthCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := ocu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ocu *OAuthCodeUpdate) Exec(ctx context.Context) error {
+	_, err := ocu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocu *OAuthCodeUpdate) ExecX(ctx context.Context) {
+	if err := ocu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ocu *OAuthCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   oauthcode.Table,
+			Columns: oauthcode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthcode.FieldID,
+			},
+		},
+	}
+	if ps := ocu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ocu.mutation.ScopesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: oauthcode.FieldScopes,
+		})
+	}
+	if ocu.mutation.CodeChallengeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: oauthcode.FieldCodeChallenge,
+		})
+	}
+	if ocu.mutation.CodeChallengeMethodCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: oauthcode.FieldCodeChallengeMethod,
+		})
+	}
+	if ocu.mutation.NonceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: oauthcode.FieldNonce,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ocu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// OAuthCodeUpdateOne is the builder for updating a single OAuthCode entity.
+type OAuthCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OAuthCodeMutation
+}
+
+// Mutation returns the OAuthCodeMutation object of the builder.
+func (ocuo *OAuthCodeUpdateOne) Mutation() *OAuthCodeMutation {
+	return ocuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ocuo *OAuthCodeUpdateOne) Select(field string, fields ...string) *OAuthCodeUpdateOne {
+	ocuo.fields = append([]string{field}, fields...)
+	return ocuo
+}
+
+// Save executes the query and returns the updated OAuthCode entity.
+func (ocuo *OAuthCodeUpdateOne) Save(ctx context.Context) (*OAuthCode, error) {
+	var (
+		err  error
+		node *OAuthCode
+	)
+	if len(ocuo.hooks) == 0 {
+		node, err = ocuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OAuthCodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ocuo.mutation = mutation
+			node, err = ocuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ocuo.hooks) - 1; i >= 0; i-- {
+			if ocuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ocuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ocuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocuo *OAuthCodeUpdateOne) SaveX(ctx context.Context) *OAuthCode {
+	node, err := ocuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ocuo *OAuthCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := ocuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocuo *OAuthCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := ocuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ocuo *OAuthCodeUpdateOne) sqlSave(ctx context.Context) (_node *OAuthCode, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   oauthcode.Table,
+			Columns: oauthcode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthcode.FieldID,
+			},
+		},
+	}
+	id, ok := ocuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ocuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthcode.FieldID)
+		for _, f := range fields {
+			if !oauthcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ocuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ocuo.mutation.ScopesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: oauthcode.FieldScopes,
+		})
+	}
+	if ocuo.mutation.CodeChallengeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: oauthcode.FieldCodeChallenge,
+		})
+	}
+	if ocuo.mutation.CodeChallengeMethodCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: oauthcode.FieldCodeChallengeMethod,
+		})
+	}
+	if ocuo.mutation.NonceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: oauthcode.FieldNonce,
+		})
+	}
+	_node = &OAuthCode{config: ocuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ocuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,188 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"usm/internal/data/ent/oauthtoken"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// OAuthToken is the model entity for the OAuthToken schema.
+type OAuthToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// Kind holds the value of the "kind" field.
+	Kind oauthtoken.Kind `json:"kind,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// ExpireTime holds the value of the "expire_time" field.
+	ExpireTime time.Time `json:"expire_time,omitempty"`
+	// Revoked holds the value of the "revoked" field.
+	Revoked bool `json:"revoked,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OAuthToken) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthtoken.FieldScopes:
+			values[i] = new([]byte)
+		case oauthtoken.FieldRevoked:
+			values[i] = new(sql.NullBool)
+		case oauthtoken.FieldID, oauthtoken.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case oauthtoken.FieldTokenHash, oauthtoken.FieldKind, oauthtoken.FieldClientID:
+			values[i] = new(sql.NullString)
+		case oauthtoken.FieldCreateTime, oauthtoken.FieldUpdateTime, oauthtoken.FieldExpireTime:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type OAuthToken", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OAuthToken fields.
+func (ot *OAuthToken) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthtoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ot.ID = int64(value.Int64)
+		case oauthtoken.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ot.CreateTime = value.Time
+			}
+		case oauthtoken.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ot.UpdateTime = value.Time
+			}
+		case oauthtoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				ot.TokenHash = value.String
+			}
+		case oauthtoken.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				ot.Kind = oauthtoken.Kind(value.String)
+			}
+		case oauthtoken.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				ot.ClientID = value.String
+			}
+		case oauthtoken.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ot.UserID = value.Int64
+			}
+		case oauthtoken.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ot.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthtoken.FieldExpireTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expire_time", values[i])
+			} else if value.Valid {
+				ot.ExpireTime = value.Time
+			}
+		case oauthtoken.FieldRevoked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked", values[i])
+			} else if value.Valid {
+				ot.Revoked = value.Bool
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this OAuthToken.
+// Note that you need to call OAuthToken.Unwrap() before calling this method if this OAuthToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ot *OAuthToken) Update() *OAuthTokenUpdateOne {
+	return (&OAuthTokenClient{config: ot.config}).UpdateOne(ot)
+}
+
+// Unwrap unwraps the OAuthToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ot *OAuthToken) Unwrap() *OAuthToken {
+	tx, ok := ot.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OAuthToken is not a transactional entity")
+	}
+	ot.config.driver = tx.drv
+	return ot
+}
+
+// String implements the fmt.Stringer.
+func (ot *OAuthToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("OAuthToken(")
+	builder.WriteString(fmt.Sprintf("id=%v", ot.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(ot.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", update_time=")
+	builder.WriteString(ot.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", token_hash=<sensitive>")
+	builder.WriteString(", kind=")
+	builder.WriteString(fmt.Sprintf("%v", ot.Kind))
+	builder.WriteString(", client_id=")
+	builder.WriteString(ot.ClientID)
+	builder.WriteString(", user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ot.UserID))
+	builder.WriteString(", scopes=")
+	builder.WriteString(fmt.Sprintf("%v", ot.Scopes))
+	builder.WriteString(", expire_time=")
+	builder.WriteString(ot.ExpireTime.Format(time.ANSIC))
+	builder.WriteString(", revoked=")
+	builder.WriteString(fmt.Sprintf("%v", ot.Revoked))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OAuthTokens is a parsable slice of OAuthToken.
+type OAuthTokens []*OAuthToken
+
+func (ot OAuthTokens) config(cfg config) {
+	for _i := range ot {
+		ot[_i].config = cfg
+	}
+}
@@ -0,0 +1,413 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"usm/internal/data/ent/oauthtoken"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthTokenCreate is the builder for creating a OAuthToken entity.
+type OAuthTokenCreate struct {
+	config
+	mutation *OAuthTokenMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (otc *OAuthTokenCreate) SetCreateTime(t time.Time) *OAuthTokenCreate {
+	otc.mutation.SetCreateTime(t)
+	return otc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (otc *OAuthTokenCreate) SetNillableCreateTime(t *time.Time) *OAuthTokenCreate {
+	if t != nil {
+		otc.SetCreateTime(*t)
+	}
+	return otc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (otc *OAuthTokenCreate) SetUpdateTime(t time.Time) *OAuthTokenCreate {
+	otc.mutation.SetUpdateTime(t)
+	return otc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (otc *OAuthTokenCreate) SetNillableUpdateTime(t *time.Time) *OAuthTokenCreate {
+	if t != nil {
+		otc.SetUpdateTime(*t)
+	}
+	return otc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (otc *OAuthTokenCreate) SetTokenHash(s string) *OAuthTokenCreate {
+	otc.mutation.SetTokenHash(s)
+	return otc
+}
+
+// SetKind sets the "kind" field.
+func (otc *OAuthTokenCreate) SetKind(o oauthtoken.Kind) *OAuthTokenCreate {
+	otc.mutation.SetKind(o)
+	return otc
+}
+
+// SetClientID sets the "client_id" field.
+func (otc *OAuthTokenCreate) SetClientID(s string) *OAuthTokenCreate {
+	otc.mutation.SetClientID(s)
+	return otc
+}
+
+// SetUserID sets the "user_id" field.
+func (otc *OAuthTokenCreate) SetUserID(i int64) *OAuthTokenCreate {
+	otc.mutation.SetUserID(i)
+	return otc
+}
+
+// SetScopes sets the "scopes" field.
+func (otc *OAuthTokenCreate) SetScopes(s []string) *OAuthTokenCreate {
+	otc.mutation.SetScopes(s)
+	return otc
+}
+
+// SetExpireTime sets the "expire_time" field.
+func (otc *OAuthTokenCreate) SetExpireTime(t time.Time) *OAuthTokenCreate {
+	otc.mutation.SetExpireTime(t)
+	return otc
+}
+
+// SetRevoked sets the "revoked" field.
+func (otc *OAuthTokenCreate) SetRevoked(b bool) *OAuthTokenCreate {
+	otc.mutation.SetRevoked(b)
+	return otc
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (otc *OAuthTokenCreate) SetNillableRevoked(b *bool) *OAuthTokenCreate {
+	if b != nil {
+		otc.SetRevoked(*b)
+	}
+	return otc
+}
+
+// SetID sets the "id" field.
+func (otc *OAuthTokenCreate) SetID(i int64) *OAuthTokenCreate {
+	otc.mutation.SetID(i)
+	return otc
+}
+
+// Mutation returns the OAuthTokenMutation object of the builder.
+func (otc *OAuthTokenCreate) Mutation() *OAuthTokenMutation {
+	return otc.mutation
+}
+
+// Save creates the OAuthToken in the database.
+func (otc *OAuthTokenCreate) Save(ctx context.Context) (*OAuthToken, error) {
+	var (
+		err  error
+		node *OAuthToken
+	)
+	otc.defaults()
+	if len(otc.hooks) == 0 {
+		if err = otc.check(); err != nil {
+			return nil, err
+		}
+		node, err = otc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OAuthTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = otc.check(); err != nil {
+				return nil, err
+			}
+			otc.mutation = mutation
+			if node, err = otc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(otc.hooks) - 1; i >= 0; i-- {
+			if otc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = otc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, otc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (otc *OAuthTokenCreate) SaveX(ctx context.Context) *OAuthToken {
+	v, err := otc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (otc *OAuthTokenCreate) Exec(ctx context.Context) error {
+	_, err := otc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otc *OAuthTokenCreate) ExecX(ctx context.Context) {
+	if err := otc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otc *OAuthTokenCreate) defaults() {
+	if _, ok := otc.mutation.CreateTime(); !ok {
+		v := oauthtoken.DefaultCreateTime()
+		otc.mutation.SetCreateTime(v)
+	}
+	if _, ok := otc.mutation.UpdateTime(); !ok {
+		v := oauthtoken.DefaultUpdateTime()
+		otc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := otc.mutation.Revoked(); !ok {
+		v := oauthtoken.DefaultRevoked
+		otc.mutation.SetRevoked(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otc *OAuthTokenCreate) check() error {
+	if _, ok := otc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OAuthToken.create_time"`)}
+	}
+	if _, ok := otc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "OAuthToken.update_time"`)}
+	}
+	if _, ok := otc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "OAuthToken.token_hash"`)}
+	}
+	if _, ok := otc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "OAuthToken.kind"`)}
+	}
+	if v, ok := otc.mutation.Kind(); ok {
+		if err := oauthtoken.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "OAuthToken.kind": %w`, err)}
+		}
+	}
+	if _, ok := otc.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OAuthToken.client_id"`)}
+	}
+	if _, ok := otc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OAuthToken.user_id"`)}
+	}
+	if _, ok := otc.mutation.ExpireTime(); !ok {
+		return &ValidationError{Name: "expire_time", err: errors.New(`ent: missing required field "OAuthToken.expire_time"`)}
+	}
+	if _, ok := otc.mutation.Revoked(); !ok {
+		return &ValidationError{Name: "revoked", err: errors.New(`ent: missing required field "OAuthToken.revoked"`)}
+	}
+	return nil
+}
+
+func (otc *OAuthTokenCreate) sqlSave(ctx context.Context) (*OAuthToken, error) {
+	_node, _spec := otc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, otc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (otc *OAuthTokenCreate) createSpec() (*OAuthToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthToken{config: otc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: oauthtoken.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthtoken.FieldID,
+			},
+		}
+	)
+	if id, ok := otc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := otc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: oauthtoken.FieldCreateTime,
+		})
+		_node.CreateTime = value
+	}
+	if value, ok := otc.mutation.UpdateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: oauthtoken.FieldUpdateTime,
+		})
+		_node.UpdateTime = value
+	}
+	if value, ok := otc.mutation.TokenHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthtoken.FieldTokenHash,
+		})
+		_node.TokenHash = value
+	}
+	if value, ok := otc.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: oauthtoken.FieldKind,
+		})
+		_node.Kind = value
+	}
+	if value, ok := otc.mutation.ClientID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauthtoken.FieldClientID,
+		})
+		_node.ClientID = value
+	}
+	if value, ok := otc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: oauthtoken.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := otc.mutation.Scopes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauthtoken.FieldScopes,
+		})
+		_node.Scopes = value
+	}
+	if value, ok := otc.mutation.ExpireTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: oauthtoken.FieldExpireTime,
+		})
+		_node.ExpireTime = value
+	}
+	if value, ok := otc.mutation.Revoked(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: oauthtoken.FieldRevoked,
+		})
+		_node.Revoked = value
+	}
+	return _node, _spec
+}
+
+// OAuthTokenCreateBulk is the builder for creating many OAuthToken entities in bulk.
+type OAuthTokenCreateBulk struct {
+	config
+	builders []*OAuthTokenCreate
+}
+
+// Save creates the OAuthToken entities in the database.
+func (otcb *OAuthTokenCreateBulk) Save(ctx context.Context) ([]*OAuthToken, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(otcb.builders))
+	nodes := make([]*OAuthToken, len(otcb.builders))
+	mutators := make([]Mutator, len(otcb.builders))
+	for i := range otcb.builders {
+		func(i int, root context.Context) {
+			builder := otcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, otcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, otcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, otcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otcb *OAuthTokenCreateBulk) SaveX(ctx context.Context) []*OAuthToken {
+	v, err := otcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (otcb *OAuthTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := otcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otcb *OAuthTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := otcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"usm/internal/data/ent/oauthtoken"
+	"usm/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthTokenDelete is the builder for deleting a OAuthToken entity.
+type OAuthTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *OAuthTokenMutation
+}
+
+// Where appends a list predicates to the OAuthTokenDelete builder.
+func (otd *OAuthTokenDelete) Where(ps ...predicate.OAuthToken) *OAuthTokenDelete {
+	otd.mutation.Where(ps...)
+	return otd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (otd *OAuthTokenDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(otd.hooks) == 0 {
+		affected, err = otd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OAuthTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			otd.mutation = mutation
+			affected, err = otd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(otd.hooks) - 1; i >= 0; i-- {
+			if otd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = otd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, otd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otd *OAuthTokenDelete) ExecX(ctx context.Context) int {
+	n, err := otd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (otd *OAuthTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: oauthtoken.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthtoken.FieldID,
+			},
+		},
+	}
+	if ps := otd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, otd.driver, _spec)
+}
+
+// OAuthTokenDeleteOne is the builder for deleting a single OAuthToken entity.
+type OAuthTokenDeleteOne struct {
+	otd *OAuthTokenDelete
+}
+
+// Exec executes the deletion query.
+func (otdo *OAuthTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := otdo.otd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{oauthtoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otdo *OAuthTokenDeleteOne) ExecX(ctx context.Context) {
+	otdo.otd.ExecX(ctx)
+}
@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"usm/internal/data/ent/oauthtoken"
+	"usm/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthTokenQuery is the builder for querying OAuthToken entities.
+type OAuthTokenQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.OAuthToken
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OAuthTokenQuery builder.
+func (otq *OAuthTokenQuery) Where(ps ...predicate.OAuthToken) *OAuthTokenQuery {
+	otq.predicates = append(otq.predicates, ps...)
+	return otq
+}
+
+// Limit adds a limit step to the query.
+func (otq *OAuthTokenQuery) Limit(limit int) *OAuthTokenQuery {
+	otq.limit = &limit
+	return otq
+}
+
+// Offset adds an offset step to the query.
+func (otq *OAuthTokenQuery) Offset(offset int) *OAuthTokenQuery {
+	otq.offset = &offset
+	return otq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (otq *OAuthTokenQuery) Unique(unique bool) *OAuthTokenQuery {
+	otq.unique = &unique
+	return otq
+}
+
+// Order adds an order step to the query.
+func (otq *OAuthTokenQuery) Order(o ...OrderFunc) *OAuthTokenQuery {
+	otq.order = append(otq.order, o...)
+	return otq
+}
+
+// First returns the first OAuthToken entity from the query.
+// Returns a *NotFoundError when no OAuthToken was found.
+func (otq *OAuthTokenQuery) First(ctx context.Context) (*OAuthToken, error) {
+	nodes, err := otq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{oauthtoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (otq *OAuthTokenQuery) FirstX(ctx context.Context) *OAuthToken {
+	node, err := otq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OAuthToken ID from the query.
+// Returns a *NotFoundError when no OAuthToken ID was found.
+func (otq *OAuthTokenQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = otq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{oauthtoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (otq *OAuthTokenQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := otq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OAuthToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OAuthToken entity is found.
+// Returns a *NotFoundError when no OAuthToken entities are found.
+func (otq *OAuthTokenQuery) Only(ctx context.Context) (*OAuthToken, error) {
+	nodes, err := otq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{oauthtoken.Label}
+	default:
+		return nil, &NotSingularError{oauthtoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (otq *OAuthTokenQuery) OnlyX(ctx context.Context) *OAuthToken {
+	node, err := otq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OAuthToken ID in the query.
+// Returns a *NotSingularError when more than one OAuthToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (otq *OAuthTokenQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = otq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{oauthtoken.Label}
+	default:
+		err = &NotSingularError{oauthtoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (otq *OAuthTokenQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := otq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OAuthTokens.
+func (otq *OAuthTokenQuery) All(ctx context.Context) ([]*OAuthToken, error) {
+	if err := otq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return otq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (otq *OAuthTokenQuery) AllX(ctx context.Context) []*OAuthToken {
+	nodes, err := otq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OAuthToken IDs.
+func (otq *OAuthTokenQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := otq.Select(oauthtoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (otq *OAuthTokenQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := otq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (otq *OAuthTokenQuery) Count(ctx context.Context) (int, error) {
+	if err := otq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return otq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (otq *OAuthTokenQuery) CountX(ctx context.Context) int {
+	count, err := otq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (otq *OAuthTokenQuery) Exist(ctx context.Context) (bool, error) {
+	if err := otq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return otq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (otq *OAuthTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := otq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OAuthTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (otq *OAuthTokenQuery) Clone() *OAuthTokenQuery {
+	if otq == nil {
+		return nil
+	}
+	return &OAuthTokenQuery{
+		config:     otq.config,
+		limit:      otq.limit,
+		offset:     otq.offset,
+		order:      append([]OrderFunc{}, otq.order...),
+		predicates: append([]predicate.OAuthToken{}, otq.predicates...),
+		// clone intermediate query.
+		sql:    otq.sql.Clone(),
+		path:   otq.path,
+		unique: otq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OAuthToken.Query().
+//		GroupBy(oauthtoken.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (otq *OAuthTokenQuery) GroupBy(field string, fields ...string) *OAuthTokenGroupBy {
+	group := &OAuthTokenGroupBy{config: otq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := otq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return otq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.OAuthToken.Query().
+//		Select(oauthtoken.FieldCreateTime).
+//		Scan(ctx, &v)
+//
+func (otq *OAuthTokenQuery) Select(fields ...string) *OAuthTokenSelect {
+	otq.fields = append(otq.fields, fields...)
+	return &OAuthTokenSelect{OAuthTokenQuery: otq}
+}
+
+func (otq *OAuthTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range otq.fields {
+		if !oauthtoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if otq.path != nil {
+		prev, err := otq.path(ctx)
+		if err != nil {
+			return err
+		}
+		otq.sql = prev
+	}
+	return nil
+}
+
+func (otq *OAuthTokenQuery) sqlAll(ctx context.Context) ([]*OAuthToken, error) {
+	var (
+		nodes = []*OAuthToken{}
+		_spec = otq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &OAuthToken{config: otq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, otq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (otq *OAuthTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := otq.querySpec()
+	_spec.Node.Columns = otq.fields
+	if len(otq.fields) > 0 {
+		_spec.Unique = otq.unique != nil && *otq.unique
+	}
+	return sqlgraph.CountNodes(ctx, otq.driver, _spec)
+}
+
+func (otq *OAuthTokenQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := otq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (otq *OAuthTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   oauthtoken.Table,
+			Columns: oauthtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthtoken.FieldID,
+			},
+		},
+		From:   otq.sql,
+		Unique: true,
+	}
+	if unique := otq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := otq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthtoken.FieldID)
+		for i := range fields {
+			if fields[i] != oauthtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := otq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := otq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := otq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := otq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (otq *OAuthTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(otq.driver.Dialect())
+	t1 := builder.Table(oauthtoken.Table)
+	columns := otq.fields
+	if len(columns) == 0 {
+		columns = oauthtoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if otq.sql != nil {
+		selector = otq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if otq.unique != nil && *otq.unique {
+		selector.Distinct()
+	}
+	for _, p := range otq.predicates {
+		p(selector)
+	}
+	for _, p := range otq.order {
+		p(selector)
+	}
+	if offset := otq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := otq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OAuthTokenGroupBy is the group-by builder for OAuthToken entities.
+type OAuthTokenGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (otgb *OAuthTokenGroupBy) Aggregate(fns ...AggregateFunc) *OAuthTokenGroupBy {
+	otgb.fns = append(otgb.fns, fns...)
+	return otgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (otgb *OAuthTokenGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := otgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	otgb.sql = query
+	return otgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (otgb *OAuthTokenGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := otgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (otgb *OAuthTokenGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(otgb.fields) > 1 {
+		return nil, errors.New("ent: OAuthTokenGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := otgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (otgb *OAuthTokenGroupBy) StringsX(ctx context.Context) []string {
+	v, err := otgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (otgb *OAuthTokenGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = otgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthtoken.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthTokenGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (otgb *OAuthTokenGroupBy) StringX(ctx context.Context) string {
+	v, err := otgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (otgb *OAuthTokenGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(otgb.fields) > 1 {
+		return nil, errors.New("ent: OAuthTokenGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := otgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (otgb *OAuthTokenGroupBy) IntsX(ctx context.Context) []int {
+	v, err := otgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (otgb *OAuthTokenGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = otgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthtoken.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthTokenGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (otgb *OAuthTokenGroupBy) IntX(ctx context.Context) int {
+	v, err := otgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (otgb *OAuthTokenGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(otgb.fields) > 1 {
+		return nil, errors.New("ent: OAuthTokenGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := otgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (otgb *OAuthTokenGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := otgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (otgb *OAuthTokenGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = otgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthtoken.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthTokenGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (otgb *OAuthTokenGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := otgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (otgb *OAuthTokenGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(otgb.fields) > 1 {
+		return nil, errors.New("ent: OAuthTokenGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := otgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (otgb *OAuthTokenGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := otgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (otgb *OAuthTokenGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = otgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthtoken.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthTokenGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (otgb *OAuthTokenGroupBy) BoolX(ctx context.Context) bool {
+	v, err := otgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (otgb *OAuthTokenGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range otgb.fields {
+		if !oauthtoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := otgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := otgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (otgb *OAuthTokenGroupBy) sqlQuery() *sql.Selector {
+	selector := otgb.sql.Select()
+	aggregation := make([]string, 0, len(otgb.fns))
+	for _, fn := range otgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(otgb.fields)+len(otgb.fns))
+		for _, f := range otgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(otgb.fields...)...)
+}
+
+// OAuthTokenSelect is the builder for selecting fields of OAuthToken entities.
+type OAuthTokenSelect struct {
+	*OAuthTokenQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ots *OAuthTokenSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ots.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ots.sql = ots.OAuthTokenQuery.sqlQuery(ctx)
+	return ots.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ots *OAuthTokenSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ots.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ots *OAuthTokenSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ots.fields) > 1 {
+		return nil, errors.New("ent: OAuthTokenSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ots.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ots *OAuthTokenSelect) StringsX(ctx context.Context) []string {
+	v, err := ots.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ots *OAuthTokenSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ots.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthtoken.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthTokenSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ots *OAuthTokenSelect) StringX(ctx context.Context) string {
+	v, err := ots.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ots *OAuthTokenSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ots.fields) > 1 {
+		return nil, errors.New("ent: OAuthTokenSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ots.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ots *OAuthTokenSelect) IntsX(ctx context.Context) []int {
+	v, err := ots.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ots *OAuthTokenSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ots.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthtoken.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthTokenSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ots *OAuthTokenSelect) IntX(ctx context.Context) int {
+	v, err := ots.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ots *OAuthTokenSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ots.fields) > 1 {
+		return nil, errors.New("ent: OAuthTokenSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ots.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ots *OAuthTokenSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ots.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ots *OAuthTokenSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ots.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthtoken.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthTokenSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ots *OAuthTokenSelect) Float64X(ctx context.Context) float64 {
+	v, err := ots.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ots *OAuthTokenSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ots.fields) > 1 {
+		return nil, errors.New("ent: OAuthTokenSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ots.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ots *OAuthTokenSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ots.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ots *OAuthTokenSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ots.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{oauthtoken.Label}
+	default:
+		err = fmt.Errorf("ent: OAuthTokenSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ots *OAuthTokenSelect) BoolX(ctx context.Context) bool {
+	v, err := ots.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ots *OAuthTokenSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ots.sql.Query()
+	if err := ots.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,338 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"usm/internal/data/ent/oauthtoken"
+	"usm/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthTokenUpdate is the builder for updating OAuthToken entities.
+type OAuthTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OAuthTokenMutation
+}
+
+// Where appends a list predicates to the OAuthTokenUpdate builder.
+func (otu *OAuthTokenUpdate) Where(ps ...predicate.OAuthToken) *OAuthTokenUpdate {
+	otu.mutation.Where(ps...)
+	return otu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (otu *OAuthTokenUpdate) SetUpdateTime(t time.Time) *OAuthTokenUpdate {
+	otu.mutation.SetUpdateTime(t)
+	return otu
+}
+
+// SetRevoked sets the "revoked" field.
+func (otu *OAuthTokenUpdate) SetRevoked(b bool) *OAuthTokenUpdate {
+	otu.mutation.SetRevoked(b)
+	return otu
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (otu *OAuthTokenUpdate) SetNillableRevoked(b *bool) *OAuthTokenUpdate {
+	if b != nil {
+		otu.SetRevoked(*b)
+	}
+	return otu
+}
+
+// Mutation returns the OAuthTokenMutation object of the builder.
+func (otu *OAuthTokenUpdate) Mutation() *OAuthTokenMutation {
+	return otu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (otu *OAuthTokenUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	otu.defaults()
+	if len(otu.hooks) == 0 {
+		affected, err = otu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OAuthTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			otu.mutation = mutation
+			affected, err = otu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(otu.hooks) - 1; i >= 0; i-- {
+			if otu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = otu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, otu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otu *OAuthTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := otu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (otu *OAuthTokenUpdate) Exec(ctx context.Context) error {
+	_, err := otu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otu *OAuthTokenUpdate) ExecX(ctx context.Context) {
+	if err := otu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otu *OAuthTokenUpdate) defaults() {
+	if _, ok := otu.mutation.UpdateTime(); !ok {
+		v := oauthtoken.UpdateDefaultUpdateTime()
+		otu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (otu *OAuthTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   oauthtoken.Table,
+			Columns: oauthtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthtoken.FieldID,
+			},
+		},
+	}
+	if ps := otu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := otu.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: oauthtoken.FieldUpdateTime,
+		})
+	}
+	if otu.mutation.ScopesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: oauthtoken.FieldScopes,
+		})
+	}
+	if value, ok := otu.mutation.Revoked(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: oauthtoken.FieldRevoked,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, otu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// OAuthTokenUpdateOne is the builder for updating a single OAuthToken entity.
+type OAuthTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OAuthTokenMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (otuo *OAuthTokenUpdateOne) SetUpdateTime(t time.Time) *OAuthTokenUpdateOne {
+	otuo.mutation.SetUpdateTime(t)
+	return otuo
+}
+
+// SetRevoked sets the "revoked" field.
+func (otuo *OAuthTokenUpdateOne) SetRevoked(b bool) *OAuthTokenUpdateOne {
+	otuo.mutation.SetRevoked(b)
+	return otuo
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (otuo *OAuthTokenUpdateOne) SetNillableRevoked(b *bool) *OAuthTokenUpdateOne {
+	if b != nil {
+		otuo.SetRevoked(*b)
+	}
+	return otuo
+}
+
+// Mutation returns the OAuthTokenMutation object of the builder.
+func (otuo *OAuthTokenUpdateOne) Mutation() *OAuthTokenMutation {
+	return otuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (otuo *OAuthTokenUpdateOne) Select(field string, fields ...string) *OAuthTokenUpdateOne {
+	otuo.fields = append([]string{field}, fields...)
+	return otuo
+}
+
+// Save executes the query and returns the updated OAuthToken entity.
+func (otuo *OAuthTokenUpdateOne) Save(ctx context.Context) (*OAuthToken, error) {
+	var (
+		err  error
+		node *OAuthToken
+	)
+	otuo.defaults()
+	if len(otuo.hooks) == 0 {
+		node, err = otuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OAuthTokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			otuo.mutation = mutation
+			node, err = otuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(otuo.hooks) - 1; i >= 0; i-- {
+			if otuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = otuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, otuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otuo *OAuthTokenUpdateOne) SaveX(ctx context.Context) *OAuthToken {
+	node, err := otuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (otuo *OAuthTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := otuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otuo *OAuthTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := otuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otuo *OAuthTokenUpdateOne) defaults() {
+	if _, ok := otuo.mutation.UpdateTime(); !ok {
+		v := oauthtoken.UpdateDefaultUpdateTime()
+		otuo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (otuo *OAuthTokenUpdateOne) sqlSave(ctx context.Context) (_node *OAuthToken, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   oauthtoken.Table,
+			Columns: oauthtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: oauthtoken.FieldID,
+			},
+		},
+	}
+	id, ok := otuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := otuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthtoken.FieldID)
+		for _, f := range fields {
+			if !oauthtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := otuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := otuo.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: oauthtoken.FieldUpdateTime,
+		})
+	}
+	if otuo.mutation.ScopesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: oauthtoken.FieldScopes,
+		})
+	}
+	if value, ok := otuo.mutation.Revoked(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: oauthtoken.FieldRevoked,
+		})
+	}
+	_node = &OAuthToken{config: otuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, otuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
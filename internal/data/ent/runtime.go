@@ -4,7 +4,16 @@ package ent
 
 import (
 	"time"
+	"usm/internal/data/ent/apikey"
+	"usm/internal/data/ent/emailverification"
+	"usm/internal/data/ent/group"
+	"usm/internal/data/ent/invitation"
+	"usm/internal/data/ent/oauthclient"
+	"usm/internal/data/ent/oauthcode"
+	"usm/internal/data/ent/oauthtoken"
+	"usm/internal/data/ent/passwordreset"
 	"usm/internal/data/ent/schema"
+	"usm/internal/data/ent/signingkey"
 	"usm/internal/data/ent/user"
 )
 
@@ -12,6 +21,131 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyMixin := schema.APIKey{}.Mixin()
+	apikeyMixinFields0 := apikeyMixin[0].Fields()
+	_ = apikeyMixinFields0
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreateTime is the schema descriptor for create_time field.
+	apikeyDescCreateTime := apikeyMixinFields0[0].Descriptor()
+	// apikey.DefaultCreateTime holds the default value on creation for the create_time field.
+	apikey.DefaultCreateTime = apikeyDescCreateTime.Default.(func() time.Time)
+	// apikeyDescUpdateTime is the schema descriptor for update_time field.
+	apikeyDescUpdateTime := apikeyMixinFields0[1].Descriptor()
+	// apikey.DefaultUpdateTime holds the default value on creation for the update_time field.
+	apikey.DefaultUpdateTime = apikeyDescUpdateTime.Default.(func() time.Time)
+	// apikey.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	apikey.UpdateDefaultUpdateTime = apikeyDescUpdateTime.UpdateDefault.(func() time.Time)
+	emailverificationMixin := schema.EmailVerification{}.Mixin()
+	emailverificationMixinFields0 := emailverificationMixin[0].Fields()
+	_ = emailverificationMixinFields0
+	emailverificationFields := schema.EmailVerification{}.Fields()
+	_ = emailverificationFields
+	// emailverificationDescCreateTime is the schema descriptor for create_time field.
+	emailverificationDescCreateTime := emailverificationMixinFields0[0].Descriptor()
+	// emailverification.DefaultCreateTime holds the default value on creation for the create_time field.
+	emailverification.DefaultCreateTime = emailverificationDescCreateTime.Default.(func() time.Time)
+	groupMixin := schema.Group{}.Mixin()
+	groupMixinFields0 := groupMixin[0].Fields()
+	_ = groupMixinFields0
+	groupFields := schema.Group{}.Fields()
+	_ = groupFields
+	// groupDescCreateTime is the schema descriptor for create_time field.
+	groupDescCreateTime := groupMixinFields0[0].Descriptor()
+	// group.DefaultCreateTime holds the default value on creation for the create_time field.
+	group.DefaultCreateTime = groupDescCreateTime.Default.(func() time.Time)
+	// groupDescUpdateTime is the schema descriptor for update_time field.
+	groupDescUpdateTime := groupMixinFields0[1].Descriptor()
+	// group.DefaultUpdateTime holds the default value on creation for the update_time field.
+	group.DefaultUpdateTime = groupDescUpdateTime.Default.(func() time.Time)
+	// group.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	group.UpdateDefaultUpdateTime = groupDescUpdateTime.UpdateDefault.(func() time.Time)
+	invitationMixin := schema.Invitation{}.Mixin()
+	invitationMixinFields0 := invitationMixin[0].Fields()
+	_ = invitationMixinFields0
+	invitationFields := schema.Invitation{}.Fields()
+	_ = invitationFields
+	// invitationDescCreateTime is the schema descriptor for create_time field.
+	invitationDescCreateTime := invitationMixinFields0[0].Descriptor()
+	// invitation.DefaultCreateTime holds the default value on creation for the create_time field.
+	invitation.DefaultCreateTime = invitationDescCreateTime.Default.(func() time.Time)
+	// invitationDescUpdateTime is the schema descriptor for update_time field.
+	invitationDescUpdateTime := invitationMixinFields0[1].Descriptor()
+	// invitation.DefaultUpdateTime holds the default value on creation for the update_time field.
+	invitation.DefaultUpdateTime = invitationDescUpdateTime.Default.(func() time.Time)
+	// invitation.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	invitation.UpdateDefaultUpdateTime = invitationDescUpdateTime.UpdateDefault.(func() time.Time)
+	oauthclientMixin := schema.OAuthClient{}.Mixin()
+	oauthclientMixinFields0 := oauthclientMixin[0].Fields()
+	_ = oauthclientMixinFields0
+	oauthclientFields := schema.OAuthClient{}.Fields()
+	_ = oauthclientFields
+	// oauthclientDescCreateTime is the schema descriptor for create_time field.
+	oauthclientDescCreateTime := oauthclientMixinFields0[0].Descriptor()
+	// oauthclient.DefaultCreateTime holds the default value on creation for the create_time field.
+	oauthclient.DefaultCreateTime = oauthclientDescCreateTime.Default.(func() time.Time)
+	// oauthclientDescUpdateTime is the schema descriptor for update_time field.
+	oauthclientDescUpdateTime := oauthclientMixinFields0[1].Descriptor()
+	// oauthclient.DefaultUpdateTime holds the default value on creation for the update_time field.
+	oauthclient.DefaultUpdateTime = oauthclientDescUpdateTime.Default.(func() time.Time)
+	// oauthclient.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	oauthclient.UpdateDefaultUpdateTime = oauthclientDescUpdateTime.UpdateDefault.(func() time.Time)
+	// oauthclientDescPublic is the schema descriptor for public field.
+	oauthclientDescPublic := oauthclientFields[7].Descriptor()
+	// oauthclient.DefaultPublic holds the default value on creation for the public field.
+	oauthclient.DefaultPublic = oauthclientDescPublic.Default.(bool)
+	oauthcodeMixin := schema.OAuthCode{}.Mixin()
+	oauthcodeMixinFields0 := oauthcodeMixin[0].Fields()
+	_ = oauthcodeMixinFields0
+	oauthcodeFields := schema.OAuthCode{}.Fields()
+	_ = oauthcodeFields
+	// oauthcodeDescCreateTime is the schema descriptor for create_time field.
+	oauthcodeDescCreateTime := oauthcodeMixinFields0[0].Descriptor()
+	// oauthcode.DefaultCreateTime holds the default value on creation for the create_time field.
+	oauthcode.DefaultCreateTime = oauthcodeDescCreateTime.Default.(func() time.Time)
+	oauthtokenMixin := schema.OAuthToken{}.Mixin()
+	oauthtokenMixinFields0 := oauthtokenMixin[0].Fields()
+	_ = oauthtokenMixinFields0
+	oauthtokenFields := schema.OAuthToken{}.Fields()
+	_ = oauthtokenFields
+	// oauthtokenDescCreateTime is the schema descriptor for create_time field.
+	oauthtokenDescCreateTime := oauthtokenMixinFields0[0].Descriptor()
+	// oauthtoken.DefaultCreateTime holds the default value on creation for the create_time field.
+	oauthtoken.DefaultCreateTime = oauthtokenDescCreateTime.Default.(func() time.Time)
+	// oauthtokenDescUpdateTime is the schema descriptor for update_time field.
+	oauthtokenDescUpdateTime := oauthtokenMixinFields0[1].Descriptor()
+	// oauthtoken.DefaultUpdateTime holds the default value on creation for the update_time field.
+	oauthtoken.DefaultUpdateTime = oauthtokenDescUpdateTime.Default.(func() time.Time)
+	// oauthtoken.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	oauthtoken.UpdateDefaultUpdateTime = oauthtokenDescUpdateTime.UpdateDefault.(func() time.Time)
+	// oauthtokenDescRevoked is the schema descriptor for revoked field.
+	oauthtokenDescRevoked := oauthtokenFields[7].Descriptor()
+	// oauthtoken.DefaultRevoked holds the default value on creation for the revoked field.
+	oauthtoken.DefaultRevoked = oauthtokenDescRevoked.Default.(bool)
+	passwordresetMixin := schema.PasswordReset{}.Mixin()
+	passwordresetMixinFields0 := passwordresetMixin[0].Fields()
+	_ = passwordresetMixinFields0
+	passwordresetFields := schema.PasswordReset{}.Fields()
+	_ = passwordresetFields
+	// passwordresetDescCreateTime is the schema descriptor for create_time field.
+	passwordresetDescCreateTime := passwordresetMixinFields0[0].Descriptor()
+	// passwordreset.DefaultCreateTime holds the default value on creation for the create_time field.
+	passwordreset.DefaultCreateTime = passwordresetDescCreateTime.Default.(func() time.Time)
+	signingkeyMixin := schema.SigningKey{}.Mixin()
+	signingkeyMixinFields0 := signingkeyMixin[0].Fields()
+	_ = signingkeyMixinFields0
+	signingkeyFields := schema.SigningKey{}.Fields()
+	_ = signingkeyFields
+	// signingkeyDescCreateTime is the schema descriptor for create_time field.
+	signingkeyDescCreateTime := signingkeyMixinFields0[0].Descriptor()
+	// signingkey.DefaultCreateTime holds the default value on creation for the create_time field.
+	signingkey.DefaultCreateTime = signingkeyDescCreateTime.Default.(func() time.Time)
+	// signingkeyDescUpdateTime is the schema descriptor for update_time field.
+	signingkeyDescUpdateTime := signingkeyMixinFields0[1].Descriptor()
+	// signingkey.DefaultUpdateTime holds the default value on creation for the update_time field.
+	signingkey.DefaultUpdateTime = signingkeyDescUpdateTime.Default.(func() time.Time)
+	// signingkey.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	signingkey.UpdateDefaultUpdateTime = signingkeyDescUpdateTime.UpdateDefault.(func() time.Time)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -27,8 +161,16 @@ func init() {
 	user.DefaultUpdateTime = userDescUpdateTime.Default.(func() time.Time)
 	// user.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	user.UpdateDefaultUpdateTime = userDescUpdateTime.UpdateDefault.(func() time.Time)
+	// userDescEmailVerified is the schema descriptor for email_verified field.
+	userDescEmailVerified := userFields[5].Descriptor()
+	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
 	// userDescDisabled is the schema descriptor for disabled field.
-	userDescDisabled := userFields[4].Descriptor()
+	userDescDisabled := userFields[7].Descriptor()
 	// user.DefaultDisabled holds the default value on creation for the disabled field.
 	user.DefaultDisabled = userDescDisabled.Default.(bool)
+	// userDescSource is the schema descriptor for source field.
+	userDescSource := userFields[11].Descriptor()
+	// user.DefaultSource holds the default value on creation for the source field.
+	user.DefaultSource = userDescSource.Default.(string)
 }
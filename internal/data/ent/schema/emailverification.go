@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// EmailVerification holds the schema definition for the EmailVerification entity.
+type EmailVerification struct {
+	ent.Schema
+}
+
+// Fields of the EmailVerification.
+func (EmailVerification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("user_id").Immutable(),
+		// 发送验证邮件时的邮箱，用户修改邮箱后令牌失效
+		field.String("email").Immutable(),
+		field.String("token_hash").Unique().Sensitive().Immutable(),
+		field.Time("expire_time").Immutable(),
+	}
+}
+
+func (EmailVerification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+func (EmailVerification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+// Edges of the EmailVerification.
+func (EmailVerification) Edges() []ent.Edge {
+	return nil
+}
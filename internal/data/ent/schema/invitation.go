@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Invitation holds the schema definition for the Invitation entity.
+type Invitation struct {
+	ent.Schema
+}
+
+// Fields of the Invitation.
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("email").Immutable(),
+		field.String("token_hash").Unique().Sensitive().Immutable(),
+		// 接受邀请后加入的用户组
+		field.Ints("group_ids").Optional().Immutable(),
+		field.Enum("status").Values("pending", "accepted", "revoked").Default("pending"),
+		field.Time("expire_time").Immutable(),
+		// 接受邀请后创建的用户
+		field.Int64("user_id").Optional(),
+		field.Time("accept_time").Optional(),
+	}
+}
+
+func (Invitation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (Invitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email"),
+	}
+}
+
+// Edges of the Invitation.
+func (Invitation) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// OAuthClient holds the schema definition for the OAuthClient entity.
+type OAuthClient struct {
+	ent.Schema
+}
+
+// Fields of the OAuthClient.
+func (OAuthClient) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("client_id").Unique().Immutable(),
+		field.String("secret_hash").Sensitive().Optional(),
+		field.String("name"),
+		field.Strings("redirect_uris").Optional(),
+		field.Strings("grant_types").Optional(),
+		field.Strings("scopes").Optional(),
+		field.Bool("public").Default(false),
+		field.Int64("service_account_id").Optional(),
+	}
+}
+
+func (OAuthClient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Edges of the OAuthClient.
+func (OAuthClient) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// OAuthCode holds the schema definition for the OAuthCode entity.
+type OAuthCode struct {
+	ent.Schema
+}
+
+// Fields of the OAuthCode.
+func (OAuthCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("code_hash").Unique().Sensitive().Immutable(),
+		field.String("client_id").Immutable(),
+		field.Int64("user_id").Immutable(),
+		field.String("redirect_uri").Immutable(),
+		field.Strings("scopes").Optional().Immutable(),
+		field.String("code_challenge").Optional().Immutable(),
+		field.String("code_challenge_method").Optional().Immutable(),
+		field.String("nonce").Optional().Immutable(),
+		field.Time("expire_time").Immutable(),
+	}
+}
+
+func (OAuthCode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+// Edges of the OAuthCode.
+func (OAuthCode) Edges() []ent.Edge {
+	return nil
+}
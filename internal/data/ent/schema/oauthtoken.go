@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// OAuthToken holds the schema definition for the OAuthToken entity.
+type OAuthToken struct {
+	ent.Schema
+}
+
+// Fields of the OAuthToken.
+func (OAuthToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("token_hash").Unique().Sensitive().Immutable(),
+		field.Enum("kind").Values("access", "refresh").Immutable(),
+		field.String("client_id").Immutable(),
+		field.Int64("user_id").Immutable(),
+		field.Strings("scopes").Optional().Immutable(),
+		field.Time("expire_time").Immutable(),
+		field.Bool("revoked").Default(false),
+	}
+}
+
+func (OAuthToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Edges of the OAuthToken.
+func (OAuthToken) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the OAuthToken.
+func (OAuthToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SigningKey holds the schema definition for the SigningKey entity.
+type SigningKey struct {
+	ent.Schema
+}
+
+// Fields of the SigningKey.
+func (SigningKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("kid").Unique().Immutable(),
+		field.String("algorithm").Immutable(),
+		// PEM 编码的私钥
+		field.Text("private_key").Sensitive().Immutable(),
+		field.Enum("status").Values("pending", "active", "retired").Default("pending"),
+		field.Time("activate_time").Optional(),
+		field.Time("retire_time").Optional(),
+	}
+}
+
+func (SigningKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Edges of the SigningKey.
+func (SigningKey) Edges() []ent.Edge {
+	return nil
+}
@@ -2,6 +2,8 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/mixin"
 )
@@ -16,9 +18,28 @@ func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("id"),
 		field.String("username").Unique(),
+		// 规范化（NFKC + 大小写折叠）后的用户名和邮箱，用于唯一性约束和查询
+		// 允许为空以便升级时为已有数据补全，见 data.migrateUserKeys
+		field.String("username_key").Optional().Nillable().Unique(),
 		field.String("email").Optional(),
+		field.String("email_key").Optional().Nillable().Unique(),
+		field.Bool("email_verified").Default(false),
 		field.String("password"),
 		field.Bool("disabled").Default(false),
+		field.Enum("kind").Values("human", "service").Default("human").Immutable(),
+		field.Enum("owner_kind").Values("user", "group").Optional(),
+		field.Int64("owner_id").Optional(),
+		// 认证来源，local 表示本地密码，其他值为外部认证源名称，例如 ldap
+		field.String("source").Default("local").Immutable(),
+		// 外部系统中的 ID，由 SCIM 客户端维护
+		field.String("external_id").Optional(),
+		field.String("display_name").Optional(),
+		field.String("phone").Optional(),
+		field.String("avatar_url").Optional(),
+		field.String("locale").Optional(),
+		field.String("timezone").Optional(),
+		// 自定义属性，允许的属性名和类型在配置中声明
+		field.JSON("attributes", map[string]interface{}{}).Optional(),
 	}
 }
 
@@ -30,5 +51,9 @@ func (User) Mixin() []ent.Mixin {
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("api_keys", APIKey.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.From("groups", Group.Type).Ref("users"),
+	}
 }
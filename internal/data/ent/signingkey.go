@@ -0,0 +1,171 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"usm/internal/data/ent/signingkey"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// SigningKey is the model entity for the SigningKey schema.
+type SigningKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Kid holds the value of the "kid" field.
+	Kid string `json:"kid,omitempty"`
+	// Algorithm holds the value of the "algorithm" field.
+	Algorithm string `json:"algorithm,omitempty"`
+	// PrivateKey holds the value of the "private_key" field.
+	PrivateKey string `json:"-"`
+	// Status holds the value of the "status" field.
+	Status signingkey.Status `json:"status,omitempty"`
+	// ActivateTime holds the value of the "activate_time" field.
+	ActivateTime time.Time `json:"activate_time,omitempty"`
+	// RetireTime holds the value of the "retire_time" field.
+	RetireTime time.Time `json:"retire_time,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SigningKey) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case signingkey.FieldID:
+			values[i] = new(sql.NullInt64)
+		case signingkey.FieldKid, signingkey.FieldAlgorithm, signingkey.FieldPrivateKey, signingkey.FieldStatus:
+			values[i] = new(sql.NullString)
+		case signingkey.FieldCreateTime, signingkey.FieldUpdateTime, signingkey.FieldActivateTime, signingkey.FieldRetireTime:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type SigningKey", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SigningKey fields.
+func (sk *SigningKey) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case signingkey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sk.ID = int64(value.Int64)
+		case signingkey.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				sk.CreateTime = value.Time
+			}
+		case signingkey.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				sk.UpdateTime = value.Time
+			}
+		case signingkey.FieldKid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kid", values[i])
+			} else if value.Valid {
+				sk.Kid = value.String
+			}
+		case signingkey.FieldAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field algorithm", values[i])
+			} else if value.Valid {
+				sk.Algorithm = value.String
+			}
+		case signingkey.FieldPrivateKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_key", values[i])
+			} else if value.Valid {
+				sk.PrivateKey = value.String
+			}
+		case signingkey.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sk.Status = signingkey.Status(value.String)
+			}
+		case signingkey.FieldActivateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field activate_time", values[i])
+			} else if value.Valid {
+				sk.ActivateTime = value.Time
+			}
+		case signingkey.FieldRetireTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field retire_time", values[i])
+			} else if value.Valid {
+				sk.RetireTime = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this SigningKey.
+// Note that you need to call SigningKey.Unwrap() before calling this method if this SigningKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sk *SigningKey) Update() *SigningKeyUpdateOne {
+	return (&SigningKeyClient{config: sk.config}).UpdateOne(sk)
+}
+
+// Unwrap unwraps the SigningKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sk *SigningKey) Unwrap() *SigningKey {
+	tx, ok := sk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SigningKey is not a transactional entity")
+	}
+	sk.config.driver = tx.drv
+	return sk
+}
+
+// String implements the fmt.Stringer.
+func (sk *SigningKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("SigningKey(")
+	builder.WriteString(fmt.Sprintf("id=%v", sk.ID))
+	builder.WriteString(", create_time=")
+	builder.WriteString(sk.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", update_time=")
+	builder.WriteString(sk.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", kid=")
+	builder.WriteString(sk.Kid)
+	builder.WriteString(", algorithm=")
+	builder.WriteString(sk.Algorithm)
+	builder.WriteString(", private_key=<sensitive>")
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", sk.Status))
+	builder.WriteString(", activate_time=")
+	builder.WriteString(sk.ActivateTime.Format(time.ANSIC))
+	builder.WriteString(", retire_time=")
+	builder.WriteString(sk.RetireTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SigningKeys is a parsable slice of SigningKey.
+type SigningKeys []*SigningKey
+
+func (sk SigningKeys) config(cfg config) {
+	for _i := range sk {
+		sk[_i].config = cfg
+	}
+}
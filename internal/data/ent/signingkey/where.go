@@ -0,0 +1,887 @@
+// Code generated by entc, DO NOT EDIT.
+
+package signingkey
+
+import (
+	"time"
+	"usm/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreateTime), v))
+	})
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdateTime), v))
+	})
+}
+
+// Kid applies equality check predicate on the "kid" field. It's identical to KidEQ.
+func Kid(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKid), v))
+	})
+}
+
+// Algorithm applies equality check predicate on the "algorithm" field. It's identical to AlgorithmEQ.
+func Algorithm(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAlgorithm), v))
+	})
+}
+
+// PrivateKey applies equality check predicate on the "private_key" field. It's identical to PrivateKeyEQ.
+func PrivateKey(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrivateKey), v))
+	})
+}
+
+// ActivateTime applies equality check predicate on the "activate_time" field. It's identical to ActivateTimeEQ.
+func ActivateTime(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActivateTime), v))
+	})
+}
+
+// RetireTime applies equality check predicate on the "retire_time" field. It's identical to RetireTimeEQ.
+func RetireTime(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetireTime), v))
+	})
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreateTime), v...))
+	})
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreateTime), v...))
+	})
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreateTime), v))
+	})
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreateTime), v))
+	})
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdateTime), v...))
+	})
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdateTime), v...))
+	})
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdateTime), v))
+	})
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdateTime), v))
+	})
+}
+
+// KidEQ applies the EQ predicate on the "kid" field.
+func KidEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKid), v))
+	})
+}
+
+// KidNEQ applies the NEQ predicate on the "kid" field.
+func KidNEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldKid), v))
+	})
+}
+
+// KidIn applies the In predicate on the "kid" field.
+func KidIn(vs ...string) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldKid), v...))
+	})
+}
+
+// KidNotIn applies the NotIn predicate on the "kid" field.
+func KidNotIn(vs ...string) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldKid), v...))
+	})
+}
+
+// KidGT applies the GT predicate on the "kid" field.
+func KidGT(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldKid), v))
+	})
+}
+
+// KidGTE applies the GTE predicate on the "kid" field.
+func KidGTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldKid), v))
+	})
+}
+
+// KidLT applies the LT predicate on the "kid" field.
+func KidLT(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldKid), v))
+	})
+}
+
+// KidLTE applies the LTE predicate on the "kid" field.
+func KidLTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldKid), v))
+	})
+}
+
+// KidContains applies the Contains predicate on the "kid" field.
+func KidContains(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldKid), v))
+	})
+}
+
+// KidHasPrefix applies the HasPrefix predicate on the "kid" field.
+func KidHasPrefix(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldKid), v))
+	})
+}
+
+// KidHasSuffix applies the HasSuffix predicate on the "kid" field.
+func KidHasSuffix(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldKid), v))
+	})
+}
+
+// KidEqualFold applies the EqualFold predicate on the "kid" field.
+func KidEqualFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldKid), v))
+	})
+}
+
+// KidContainsFold applies the ContainsFold predicate on the "kid" field.
+func KidContainsFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldKid), v))
+	})
+}
+
+// AlgorithmEQ applies the EQ predicate on the "algorithm" field.
+func AlgorithmEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAlgorithm), v))
+	})
+}
+
+// AlgorithmNEQ applies the NEQ predicate on the "algorithm" field.
+func AlgorithmNEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAlgorithm), v))
+	})
+}
+
+// AlgorithmIn applies the In predicate on the "algorithm" field.
+func AlgorithmIn(vs ...string) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAlgorithm), v...))
+	})
+}
+
+// AlgorithmNotIn applies the NotIn predicate on the "algorithm" field.
+func AlgorithmNotIn(vs ...string) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAlgorithm), v...))
+	})
+}
+
+// AlgorithmGT applies the GT predicate on the "algorithm" field.
+func AlgorithmGT(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAlgorithm), v))
+	})
+}
+
+// AlgorithmGTE applies the GTE predicate on the "algorithm" field.
+func AlgorithmGTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAlgorithm), v))
+	})
+}
+
+// AlgorithmLT applies the LT predicate on the "algorithm" field.
+func AlgorithmLT(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAlgorithm), v))
+	})
+}
+
+// AlgorithmLTE applies the LTE predicate on the "algorithm" field.
+func AlgorithmLTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAlgorithm), v))
+	})
+}
+
+// AlgorithmContains applies the Contains predicate on the "algorithm" field.
+func AlgorithmContains(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAlgorithm), v))
+	})
+}
+
+// AlgorithmHasPrefix applies the HasPrefix predicate on the "algorithm" field.
+func AlgorithmHasPrefix(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAlgorithm), v))
+	})
+}
+
+// AlgorithmHasSuffix applies the HasSuffix predicate on the "algorithm" field.
+func AlgorithmHasSuffix(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAlgorithm), v))
+	})
+}
+
+// AlgorithmEqualFold applies the EqualFold predicate on the "algorithm" field.
+func AlgorithmEqualFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAlgorithm), v))
+	})
+}
+
+// AlgorithmContainsFold applies the ContainsFold predicate on the "algorithm" field.
+func AlgorithmContainsFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAlgorithm), v))
+	})
+}
+
+// PrivateKeyEQ applies the EQ predicate on the "private_key" field.
+func PrivateKeyEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyNEQ applies the NEQ predicate on the "private_key" field.
+func PrivateKeyNEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyIn applies the In predicate on the "private_key" field.
+func PrivateKeyIn(vs ...string) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPrivateKey), v...))
+	})
+}
+
+// PrivateKeyNotIn applies the NotIn predicate on the "private_key" field.
+func PrivateKeyNotIn(vs ...string) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPrivateKey), v...))
+	})
+}
+
+// PrivateKeyGT applies the GT predicate on the "private_key" field.
+func PrivateKeyGT(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyGTE applies the GTE predicate on the "private_key" field.
+func PrivateKeyGTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyLT applies the LT predicate on the "private_key" field.
+func PrivateKeyLT(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyLTE applies the LTE predicate on the "private_key" field.
+func PrivateKeyLTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyContains applies the Contains predicate on the "private_key" field.
+func PrivateKeyContains(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyHasPrefix applies the HasPrefix predicate on the "private_key" field.
+func PrivateKeyHasPrefix(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyHasSuffix applies the HasSuffix predicate on the "private_key" field.
+func PrivateKeyHasSuffix(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyEqualFold applies the EqualFold predicate on the "private_key" field.
+func PrivateKeyEqualFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPrivateKey), v))
+	})
+}
+
+// PrivateKeyContainsFold applies the ContainsFold predicate on the "private_key" field.
+func PrivateKeyContainsFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPrivateKey), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// ActivateTimeEQ applies the EQ predicate on the "activate_time" field.
+func ActivateTimeEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActivateTime), v))
+	})
+}
+
+// ActivateTimeNEQ applies the NEQ predicate on the "activate_time" field.
+func ActivateTimeNEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActivateTime), v))
+	})
+}
+
+// ActivateTimeIn applies the In predicate on the "activate_time" field.
+func ActivateTimeIn(vs ...time.Time) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldActivateTime), v...))
+	})
+}
+
+// ActivateTimeNotIn applies the NotIn predicate on the "activate_time" field.
+func ActivateTimeNotIn(vs ...time.Time) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldActivateTime), v...))
+	})
+}
+
+// ActivateTimeGT applies the GT predicate on the "activate_time" field.
+func ActivateTimeGT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldActivateTime), v))
+	})
+}
+
+// ActivateTimeGTE applies the GTE predicate on the "activate_time" field.
+func ActivateTimeGTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldActivateTime), v))
+	})
+}
+
+// ActivateTimeLT applies the LT predicate on the "activate_time" field.
+func ActivateTimeLT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldActivateTime), v))
+	})
+}
+
+// ActivateTimeLTE applies the LTE predicate on the "activate_time" field.
+func ActivateTimeLTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldActivateTime), v))
+	})
+}
+
+// ActivateTimeIsNil applies the IsNil predicate on the "activate_time" field.
+func ActivateTimeIsNil() predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldActivateTime)))
+	})
+}
+
+// ActivateTimeNotNil applies the NotNil predicate on the "activate_time" field.
+func ActivateTimeNotNil() predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldActivateTime)))
+	})
+}
+
+// RetireTimeEQ applies the EQ predicate on the "retire_time" field.
+func RetireTimeEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetireTime), v))
+	})
+}
+
+// RetireTimeNEQ applies the NEQ predicate on the "retire_time" field.
+func RetireTimeNEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRetireTime), v))
+	})
+}
+
+// RetireTimeIn applies the In predicate on the "retire_time" field.
+func RetireTimeIn(vs ...time.Time) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRetireTime), v...))
+	})
+}
+
+// RetireTimeNotIn applies the NotIn predicate on the "retire_time" field.
+func RetireTimeNotIn(vs ...time.Time) predicate.SigningKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SigningKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRetireTime), v...))
+	})
+}
+
+// RetireTimeGT applies the GT predicate on the "retire_time" field.
+func RetireTimeGT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRetireTime), v))
+	})
+}
+
+// RetireTimeGTE applies the GTE predicate on the "retire_time" field.
+func RetireTimeGTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRetireTime), v))
+	})
+}
+
+// RetireTimeLT applies the LT predicate on the "retire_time" field.
+func RetireTimeLT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRetireTime), v))
+	})
+}
+
+// RetireTimeLTE applies the LTE predicate on the "retire_time" field.
+func RetireTimeLTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRetireTime), v))
+	})
+}
+
+// RetireTimeIsNil applies the IsNil predicate on the "retire_time" field.
+func RetireTimeIsNil() predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRetireTime)))
+	})
+}
+
+// RetireTimeNotNil applies the NotNil predicate on the "retire_time" field.
+func RetireTimeNotNil() predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRetireTime)))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SigningKey) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SigningKey) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SigningKey) predicate.SigningKey {
+	return predicate.SigningKey(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,409 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"usm/internal/data/ent/signingkey"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SigningKeyCreate is the builder for creating a SigningKey entity.
+type SigningKeyCreate struct {
+	config
+	mutation *SigningKeyMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (skc *SigningKeyCreate) SetCreateTime(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetCreateTime(t)
+	return skc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableCreateTime(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetCreateTime(*t)
+	}
+	return skc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (skc *SigningKeyCreate) SetUpdateTime(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetUpdateTime(t)
+	return skc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableUpdateTime(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetUpdateTime(*t)
+	}
+	return skc
+}
+
+// SetKid sets the "kid" field.
+func (skc *SigningKeyCreate) SetKid(s string) *SigningKeyCreate {
+	skc.mutation.SetKid(s)
+	return skc
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (skc *SigningKeyCreate) SetAlgorithm(s string) *SigningKeyCreate {
+	skc.mutation.SetAlgorithm(s)
+	return skc
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (skc *SigningKeyCreate) SetPrivateKey(s string) *SigningKeyCreate {
+	skc.mutation.SetPrivateKey(s)
+	return skc
+}
+
+// SetStatus sets the "status" field.
+func (skc *SigningKeyCreate) SetStatus(s signingkey.Status) *SigningKeyCreate {
+	skc.mutation.SetStatus(s)
+	return skc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableStatus(s *signingkey.Status) *SigningKeyCreate {
+	if s != nil {
+		skc.SetStatus(*s)
+	}
+	return skc
+}
+
+// SetActivateTime sets the "activate_time" field.
+func (skc *SigningKeyCreate) SetActivateTime(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetActivateTime(t)
+	return skc
+}
+
+// SetNillableActivateTime sets the "activate_time" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableActivateTime(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetActivateTime(*t)
+	}
+	return skc
+}
+
+// SetRetireTime sets the "retire_time" field.
+func (skc *SigningKeyCreate) SetRetireTime(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetRetireTime(t)
+	return skc
+}
+
+// SetNillableRetireTime sets the "retire_time" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableRetireTime(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetRetireTime(*t)
+	}
+	return skc
+}
+
+// SetID sets the "id" field.
+func (skc *SigningKeyCreate) SetID(i int64) *SigningKeyCreate {
+	skc.mutation.SetID(i)
+	return skc
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (skc *SigningKeyCreate) Mutation() *SigningKeyMutation {
+	return skc.mutation
+}
+
+// Save creates the SigningKey in the database.
+func (skc *SigningKeyCreate) Save(ctx context.Context) (*SigningKey, error) {
+	var (
+		err  error
+		node *SigningKey
+	)
+	skc.defaults()
+	if len(skc.hooks) == 0 {
+		if err = skc.check(); err != nil {
+			return nil, err
+		}
+		node, err = skc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SigningKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = skc.check(); err != nil {
+				return nil, err
+			}
+			skc.mutation = mutation
+			if node, err = skc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(skc.hooks) - 1; i >= 0; i-- {
+			if skc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = skc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, skc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (skc *SigningKeyCreate) SaveX(ctx context.Context) *SigningKey {
+	v, err := skc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skc *SigningKeyCreate) Exec(ctx context.Context) error {
+	_, err := skc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skc *SigningKeyCreate) ExecX(ctx context.Context) {
+	if err := skc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skc *SigningKeyCreate) defaults() {
+	if _, ok := skc.mutation.CreateTime(); !ok {
+		v := signingkey.DefaultCreateTime()
+		skc.mutation.SetCreateTime(v)
+	}
+	if _, ok := skc.mutation.UpdateTime(); !ok {
+		v := signingkey.DefaultUpdateTime()
+		skc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := skc.mutation.Status(); !ok {
+		v := signingkey.DefaultStatus
+		skc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skc *SigningKeyCreate) check() error {
+	if _, ok := skc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "SigningKey.create_time"`)}
+	}
+	if _, ok := skc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "SigningKey.update_time"`)}
+	}
+	if _, ok := skc.mutation.Kid(); !ok {
+		return &ValidationError{Name: "kid", err: errors.New(`ent: missing required field "SigningKey.kid"`)}
+	}
+	if _, ok := skc.mutation.Algorithm(); !ok {
+		return &ValidationError{Name: "algorithm", err: errors.New(`ent: missing required field "SigningKey.algorithm"`)}
+	}
+	if _, ok := skc.mutation.PrivateKey(); !ok {
+		return &ValidationError{Name: "private_key", err: errors.New(`ent: missing required field "SigningKey.private_key"`)}
+	}
+	if _, ok := skc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SigningKey.status"`)}
+	}
+	if v, ok := skc.mutation.Status(); ok {
+		if err := signingkey.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SigningKey.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (skc *SigningKeyCreate) sqlSave(ctx context.Context) (*SigningKey, error) {
+	_node, _spec := skc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, skc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	return _node, nil
+}
+
+func (skc *SigningKeyCreate) createSpec() (*SigningKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SigningKey{config: skc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: signingkey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: signingkey.FieldID,
+			},
+		}
+	)
+	if id, ok := skc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := skc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldCreateTime,
+		})
+		_node.CreateTime = value
+	}
+	if value, ok := skc.mutation.UpdateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldUpdateTime,
+		})
+		_node.UpdateTime = value
+	}
+	if value, ok := skc.mutation.Kid(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: signingkey.FieldKid,
+		})
+		_node.Kid = value
+	}
+	if value, ok := skc.mutation.Algorithm(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: signingkey.FieldAlgorithm,
+		})
+		_node.Algorithm = value
+	}
+	if value, ok := skc.mutation.PrivateKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: signingkey.FieldPrivateKey,
+		})
+		_node.PrivateKey = value
+	}
+	if value, ok := skc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: signingkey.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := skc.mutation.ActivateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldActivateTime,
+		})
+		_node.ActivateTime = value
+	}
+	if value, ok := skc.mutation.RetireTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldRetireTime,
+		})
+		_node.RetireTime = value
+	}
+	return _node, _spec
+}
+
+// SigningKeyCreateBulk is the builder for creating many SigningKey entities in bulk.
+type SigningKeyCreateBulk struct {
+	config
+	builders []*SigningKeyCreate
+}
+
+// Save creates the SigningKey entities in the database.
+func (skcb *SigningKeyCreateBulk) Save(ctx context.Context) ([]*SigningKey, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(skcb.builders))
+	nodes := make([]*SigningKey, len(skcb.builders))
+	mutators := make([]Mutator, len(skcb.builders))
+	for i := range skcb.builders {
+		func(i int, root context.Context) {
+			builder := skcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SigningKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, skcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, skcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, skcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skcb *SigningKeyCreateBulk) SaveX(ctx context.Context) []*SigningKey {
+	v, err := skcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skcb *SigningKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := skcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skcb *SigningKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := skcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,490 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"usm/internal/data/ent/predicate"
+	"usm/internal/data/ent/signingkey"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SigningKeyUpdate is the builder for updating SigningKey entities.
+type SigningKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SigningKeyMutation
+}
+
+// Where appends a list predicates to the SigningKeyUpdate builder.
+func (sku *SigningKeyUpdate) Where(ps ...predicate.SigningKey) *SigningKeyUpdate {
+	sku.mutation.Where(ps...)
+	return sku
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (sku *SigningKeyUpdate) SetUpdateTime(t time.Time) *SigningKeyUpdate {
+	sku.mutation.SetUpdateTime(t)
+	return sku
+}
+
+// SetStatus sets the "status" field.
+func (sku *SigningKeyUpdate) SetStatus(s signingkey.Status) *SigningKeyUpdate {
+	sku.mutation.SetStatus(s)
+	return sku
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableStatus(s *signingkey.Status) *SigningKeyUpdate {
+	if s != nil {
+		sku.SetStatus(*s)
+	}
+	return sku
+}
+
+// SetActivateTime sets the "activate_time" field.
+func (sku *SigningKeyUpdate) SetActivateTime(t time.Time) *SigningKeyUpdate {
+	sku.mutation.SetActivateTime(t)
+	return sku
+}
+
+// SetNillableActivateTime sets the "activate_time" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableActivateTime(t *time.Time) *SigningKeyUpdate {
+	if t != nil {
+		sku.SetActivateTime(*t)
+	}
+	return sku
+}
+
+// ClearActivateTime clears the value of the "activate_time" field.
+func (sku *SigningKeyUpdate) ClearActivateTime() *SigningKeyUpdate {
+	sku.mutation.ClearActivateTime()
+	return sku
+}
+
+// SetRetireTime sets the "retire_time" field.
+func (sku *SigningKeyUpdate) SetRetireTime(t time.Time) *SigningKeyUpdate {
+	sku.mutation.SetRetireTime(t)
+	return sku
+}
+
+// SetNillableRetireTime sets the "retire_time" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableRetireTime(t *time.Time) *SigningKeyUpdate {
+	if t != nil {
+		sku.SetRetireTime(*t)
+	}
+	return sku
+}
+
+// ClearRetireTime clears the value of the "retire_time" field.
+func (sku *SigningKeyUpdate) ClearRetireTime() *SigningKeyUpdate {
+	sku.mutation.ClearRetireTime()
+	return sku
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (sku *SigningKeyUpdate) Mutation() *SigningKeyMutation {
+	return sku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sku *SigningKeyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	sku.defaults()
+	if len(sku.hooks) == 0 {
+		if err = sku.check(); err != nil {
+			return 0, err
+		}
+		affected, err = sku.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SigningKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sku.check(); err != nil {
+				return 0, err
+			}
+			sku.mutation = mutation
+			affected, err = sku.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sku.hooks) - 1; i >= 0; i-- {
+			if sku.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sku.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sku.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sku *SigningKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := sku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sku *SigningKeyUpdate) Exec(ctx context.Context) error {
+	_, err := sku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sku *SigningKeyUpdate) ExecX(ctx context.Context) {
+	if err := sku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sku *SigningKeyUpdate) defaults() {
+	if _, ok := sku.mutation.UpdateTime(); !ok {
+		v := signingkey.UpdateDefaultUpdateTime()
+		sku.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sku *SigningKeyUpdate) check() error {
+	if v, ok := sku.mutation.Status(); ok {
+		if err := signingkey.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SigningKey.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sku *SigningKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   signingkey.Table,
+			Columns: signingkey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: signingkey.FieldID,
+			},
+		},
+	}
+	if ps := sku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sku.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldUpdateTime,
+		})
+	}
+	if value, ok := sku.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: signingkey.FieldStatus,
+		})
+	}
+	if value, ok := sku.mutation.ActivateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldActivateTime,
+		})
+	}
+	if sku.mutation.ActivateTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: signingkey.FieldActivateTime,
+		})
+	}
+	if value, ok := sku.mutation.RetireTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldRetireTime,
+		})
+	}
+	if sku.mutation.RetireTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: signingkey.FieldRetireTime,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SigningKeyUpdateOne is the builder for updating a single SigningKey entity.
+type SigningKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SigningKeyMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (skuo *SigningKeyUpdateOne) SetUpdateTime(t time.Time) *SigningKeyUpdateOne {
+	skuo.mutation.SetUpdateTime(t)
+	return skuo
+}
+
+// SetStatus sets the "status" field.
+func (skuo *SigningKeyUpdateOne) SetStatus(s signingkey.Status) *SigningKeyUpdateOne {
+	skuo.mutation.SetStatus(s)
+	return skuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableStatus(s *signingkey.Status) *SigningKeyUpdateOne {
+	if s != nil {
+		skuo.SetStatus(*s)
+	}
+	return skuo
+}
+
+// SetActivateTime sets the "activate_time" field.
+func (skuo *SigningKeyUpdateOne) SetActivateTime(t time.Time) *SigningKeyUpdateOne {
+	skuo.mutation.SetActivateTime(t)
+	return skuo
+}
+
+// SetNillableActivateTime sets the "activate_time" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableActivateTime(t *time.Time) *SigningKeyUpdateOne {
+	if t != nil {
+		skuo.SetActivateTime(*t)
+	}
+	return skuo
+}
+
+// ClearActivateTime clears the value of the "activate_time" field.
+func (skuo *SigningKeyUpdateOne) ClearActivateTime() *SigningKeyUpdateOne {
+	skuo.mutation.ClearActivateTime()
+	return skuo
+}
+
+// SetRetireTime sets the "retire_time" field.
+func (skuo *SigningKeyUpdateOne) SetRetireTime(t time.Time) *SigningKeyUpdateOne {
+	skuo.mutation.SetRetireTime(t)
+	return skuo
+}
+
+// SetNillableRetireTime sets the "retire_time" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableRetireTime(t *time.Time) *SigningKeyUpdateOne {
+	if t != nil {
+		skuo.SetRetireTime(*t)
+	}
+	return skuo
+}
+
+// ClearRetireTime clears the value of the "retire_time" field.
+func (skuo *SigningKeyUpdateOne) ClearRetireTime() *SigningKeyUpdateOne {
+	skuo.mutation.ClearRetireTime()
+	return skuo
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (skuo *SigningKeyUpdateOne) Mutation() *SigningKeyMutation {
+	return skuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (skuo *SigningKeyUpdateOne) Select(field string, fields ...string) *SigningKeyUpdateOne {
+	skuo.fields = append([]string{field}, fields...)
+	return skuo
+}
+
+// Save executes the query and returns the updated SigningKey entity.
+func (skuo *SigningKeyUpdateOne) Save(ctx context.Context) (*SigningKey, error) {
+	var (
+		err  error
+		node *SigningKey
+	)
+	skuo.defaults()
+	if len(skuo.hooks) == 0 {
+		if err = skuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = skuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SigningKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = skuo.check(); err != nil {
+				return nil, err
+			}
+			skuo.mutation = mutation
+			node, err = skuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(skuo.hooks) - 1; i >= 0; i-- {
+			if skuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = skuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, skuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skuo *SigningKeyUpdateOne) SaveX(ctx context.Context) *SigningKey {
+	node, err := skuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (skuo *SigningKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := skuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skuo *SigningKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := skuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skuo *SigningKeyUpdateOne) defaults() {
+	if _, ok := skuo.mutation.UpdateTime(); !ok {
+		v := signingkey.UpdateDefaultUpdateTime()
+		skuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skuo *SigningKeyUpdateOne) check() error {
+	if v, ok := skuo.mutation.Status(); ok {
+		if err := signingkey.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SigningKey.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (skuo *SigningKeyUpdateOne) sqlSave(ctx context.Context) (_node *SigningKey, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   signingkey.Table,
+			Columns: signingkey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: signingkey.FieldID,
+			},
+		},
+	}
+	id, ok := skuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SigningKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := skuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signingkey.FieldID)
+		for _, f := range fields {
+			if !signingkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signingkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := skuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := skuo.mutation.UpdateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldUpdateTime,
+		})
+	}
+	if value, ok := skuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: signingkey.FieldStatus,
+		})
+	}
+	if value, ok := skuo.mutation.ActivateTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldActivateTime,
+		})
+	}
+	if skuo.mutation.ActivateTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: signingkey.FieldActivateTime,
+		})
+	}
+	if value, ok := skuo.mutation.RetireTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: signingkey.FieldRetireTime,
+		})
+	}
+	if skuo.mutation.RetireTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: signingkey.FieldRetireTime,
+		})
+	}
+	_node = &SigningKey{config: skuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, skuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"usm/internal/data/ent/apikey"
+	"usm/internal/data/ent/group"
 	"usm/internal/data/ent/user"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -54,6 +56,20 @@ func (uc *UserCreate) SetUsername(s string) *UserCreate {
 	return uc
 }
 
+// SetUsernameKey sets the "username_key" field.
+func (uc *UserCreate) SetUsernameKey(s string) *UserCreate {
+	uc.mutation.SetUsernameKey(s)
+	return uc
+}
+
+// SetNillableUsernameKey sets the "username_key" field if the given value is not nil.
+func (uc *UserCreate) SetNillableUsernameKey(s *string) *UserCreate {
+	if s != nil {
+		uc.SetUsernameKey(*s)
+	}
+	return uc
+}
+
 // SetEmail sets the "email" field.
 func (uc *UserCreate) SetEmail(s string) *UserCreate {
 	uc.mutation.SetEmail(s)
@@ -68,6 +84,34 @@ func (uc *UserCreate) SetNillableEmail(s *string) *UserCreate {
 	return uc
 }
 
+// SetEmailKey sets the "email_key" field.
+func (uc *UserCreate) SetEmailKey(s string) *UserCreate {
+	uc.mutation.SetEmailKey(s)
+	return uc
+}
+
+// SetNillableEmailKey sets the "email_key" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmailKey(s *string) *UserCreate {
+	if s != nil {
+		uc.SetEmailKey(*s)
+	}
+	return uc
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (uc *UserCreate) SetEmailVerified(b bool) *UserCreate {
+	uc.mutation.SetEmailVerified(b)
+	return uc
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmailVerified(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetEmailVerified(*b)
+	}
+	return uc
+}
+
 // SetPassword sets the "password" field.
 func (uc *UserCreate) SetPassword(s string) *UserCreate {
 	uc.mutation.SetPassword(s)
@@ -88,12 +132,188 @@ func (uc *UserCreate) SetNillableDisabled(b *bool) *UserCreate {
 	return uc
 }
 
+// SetKind sets the "kind" field.
+func (uc *UserCreate) SetKind(u user.Kind) *UserCreate {
+	uc.mutation.SetKind(u)
+	return uc
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (uc *UserCreate) SetNillableKind(u *user.Kind) *UserCreate {
+	if u != nil {
+		uc.SetKind(*u)
+	}
+	return uc
+}
+
+// SetOwnerKind sets the "owner_kind" field.
+func (uc *UserCreate) SetOwnerKind(uk user.OwnerKind) *UserCreate {
+	uc.mutation.SetOwnerKind(uk)
+	return uc
+}
+
+// SetNillableOwnerKind sets the "owner_kind" field if the given value is not nil.
+func (uc *UserCreate) SetNillableOwnerKind(uk *user.OwnerKind) *UserCreate {
+	if uk != nil {
+		uc.SetOwnerKind(*uk)
+	}
+	return uc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (uc *UserCreate) SetOwnerID(i int64) *UserCreate {
+	uc.mutation.SetOwnerID(i)
+	return uc
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableOwnerID(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetOwnerID(*i)
+	}
+	return uc
+}
+
+// SetSource sets the "source" field.
+func (uc *UserCreate) SetSource(s string) *UserCreate {
+	uc.mutation.SetSource(s)
+	return uc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSource(s *string) *UserCreate {
+	if s != nil {
+		uc.SetSource(*s)
+	}
+	return uc
+}
+
+// SetExternalID sets the "external_id" field.
+func (uc *UserCreate) SetExternalID(s string) *UserCreate {
+	uc.mutation.SetExternalID(s)
+	return uc
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableExternalID(s *string) *UserCreate {
+	if s != nil {
+		uc.SetExternalID(*s)
+	}
+	return uc
+}
+
+// SetDisplayName sets the "display_name" field.
+func (uc *UserCreate) SetDisplayName(s string) *UserCreate {
+	uc.mutation.SetDisplayName(s)
+	return uc
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDisplayName(s *string) *UserCreate {
+	if s != nil {
+		uc.SetDisplayName(*s)
+	}
+	return uc
+}
+
+// SetPhone sets the "phone" field.
+func (uc *UserCreate) SetPhone(s string) *UserCreate {
+	uc.mutation.SetPhone(s)
+	return uc
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePhone(s *string) *UserCreate {
+	if s != nil {
+		uc.SetPhone(*s)
+	}
+	return uc
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (uc *UserCreate) SetAvatarURL(s string) *UserCreate {
+	uc.mutation.SetAvatarURL(s)
+	return uc
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (uc *UserCreate) SetNillableAvatarURL(s *string) *UserCreate {
+	if s != nil {
+		uc.SetAvatarURL(*s)
+	}
+	return uc
+}
+
+// SetLocale sets the "locale" field.
+func (uc *UserCreate) SetLocale(s string) *UserCreate {
+	uc.mutation.SetLocale(s)
+	return uc
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLocale(s *string) *UserCreate {
+	if s != nil {
+		uc.SetLocale(*s)
+	}
+	return uc
+}
+
+// SetTimezone sets the "timezone" field.
+func (uc *UserCreate) SetTimezone(s string) *UserCreate {
+	uc.mutation.SetTimezone(s)
+	return uc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTimezone(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTimezone(*s)
+	}
+	return uc
+}
+
+// SetAttributes sets the "attributes" field.
+func (uc *UserCreate) SetAttributes(m map[string]interface{}) *UserCreate {
+	uc.mutation.SetAttributes(m)
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(i int64) *UserCreate {
 	uc.mutation.SetID(i)
 	return uc
 }
 
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (uc *UserCreate) AddAPIKeyIDs(ids ...int64) *UserCreate {
+	uc.mutation.AddAPIKeyIDs(ids...)
+	return uc
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (uc *UserCreate) AddAPIKeys(a ...*APIKey) *UserCreate {
+	ids := make([]int64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAPIKeyIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (uc *UserCreate) AddGroupIDs(ids ...int64) *UserCreate {
+	uc.mutation.AddGroupIDs(ids...)
+	return uc
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (uc *UserCreate) AddGroups(g ...*Group) *UserCreate {
+	ids := make([]int64, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddGroupIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -173,10 +393,22 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultUpdateTime()
 		uc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := uc.mutation.EmailVerified(); !ok {
+		v := user.DefaultEmailVerified
+		uc.mutation.SetEmailVerified(v)
+	}
 	if _, ok := uc.mutation.Disabled(); !ok {
 		v := user.DefaultDisabled
 		uc.mutation.SetDisabled(v)
 	}
+	if _, ok := uc.mutation.Kind(); !ok {
+		v := user.DefaultKind
+		uc.mutation.SetKind(v)
+	}
+	if _, ok := uc.mutation.Source(); !ok {
+		v := user.DefaultSource
+		uc.mutation.SetSource(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -190,12 +422,31 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Username(); !ok {
 		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "User.username"`)}
 	}
+	if _, ok := uc.mutation.EmailVerified(); !ok {
+		return &ValidationError{Name: "email_verified", err: errors.New(`ent: missing required field "User.email_verified"`)}
+	}
 	if _, ok := uc.mutation.Password(); !ok {
 		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "User.password"`)}
 	}
 	if _, ok := uc.mutation.Disabled(); !ok {
 		return &ValidationError{Name: "disabled", err: errors.New(`ent: missing required field "User.disabled"`)}
 	}
+	if _, ok := uc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "User.kind"`)}
+	}
+	if v, ok := uc.mutation.Kind(); ok {
+		if err := user.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "User.kind": %w`, err)}
+		}
+	}
+	if v, ok := uc.mutation.OwnerKind(); ok {
+		if err := user.OwnerKindValidator(v); err != nil {
+			return &ValidationError{Name: "owner_kind", err: fmt.Errorf(`ent: validator failed for field "User.owner_kind": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "User.source"`)}
+	}
 	return nil
 }
 
@@ -253,6 +504,14 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		})
 		_node.Username = value
 	}
+	if value, ok := uc.mutation.UsernameKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldUsernameKey,
+		})
+		_node.UsernameKey = &value
+	}
 	if value, ok := uc.mutation.Email(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -261,6 +520,22 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		})
 		_node.Email = value
 	}
+	if value, ok := uc.mutation.EmailKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldEmailKey,
+		})
+		_node.EmailKey = &value
+	}
+	if value, ok := uc.mutation.EmailVerified(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldEmailVerified,
+		})
+		_node.EmailVerified = value
+	}
 	if value, ok := uc.mutation.Password(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -277,6 +552,132 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		})
 		_node.Disabled = value
 	}
+	if value, ok := uc.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: user.FieldKind,
+		})
+		_node.Kind = value
+	}
+	if value, ok := uc.mutation.OwnerKind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: user.FieldOwnerKind,
+		})
+		_node.OwnerKind = value
+	}
+	if value, ok := uc.mutation.OwnerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldOwnerID,
+		})
+		_node.OwnerID = value
+	}
+	if value, ok := uc.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := uc.mutation.ExternalID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldExternalID,
+		})
+		_node.ExternalID = value
+	}
+	if value, ok := uc.mutation.DisplayName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldDisplayName,
+		})
+		_node.DisplayName = value
+	}
+	if value, ok := uc.mutation.Phone(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldPhone,
+		})
+		_node.Phone = value
+	}
+	if value, ok := uc.mutation.AvatarURL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldAvatarURL,
+		})
+		_node.AvatarURL = value
+	}
+	if value, ok := uc.mutation.Locale(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldLocale,
+		})
+		_node.Locale = value
+	}
+	if value, ok := uc.mutation.Timezone(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldTimezone,
+		})
+		_node.Timezone = value
+	}
+	if value, ok := uc.mutation.Attributes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldAttributes,
+		})
+		_node.Attributes = value
+	}
+	if nodes := uc.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: apikey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.GroupsTable,
+			Columns: user.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: group.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -0,0 +1,214 @@
+package data
+
+import (
+	"context"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/data/ent"
+	"usm/internal/data/ent/group"
+	"usm/internal/data/ent/predicate"
+	"usm/internal/data/ent/user"
+)
+
+type groupRepo struct {
+	data *Data
+}
+
+func NewGroupRepo(data *Data) repo.GroupRepo {
+	return &groupRepo{
+		data: data,
+	}
+}
+
+func (r *groupRepo) Create(ctx context.Context, m *repo.Group) (*repo.Group, error) {
+	c := r.data.DB(ctx).Group.
+		Create().
+		SetDisplayName(m.DisplayName)
+	if m.ExternalID != "" {
+		c.SetExternalID(m.ExternalID)
+	}
+	g, err := c.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.ErrResourceAlreadyExists
+		}
+		return nil, err
+	}
+	return r.groupFromEntity(g), nil
+}
+
+func (r *groupRepo) Update(ctx context.Context, m *repo.Group) (*repo.Group, error) {
+	up := r.data.DB(ctx).Group.UpdateOneID(int64(m.ID)).SetDisplayName(m.DisplayName)
+	if m.ExternalID != "" {
+		up.SetExternalID(m.ExternalID)
+	} else {
+		up.ClearExternalID()
+	}
+	g, err := up.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, biz.ErrResourceAlreadyExists
+		}
+		return nil, err
+	}
+	return r.groupFromEntity(g), nil
+}
+
+func (r *groupRepo) Delete(ctx context.Context, id int) error {
+	err := r.data.DB(ctx).Group.DeleteOneID(int64(id)).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
+}
+
+func (r *groupRepo) Get(ctx context.Context, id int) (*repo.Group, error) {
+	g, err := r.data.DB(ctx).Group.Get(ctx, int64(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.groupFromEntity(g), nil
+}
+
+func (r *groupRepo) List(ctx context.Context, filter *repo.GroupFilter, offset, limit int) ([]*repo.Group, error) {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	ents, err := r.data.DB(ctx).Group.Query().
+		Where(groupPredicates(filter)...).
+		Order(ent.Asc(group.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*repo.Group, 0, len(ents))
+	for _, g := range ents {
+		groups = append(groups, r.groupFromEntity(g))
+	}
+	return groups, nil
+}
+
+func (r *groupRepo) Count(ctx context.Context, filter *repo.GroupFilter) (int, error) {
+	return r.data.DB(ctx).Group.Query().Where(groupPredicates(filter)...).Count(ctx)
+}
+
+func groupPredicates(filter *repo.GroupFilter) []predicate.Group {
+	var ps []predicate.Group
+	if filter == nil {
+		return ps
+	}
+	if filter.DisplayName != "" {
+		ps = append(ps, group.DisplayName(filter.DisplayName))
+	}
+	if filter.ExternalID != "" {
+		ps = append(ps, group.ExternalID(filter.ExternalID))
+	}
+	return ps
+}
+
+func (r *groupRepo) ListMembers(ctx context.Context, id int) ([]*repo.User, error) {
+	ents, err := r.data.DB(ctx).Group.Query().
+		Where(group.ID(int64(id))).
+		QueryUsers().
+		Order(ent.Asc(user.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ur := &userRepo{data: r.data}
+	users := make([]*repo.User, 0, len(ents))
+	for _, u := range ents {
+		users = append(users, ur.userFromEntity(u))
+	}
+	return users, nil
+}
+
+func (r *groupRepo) AddMembers(ctx context.Context, id int, userIDs []int) error {
+	// 已经是成员的用户跳过，使重复添加成员幂等
+	existing, err := r.data.DB(ctx).Group.Query().
+		Where(group.ID(int64(id))).
+		QueryUsers().
+		Where(user.IDIn(toInt64s(userIDs)...)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	skip := make(map[int64]bool, len(existing))
+	for _, uid := range existing {
+		skip[uid] = true
+	}
+	var add []int64
+	for _, uid := range toInt64s(userIDs) {
+		if !skip[uid] {
+			skip[uid] = true
+			add = append(add, uid)
+		}
+	}
+	err = r.data.DB(ctx).Group.UpdateOneID(int64(id)).AddUserIDs(add...).Exec(ctx)
+	// 用户不存在时外键约束失败
+	if ent.IsNotFound(err) || ent.IsConstraintError(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
+}
+
+func (r *groupRepo) RemoveMembers(ctx context.Context, id int, userIDs []int) error {
+	err := r.data.DB(ctx).Group.UpdateOneID(int64(id)).RemoveUserIDs(toInt64s(userIDs)...).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
+}
+
+func (r *groupRepo) ClearMembers(ctx context.Context, id int) error {
+	err := r.data.DB(ctx).Group.UpdateOneID(int64(id)).ClearUsers().Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
+}
+
+func (r *groupRepo) ListByUser(ctx context.Context, userID int) ([]*repo.Group, error) {
+	ents, err := r.data.DB(ctx).Group.Query().
+		Where(group.HasUsersWith(user.ID(int64(userID)))).
+		Order(ent.Asc(group.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*repo.Group, 0, len(ents))
+	for _, g := range ents {
+		groups = append(groups, r.groupFromEntity(g))
+	}
+	return groups, nil
+}
+
+func (r *groupRepo) groupFromEntity(g *ent.Group) *repo.Group {
+	return &repo.Group{
+		ID:          int(g.ID),
+		DisplayName: g.DisplayName,
+		ExternalID:  g.ExternalID,
+		CreateTime:  g.CreateTime,
+		UpdateTime:  g.UpdateTime,
+	}
+}
+
+func toInt64s(ids []int) []int64 {
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, int64(id))
+	}
+	return res
+}
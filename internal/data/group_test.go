@@ -0,0 +1,62 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_groupRepo_Members(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	ur := NewUserRepo(data)
+	r := NewGroupRepo(data)
+
+	alice, err := ur.Create(ctx, &repo.User{Username: "alice", Email: "alice@example.com", Kind: repo.UserKindHuman})
+	assert.NoError(t, err)
+	bob, err := ur.Create(ctx, &repo.User{Username: "bob", Email: "bob@example.com", Kind: repo.UserKindHuman})
+	assert.NoError(t, err)
+
+	g, err := r.Create(ctx, &repo.Group{DisplayName: "dev", ExternalID: "ext-1"})
+	assert.NoError(t, err)
+	_, err = r.Create(ctx, &repo.Group{DisplayName: "dev"})
+	assert.Equal(t, biz.ErrResourceAlreadyExists, err)
+
+	gs, err := r.List(ctx, &repo.GroupFilter{ExternalID: "ext-1"}, 0, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, gs, 1) {
+		assert.Equal(t, g.ID, gs[0].ID)
+	}
+	n, err := r.Count(ctx, &repo.GroupFilter{DisplayName: "ops"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	assert.NoError(t, r.AddMembers(ctx, g.ID, []int{alice.ID, bob.ID}))
+	assert.NoError(t, r.AddMembers(ctx, g.ID, []int{bob.ID, bob.ID}))
+	members, err := r.ListMembers(ctx, g.ID)
+	assert.NoError(t, err)
+	assert.Len(t, members, 2)
+
+	assert.NoError(t, r.RemoveMembers(ctx, g.ID, []int{alice.ID}))
+	groups, err := r.ListByUser(ctx, alice.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, groups)
+	groups, err = r.ListByUser(ctx, bob.ID)
+	assert.NoError(t, err)
+	assert.Len(t, groups, 1)
+
+	assert.NoError(t, r.ClearMembers(ctx, g.ID))
+	members, err = r.ListMembers(ctx, g.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, members)
+
+	assert.NoError(t, r.Delete(ctx, g.ID))
+	_, err = r.Get(ctx, g.ID)
+	assert.Equal(t, biz.ErrResourceNotFound, err)
+	assert.Equal(t, biz.ErrResourceNotFound, r.AddMembers(ctx, g.ID, []int{bob.ID}))
+}
@@ -0,0 +1,159 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/data/ent"
+	"usm/internal/data/ent/invitation"
+	"usm/internal/data/ent/predicate"
+)
+
+type invitationRepo struct {
+	data *Data
+}
+
+func NewInvitationRepo(data *Data) repo.InvitationRepo {
+	return &invitationRepo{
+		data: data,
+	}
+}
+
+func (r *invitationRepo) Create(ctx context.Context, m *repo.Invitation) (*repo.Invitation, error) {
+	i, err := r.data.DB(ctx).Invitation.
+		Create().
+		SetEmail(m.Email).
+		SetTokenHash(m.TokenHash).
+		SetGroupIds(m.GroupIDs).
+		SetExpireTime(m.ExpireTime).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.ErrResourceAlreadyExists
+		}
+		return nil, err
+	}
+	return r.invitationFromEntity(i), nil
+}
+
+func (r *invitationRepo) Get(ctx context.Context, id int) (*repo.Invitation, error) {
+	i, err := r.data.DB(ctx).Invitation.Get(ctx, int64(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.invitationFromEntity(i), nil
+}
+
+func (r *invitationRepo) GetByHash(ctx context.Context, hash string) (*repo.Invitation, error) {
+	i, err := r.data.DB(ctx).Invitation.Query().Where(
+		invitation.TokenHash(hash),
+	).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.invitationFromEntity(i), nil
+}
+
+func (r *invitationRepo) List(ctx context.Context, filter *repo.InvitationFilter, offset, limit int) ([]*repo.Invitation, error) {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	ents, err := r.data.DB(ctx).Invitation.Query().
+		Where(invitationPredicates(filter, time.Now())...).
+		Order(ent.Desc(invitation.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*repo.Invitation, 0, len(ents))
+	for _, i := range ents {
+		res = append(res, r.invitationFromEntity(i))
+	}
+	return res, nil
+}
+
+func invitationPredicates(filter *repo.InvitationFilter, now time.Time) []predicate.Invitation {
+	var ps []predicate.Invitation
+	if filter == nil {
+		return ps
+	}
+	switch filter.Status {
+	case "":
+	case repo.InvitationStatusPending:
+		ps = append(ps, invitation.StatusEQ(invitation.StatusPending), invitation.ExpireTimeGT(now))
+	case repo.InvitationStatusExpired:
+		ps = append(ps, invitation.StatusEQ(invitation.StatusPending), invitation.ExpireTimeLTE(now))
+	default:
+		ps = append(ps, invitation.StatusEQ(invitation.Status(filter.Status)))
+	}
+	if filter.Email != "" {
+		ps = append(ps, invitation.EmailEqualFold(filter.Email))
+	}
+	return ps
+}
+
+func (r *invitationRepo) Revoke(ctx context.Context, id int) error {
+	n, err := r.data.DB(ctx).Invitation.Update().
+		Where(invitation.ID(int64(id)), invitation.StatusEQ(invitation.StatusPending)).
+		SetStatus(invitation.StatusRevoked).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return biz.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (r *invitationRepo) Accept(ctx context.Context, id, userID int, now time.Time) error {
+	n, err := r.data.DB(ctx).Invitation.Update().
+		Where(invitation.ID(int64(id)), invitation.StatusEQ(invitation.StatusPending)).
+		SetStatus(invitation.StatusAccepted).
+		SetUserID(int64(userID)).
+		SetAcceptTime(now).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return biz.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (r *invitationRepo) RevokeByEmail(ctx context.Context, email string) error {
+	_, err := r.data.DB(ctx).Invitation.Update().
+		Where(invitation.EmailEqualFold(email), invitation.StatusEQ(invitation.StatusPending)).
+		SetStatus(invitation.StatusRevoked).
+		Save(ctx)
+	return err
+}
+
+func (r *invitationRepo) invitationFromEntity(i *ent.Invitation) *repo.Invitation {
+	return &repo.Invitation{
+		ID:         int(i.ID),
+		Email:      i.Email,
+		TokenHash:  i.TokenHash,
+		GroupIDs:   i.GroupIds,
+		Status:     repo.InvitationStatus(i.Status),
+		ExpireTime: i.ExpireTime,
+		UserID:     int(i.UserID),
+		AcceptTime: i.AcceptTime,
+		CreateTime: i.CreateTime,
+		UpdateTime: i.UpdateTime,
+	}
+}
@@ -0,0 +1,58 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_invitationRepo(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	r := NewInvitationRepo(data)
+
+	now := time.Now()
+	pending, err := r.Create(ctx, &repo.Invitation{Email: "alice@example.com", TokenHash: "hash-1", GroupIDs: []int{1, 2}, ExpireTime: now.Add(time.Hour)})
+	assert.NoError(t, err)
+	assert.Equal(t, repo.InvitationStatusPending, pending.Status)
+	assert.Equal(t, []int{1, 2}, pending.GroupIDs)
+	expired, err := r.Create(ctx, &repo.Invitation{Email: "bob@example.com", TokenHash: "hash-2", ExpireTime: now.Add(-time.Hour)})
+	assert.NoError(t, err)
+
+	list := func(status repo.InvitationStatus) []int {
+		invs, err := r.List(ctx, &repo.InvitationFilter{Status: status}, 0, 10)
+		assert.NoError(t, err)
+		var ids []int
+		for _, inv := range invs {
+			ids = append(ids, inv.ID)
+		}
+		return ids
+	}
+	assert.Equal(t, []int{pending.ID}, list(repo.InvitationStatusPending))
+	assert.Equal(t, []int{expired.ID}, list(repo.InvitationStatusExpired))
+	assert.Equal(t, []int{expired.ID, pending.ID}, list(""))
+
+	got, err := r.GetByHash(ctx, "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, pending.ID, got.ID)
+
+	assert.NoError(t, r.Accept(ctx, pending.ID, 7, now))
+	assert.Equal(t, biz.ErrResourceNotFound, r.Accept(ctx, pending.ID, 8, now), "accepted invitation must not be accepted again")
+	assert.Equal(t, biz.ErrResourceNotFound, r.Revoke(ctx, pending.ID))
+	got, err = r.Get(ctx, pending.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, repo.InvitationStatusAccepted, got.Status)
+	assert.Equal(t, 7, got.UserID)
+	assert.False(t, got.AcceptTime.IsZero())
+
+	assert.NoError(t, r.RevokeByEmail(ctx, "bob@example.com"))
+	assert.Equal(t, []int{expired.ID}, list(repo.InvitationStatusRevoked))
+	_, err = r.Get(ctx, 99)
+	assert.Equal(t, biz.ErrResourceNotFound, err)
+}
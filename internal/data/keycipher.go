@@ -0,0 +1,112 @@
+package data
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"usm/internal/conf"
+	"usm/pkg/secret"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 加密后的私钥格式为 enc:v1:<base64(nonce|密文)>，没有此前缀的是明文 PEM
+const encryptedKeyPrefix = "enc:v1:"
+
+var errKeyDecrypt = errors.New("decrypt signing key: no key encryption key matches")
+
+// keyCipher 使用 AES-256-GCM 加密保存到数据库的私钥，附加数据为 kid，密文不能用于其他密钥记录
+type keyCipher struct {
+	keys *secret.Secret
+}
+
+func newKeyCipher(c *conf.Data_KeyEncryption, logger log.Logger) (*keyCipher, error) {
+	ref := c.GetKeysRef()
+	keys, err := secret.New(ref.GetFile(), ref.GetEnv(), strings.Join(c.GetKeys(), "\n"), logger)
+	if err != nil {
+		return nil, fmt.Errorf("signing key encryption keys: %v", err)
+	}
+	kc := &keyCipher{keys: keys}
+	aeads, err := kc.aeads()
+	if err != nil {
+		keys.Close()
+		return nil, err
+	}
+	if len(aeads) == 0 {
+		log.NewHelper(logger).Warn("signing_key_encryption is not configured, signing keys are stored in plaintext")
+	}
+	return kc, nil
+}
+
+// aeads 每次使用时读取密钥，密钥文件更新后立即生效
+func (kc *keyCipher) aeads() ([]cipher.AEAD, error) {
+	var aeads []cipher.AEAD
+	for i, k := range strings.Fields(kc.keys.Value()) {
+		b, err := base64.StdEncoding.DecodeString(k)
+		if err != nil || len(b) != 32 {
+			return nil, fmt.Errorf("signing key encryption key %d must be 32 bytes encoded in base64", i)
+		}
+		block, err := aes.NewCipher(b)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		aeads = append(aeads, aead)
+	}
+	return aeads, nil
+}
+
+// seal 使用第一个密钥加密，没有配置密钥时返回明文
+func (kc *keyCipher) seal(kid string, plain []byte) (string, error) {
+	aeads, err := kc.aeads()
+	if err != nil {
+		return "", err
+	}
+	if len(aeads) == 0 {
+		return string(plain), nil
+	}
+	aead := aeads[0]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, plain, []byte(kid))
+	return encryptedKeyPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// open 依次尝试每个密钥解密，明文保存的私钥原样返回
+func (kc *keyCipher) open(kid, stored string) ([]byte, error) {
+	if !strings.HasPrefix(stored, encryptedKeyPrefix) {
+		return []byte(stored), nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(stored, encryptedKeyPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt signing key: %v", err)
+	}
+	aeads, err := kc.aeads()
+	if err != nil {
+		return nil, err
+	}
+	for _, aead := range aeads {
+		if len(sealed) < aead.NonceSize() {
+			break
+		}
+		plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(kid))
+		if err == nil {
+			return plain, nil
+		}
+	}
+	return nil, errKeyDecrypt
+}
+
+func (kc *keyCipher) close() {
+	kc.keys.Close()
+}
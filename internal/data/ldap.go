@@ -0,0 +1,191 @@
+package data
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+	"time"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
+	"usm/pkg/secret"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-ldap/ldap/v3"
+)
+
+const (
+	ldapSourceName            = "ldap"
+	defaultLDAPUserFilter     = "(uid=%s)"
+	defaultLDAPEmailAttribute = "mail"
+	defaultLDAPTimeout        = 5 * time.Second
+)
+
+// ldapConn 是 *ldap.Conn 中用到的方法，便于测试时替换为进程内的 LDAP 桩
+type ldapConn interface {
+	Bind(username, password string) error
+	Search(req *ldap.SearchRequest) (*ldap.SearchResult, error)
+	StartTLS(config *tls.Config) error
+	Close()
+}
+
+type ldapAuthenticator struct {
+	log *log.Helper
+
+	bindDN         string
+	bindPassword   *secret.Secret
+	baseDN         string
+	userFilter     string
+	emailAttribute string
+	startTLS       bool
+	tlsConfig      *tls.Config
+	timeout        time.Duration
+
+	dial func() (ldapConn, error)
+}
+
+func NewExternalAuthenticators(c *conf.Auth, logger log.Logger) (repo.ExternalAuthenticators, func(), error) {
+	var res repo.ExternalAuthenticators
+	if c.GetLdap().GetUrl() != "" {
+		a, err := newLDAPAuthenticator(c.GetLdap(), logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		res = append(res, a)
+		return res, func() { a.bindPassword.Close() }, nil
+	}
+	return res, func() {}, nil
+}
+
+func newLDAPAuthenticator(c *conf.Auth_LDAP, logger log.Logger) (*ldapAuthenticator, error) {
+	logger = log.With(logger, "module", "data")
+	a := &ldapAuthenticator{
+		log:            log.NewHelper(logger),
+		bindDN:         c.BindDn,
+		baseDN:         c.BaseDn,
+		userFilter:     c.UserFilter,
+		emailAttribute: c.EmailAttribute,
+		startTLS:       c.StartTls,
+		timeout:        defaultLDAPTimeout,
+	}
+	if a.userFilter == "" {
+		a.userFilter = defaultLDAPUserFilter
+	}
+	if strings.Count(a.userFilter, "%s") != 1 {
+		return nil, fmt.Errorf("ldap user filter %q must contain exactly one %%s", a.userFilter)
+	}
+	if a.emailAttribute == "" {
+		a.emailAttribute = defaultLDAPEmailAttribute
+	}
+	if c.Timeout != nil {
+		a.timeout = c.Timeout.AsDuration()
+	}
+	tlsConfig, err := ldapTLSConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	a.tlsConfig = tlsConfig
+	a.bindPassword, err = secret.New(c.GetBindPasswordRef().GetFile(), c.GetBindPasswordRef().GetEnv(), c.BindPassword, logger)
+	if err != nil {
+		return nil, fmt.Errorf("ldap bind password: %v", err)
+	}
+	url := c.Url
+	a.dial = func() (ldapConn, error) {
+		conn, err := ldap.DialURL(url,
+			ldap.DialWithDialer(&net.Dialer{Timeout: a.timeout}),
+			ldap.DialWithTLSConfig(tlsConfig),
+		)
+		if err != nil {
+			return nil, err
+		}
+		conn.SetTimeout(a.timeout)
+		return conn, nil
+	}
+	return a, nil
+}
+
+func ldapTLSConfig(c *conf.Auth_LDAP) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if host, _, err := net.SplitHostPort(strings.TrimPrefix(strings.TrimPrefix(c.Url, "ldaps://"), "ldap://")); err == nil {
+		tlsConfig.ServerName = host
+	}
+	if c.CaFile != "" {
+		b, err := ioutil.ReadFile(c.CaFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, errors.New("no certificates found in ldap ca file")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+func (a *ldapAuthenticator) Name() string {
+	return ldapSourceName
+}
+
+// Authenticate 先使用服务账号查找用户 DN，再以用户 DN 和密码 bind 校验密码
+func (a *ldapAuthenticator) Authenticate(ctx context.Context, username, password string) (*repo.Identity, error) {
+	// 空密码会被 LDAP 当作匿名 bind 而成功
+	if username == "" || password == "" {
+		return nil, biz.ErrInvalidCredentials
+	}
+	conn, err := a.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if a.startTLS {
+		if err := conn.StartTLS(a.tlsConfig); err != nil {
+			return nil, err
+		}
+	}
+	if a.bindDN != "" {
+		if err := conn.Bind(a.bindDN, a.bindPassword.Value()); err != nil {
+			return nil, fmt.Errorf("ldap service bind: %w", err)
+		}
+	}
+	res, err := conn.Search(ldap.NewSearchRequest(
+		a.baseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		2, int(a.timeout.Seconds()), false,
+		fmt.Sprintf(a.userFilter, ldap.EscapeFilter(username)),
+		[]string{"dn", a.emailAttribute},
+		nil,
+	))
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			return nil, biz.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+	if len(res.Entries) != 1 {
+		if len(res.Entries) > 1 {
+			a.log.Warnf("ldap user filter matched %d entries for %s", len(res.Entries), username)
+		}
+		return nil, biz.ErrInvalidCredentials
+	}
+	entry := res.Entries[0]
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, biz.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+	return &repo.Identity{
+		Source:   ldapSourceName,
+		Username: username,
+		Email:    entry.GetAttributeValue(a.emailAttribute),
+	}, nil
+}
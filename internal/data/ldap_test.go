@@ -0,0 +1,130 @@
+package data
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"testing"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubLDAP 进程内的 LDAP 目录，只支持简单的等值过滤器
+type stubLDAP struct {
+	entries   map[string]*ldap.Entry // filter -> entry
+	passwords map[string]string      // dn -> password
+	bound     string
+}
+
+func (s *stubLDAP) Bind(username, password string) error {
+	if p, ok := s.passwords[username]; !ok || p != password {
+		return ldap.NewError(ldap.LDAPResultInvalidCredentials, nil)
+	}
+	s.bound = username
+	return nil
+}
+
+func (s *stubLDAP) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	if s.bound == "" {
+		return nil, ldap.NewError(ldap.LDAPResultInsufficientAccessRights, nil)
+	}
+	res := &ldap.SearchResult{}
+	if e, ok := s.entries[req.Filter]; ok {
+		res.Entries = append(res.Entries, e)
+	}
+	return res, nil
+}
+
+func (s *stubLDAP) StartTLS(config *tls.Config) error {
+	return nil
+}
+
+func (s *stubLDAP) Close() {}
+
+func newTestLDAPAuthenticator(t *testing.T) *ldapAuthenticator {
+	a, err := newLDAPAuthenticator(&conf.Auth_LDAP{
+		Url:          "ldap://ldap.example.com:389",
+		BindDn:       "cn=usm,dc=example,dc=com",
+		BindPassword: "usm",
+		BaseDn:       "ou=people,dc=example,dc=com",
+	}, log.NewStdLogger(os.Stdout))
+	assert.NoError(t, err)
+	a.dial = func() (ldapConn, error) {
+		return &stubLDAP{
+			entries: map[string]*ldap.Entry{
+				"(uid=alice)": ldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{
+					"mail": {"alice@example.com"},
+				}),
+				// 未转义的通配符会匹配到 alice
+				"(uid=*)": ldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", nil),
+			},
+			passwords: map[string]string{
+				"cn=usm,dc=example,dc=com":              "usm",
+				"uid=alice,ou=people,dc=example,dc=com": "secret",
+			},
+		}, nil
+	}
+	return a
+}
+
+func Test_ldapAuthenticator_Authenticate(t *testing.T) {
+	ctx := context.Background()
+	a := newTestLDAPAuthenticator(t)
+	type args struct {
+		username string
+		password string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *repo.Identity
+		wantErr error
+	}{
+		{
+			name: "should authenticate successfully",
+			args: args{username: "alice", password: "secret"},
+			want: &repo.Identity{Source: "ldap", Username: "alice", Email: "alice@example.com"},
+		},
+		{
+			name:    "should authenticate failed if password mismatch",
+			args:    args{username: "alice", password: "wrong"},
+			wantErr: biz.ErrInvalidCredentials,
+		},
+		{
+			name:    "should authenticate failed if password is empty",
+			args:    args{username: "alice", password: ""},
+			wantErr: biz.ErrInvalidCredentials,
+		},
+		{
+			name:    "should authenticate failed if user not found",
+			args:    args{username: "bob", password: "secret"},
+			wantErr: biz.ErrInvalidCredentials,
+		},
+		{
+			name:    "should escape username in filter",
+			args:    args{username: "*", password: "secret"},
+			wantErr: biz.ErrInvalidCredentials,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.Authenticate(ctx, tt.args.username, tt.args.password)
+			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
+			assert.Equal(t, tt.want, got, "mismatch: got=%v, want=%v", got, tt.want)
+		})
+	}
+}
+
+func Test_newLDAPAuthenticator(t *testing.T) {
+	_, err := newLDAPAuthenticator(&conf.Auth_LDAP{
+		Url:        "ldap://ldap.example.com",
+		UserFilter: "(uid=*)",
+	}, log.NewStdLogger(os.Stdout))
+	assert.Error(t, err)
+}
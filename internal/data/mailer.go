@@ -0,0 +1,138 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"sync"
+	"time"
+
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
+	"usm/pkg/secret"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func NewMailer(c *conf.Data, logger log.Logger) (repo.Mailer, func(), error) {
+	logger = log.With(logger, "module", "data")
+	mc := c.GetMail()
+	from := mc.GetFrom()
+	if from == "" {
+		from = "noreply@localhost"
+	}
+	addr, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid mail from address %q: %v", from, err)
+	}
+	if sc := mc.GetSmtp(); sc.GetAddr() != "" {
+		host, _, err := net.SplitHostPort(sc.Addr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid smtp addr %q: %v", sc.Addr, err)
+		}
+		username, err := secret.New(sc.GetUsernameRef().GetFile(), sc.GetUsernameRef().GetEnv(), sc.Username, logger)
+		if err != nil {
+			return nil, nil, fmt.Errorf("smtp username: %v", err)
+		}
+		password, err := secret.New(sc.GetPasswordRef().GetFile(), sc.GetPasswordRef().GetEnv(), sc.Password, logger)
+		if err != nil {
+			username.Close()
+			return nil, nil, fmt.Errorf("smtp password: %v", err)
+		}
+		m := &smtpMailer{
+			addr:     sc.Addr,
+			host:     host,
+			username: username,
+			password: password,
+			from:     addr,
+		}
+		return m, func() {
+			username.Close()
+			password.Close()
+		}, nil
+	}
+	return &fileMailer{
+		log:  log.NewHelper(logger),
+		path: mc.GetFile(),
+		from: addr,
+	}, func() {}, nil
+}
+
+// smtpMailer 通过 SMTP 发送邮件，smtp.SendMail 在服务端支持时自动使用 STARTTLS
+type smtpMailer struct {
+	addr     string
+	host     string
+	username *secret.Secret
+	password *secret.Secret
+	from     *mail.Address
+}
+
+func (m *smtpMailer) Send(ctx context.Context, msg *repo.Mail) error {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient %q: %v", msg.To, err)
+	}
+	return smtp.SendMail(m.addr, m.auth(), m.from.Address, []string{to.Address}, formatMail(m.from, to, msg))
+}
+
+// auth 每次发送时使用当前的用户名和密码，用户名为空时不认证
+func (m *smtpMailer) auth() smtp.Auth {
+	username := m.username.Value()
+	if username == "" {
+		return nil
+	}
+	return smtp.PlainAuth("", username, m.password.Value(), m.host)
+}
+
+// fileMailer 用于本地测试，将邮件追加写入文件，未配置文件时丢弃邮件，只记录收件人和主题
+type fileMailer struct {
+	log  *log.Helper
+	path string
+	from *mail.Address
+
+	mu sync.Mutex
+}
+
+func (m *fileMailer) Send(ctx context.Context, msg *repo.Mail) error {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient %q: %v", msg.To, err)
+	}
+	if m.path == "" {
+		// 邮件正文包含验证、重置密码和邀请令牌，不能写入日志
+		m.log.WithContext(ctx).Warnw("msg", "mail dropped, neither smtp addr nor mail file is configured", "to", to.Address, "subject", msg.Subject)
+		return nil
+	}
+	data := formatMail(m.from, to, msg)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	f, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// formatMail 生成 RFC 5322 格式的纯文本邮件，地址已经过解析，主题使用 RFC 2047 编码，避免头注入
+func formatMail(from, to *mail.Address, msg *repo.Mail) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from.String())
+	fmt.Fprintf(&b, "To: %s\r\n", to.String())
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(msg.Body)
+	return b.Bytes()
+}
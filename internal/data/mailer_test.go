@@ -0,0 +1,93 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_fileMailer(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "mail.log")
+	m, cleanup, err := NewMailer(&conf.Data{Mail: &conf.Data_Mail{From: "USM <noreply@example.com>", File: path}}, log.DefaultLogger)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	assert.NoError(t, m.Send(ctx, &repo.Mail{To: "alice@example.com", Subject: "验证邮箱", Body: "token: abc"}))
+	assert.Error(t, m.Send(ctx, &repo.Mail{To: "alice@example.com\r\nBcc: eve@example.com", Subject: "x"}))
+
+	b, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	s := string(b)
+	assert.Contains(t, s, "From: \"USM\" <noreply@example.com>\r\n")
+	assert.Contains(t, s, "To: <alice@example.com>\r\n")
+	assert.Contains(t, s, "Subject: =?utf-8?q?")
+	assert.True(t, strings.HasSuffix(s, "token: abc\n"))
+	assert.NotContains(t, s, "eve@example.com")
+}
+
+func Test_fileMailer_NoFile(t *testing.T) {
+	var buf bytes.Buffer
+	m, cleanup, err := NewMailer(&conf.Data{Mail: &conf.Data_Mail{}}, log.NewStdLogger(&buf))
+	require.NoError(t, err)
+	defer cleanup()
+	token := "reset-token-6f1d2c"
+	assert.NoError(t, m.Send(context.Background(), &repo.Mail{
+		To:      "alice@example.com",
+		Subject: "重置密码",
+		Body:    "https://usm.example.com/reset?token=" + token,
+	}))
+	assert.Contains(t, buf.String(), "mail dropped")
+	assert.NotContains(t, buf.String(), token)
+}
+
+func Test_NewMailer(t *testing.T) {
+	_, _, err := NewMailer(&conf.Data{Mail: &conf.Data_Mail{From: "not an address"}}, log.DefaultLogger)
+	assert.Error(t, err)
+	_, _, err = NewMailer(&conf.Data{Mail: &conf.Data_Mail{Smtp: &conf.Data_Mail_SMTP{Addr: "smtp.example.com"}}}, log.DefaultLogger)
+	assert.Error(t, err)
+	m, cleanup, err := NewMailer(&conf.Data{Mail: &conf.Data_Mail{Smtp: &conf.Data_Mail_SMTP{Addr: "smtp.example.com:587", Username: "u"}}}, log.DefaultLogger)
+	assert.NoError(t, err)
+	assert.IsType(t, &smtpMailer{}, m)
+	cleanup()
+	_, _, err = NewMailer(&conf.Data{Mail: &conf.Data_Mail{Smtp: &conf.Data_Mail_SMTP{
+		Addr:        "smtp.example.com:587",
+		PasswordRef: &conf.SecretRef{File: filepath.Join(t.TempDir(), "missing")},
+	}}}, log.DefaultLogger)
+	assert.Error(t, err)
+}
+
+func Test_smtpMailer_auth(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(path, []byte("old\n"), 0o600))
+	t.Setenv("TEST_SMTP_USERNAME", "u")
+	m, cleanup, err := NewMailer(&conf.Data{Mail: &conf.Data_Mail{Smtp: &conf.Data_Mail_SMTP{
+		Addr:        "smtp.example.com:587",
+		Password:    "plain",
+		UsernameRef: &conf.SecretRef{Env: "TEST_SMTP_USERNAME"},
+		PasswordRef: &conf.SecretRef{File: path},
+	}}}, log.DefaultLogger)
+	require.NoError(t, err)
+	defer cleanup()
+	sm := m.(*smtpMailer)
+	assert.Equal(t, "u", sm.username.Value())
+	assert.Equal(t, "old", sm.password.Value())
+	assert.NotNil(t, sm.auth())
+
+	// 密钥文件更新后下一次发送使用新密码
+	require.NoError(t, os.WriteFile(path, []byte("new\n"), 0o600))
+	assert.Eventually(t, func() bool { return sm.password.Value() == "new" }, 3*time.Second, 20*time.Millisecond)
+}
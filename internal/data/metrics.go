@@ -0,0 +1,128 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"usm/internal/biz/repo"
+	kprom "usm/pkg/kratos/contrib/metrics/prometheus"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// 指标注册到注入的 Registerer，服务运行时是 Prometheus 默认的 Registry，由 HTTP 服务的 /metrics 导出
+var (
+	loginsTotalOpts = prometheus.CounterOpts{
+		Namespace: "usm",
+		Subsystem: "account",
+		Name:      "logins_total",
+		Help:      "Total number of login attempts by method and result.",
+	}
+	usersCreatedTotalOpts = prometheus.CounterOpts{
+		Namespace: "usm",
+		Subsystem: "account",
+		Name:      "users_created_total",
+		Help:      "Total number of users created by origin.",
+	}
+	dbQuerySecondsOpts = prometheus.HistogramOpts{
+		Namespace: "usm",
+		Subsystem: "db",
+		Name:      "query_seconds",
+		Help:      "Database statement latency in seconds by operation.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}
+)
+
+type metrics struct {
+	logins       *prometheus.CounterVec
+	usersCreated *prometheus.CounterVec
+}
+
+func NewMetrics(reg prometheus.Registerer) (repo.Metrics, error) {
+	logins, err := kprom.RegisterCounterVec(reg, loginsTotalOpts, "method", "success")
+	if err != nil {
+		return nil, err
+	}
+	usersCreated, err := kprom.RegisterCounterVec(reg, usersCreatedTotalOpts, "origin")
+	if err != nil {
+		return nil, err
+	}
+	return &metrics{logins: logins, usersCreated: usersCreated}, nil
+}
+
+func (m *metrics) LoginAttempted(method string, success bool) {
+	m.logins.WithLabelValues(method, strconv.FormatBool(success)).Inc()
+}
+
+func (m *metrics) UsersCreated(origin string, n int) {
+	m.usersCreated.WithLabelValues(origin).Add(float64(n))
+}
+
+// registerDBStats 导出连接池状态，返回取消注册的函数。
+// 已注册的收集器读取的是另一个连接池，不能复用，只记录警告
+func registerDBStats(reg prometheus.Registerer, db *sql.DB, name string, logger *log.Helper) (func(), error) {
+	c := collectors.NewDBStatsCollector(db, name)
+	if err := reg.Register(c); err != nil {
+		if errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+			logger.Warnf("database %s pool stats are already registered, stats of this pool are not exported", name)
+			return func() {}, nil
+		}
+		return nil, fmt.Errorf("register database stats: %w", err)
+	}
+	return func() { reg.Unregister(c) }, nil
+}
+
+func observeQuery(seconds *prometheus.HistogramVec, operation string, start time.Time, err error) {
+	seconds.WithLabelValues(operation, strconv.FormatBool(err == nil)).Observe(time.Since(start).Seconds())
+}
+
+// metricsDriver 记录每条语句和事务提交的耗时
+type metricsDriver struct {
+	dialect.Driver
+	seconds *prometheus.HistogramVec
+}
+
+func (d *metricsDriver) Exec(ctx context.Context, query string, args, v interface{}) (err error) {
+	defer func(start time.Time) { observeQuery(d.seconds, "exec", start, err) }(time.Now())
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *metricsDriver) Query(ctx context.Context, query string, args, v interface{}) (err error) {
+	defer func(start time.Time) { observeQuery(d.seconds, "query", start, err) }(time.Now())
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *metricsDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &metricsTx{Tx: tx, seconds: d.seconds}, nil
+}
+
+type metricsTx struct {
+	dialect.Tx
+	seconds *prometheus.HistogramVec
+}
+
+func (tx *metricsTx) Exec(ctx context.Context, query string, args, v interface{}) (err error) {
+	defer func(start time.Time) { observeQuery(tx.seconds, "exec", start, err) }(time.Now())
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+func (tx *metricsTx) Query(ctx context.Context, query string, args, v interface{}) (err error) {
+	defer func(start time.Time) { observeQuery(tx.seconds, "query", start, err) }(time.Now())
+	return tx.Tx.Query(ctx, query, args, v)
+}
+
+func (tx *metricsTx) Commit() (err error) {
+	defer func(start time.Time) { observeQuery(tx.seconds, "commit", start, err) }(time.Now())
+	return tx.Tx.Commit()
+}
@@ -0,0 +1,80 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m, err := NewMetrics(reg)
+	require.NoError(t, err)
+	m.LoginAttempted("basic", false)
+	m.UsersCreated("batch", 3)
+
+	// 同一个 Registry 上再次创建时复用已注册的指标
+	again, err := NewMetrics(reg)
+	require.NoError(t, err)
+	again.LoginAttempted("basic", false)
+	assert.NoError(t, testutil.GatherAndCompare(reg, bytes.NewBufferString(`
+# HELP usm_account_logins_total Total number of login attempts by method and result.
+# TYPE usm_account_logins_total counter
+usm_account_logins_total{method="basic",success="false"} 2
+# HELP usm_account_users_created_total Total number of users created by origin.
+# TYPE usm_account_users_created_total counter
+usm_account_users_created_total{origin="batch"} 3
+`)))
+}
+
+func TestRegisterDBStats(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	db, err := entsql.Open(dialect.SQLite, "file:dbstats?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	var buf bytes.Buffer
+	logger := log.NewHelper(log.NewStdLogger(&buf))
+
+	unregister, err := registerDBStats(reg, db.DB(), "sqlite3", logger)
+	require.NoError(t, err)
+	// 另一个同名连接池不能复用已注册的收集器，记录警告
+	unregisterAgain, err := registerDBStats(reg, db.DB(), "sqlite3", logger)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "database sqlite3 pool stats are already registered")
+	unregisterAgain()
+	n, err := testutil.GatherAndCount(reg, "go_sql_open_connections")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	unregister()
+	n, err = testutil.GatherAndCount(reg, "go_sql_open_connections")
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestMetricsDriver(t *testing.T) {
+	ctx := context.Background()
+	drv, err := entsql.Open(dialect.SQLite, "file:metrics?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	seconds := prometheus.NewHistogramVec(dbQuerySecondsOpts, []string{"operation", "success"})
+	d := &metricsDriver{Driver: drv, seconds: seconds}
+
+	assert.NoError(t, d.Exec(ctx, "CREATE TABLE t (id INTEGER)", []interface{}{}, nil))
+	assert.Error(t, d.Exec(ctx, "INSERT INTO missing VALUES (1)", []interface{}{}, nil))
+	tx, err := d.Tx(ctx)
+	require.NoError(t, err)
+	assert.NoError(t, tx.Exec(ctx, "INSERT INTO t VALUES (1)", []interface{}{}, nil))
+	assert.NoError(t, tx.Commit())
+
+	// exec/true、exec/false、commit/true
+	assert.Equal(t, 3, testutil.CollectAndCount(seconds))
+}
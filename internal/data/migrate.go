@@ -0,0 +1,88 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"usm/internal/biz/repo"
+	"usm/internal/data/ent"
+	"usm/internal/data/ent/user"
+)
+
+// migrateUserKeys 为升级前创建的用户补全 username_key 和 email_key。
+// 规范化后用户名或邮箱相同的用户（例如 Alice 和 alice）无法自动合并，此时返回错误并列出冲突的用户，需要先修改
+func migrateUserKeys(ctx context.Context, client *ent.Client) error {
+	pending, err := client.User.Query().Where(user.Or(
+		user.UsernameKeyIsNil(),
+		user.And(user.EmailNEQ(""), user.EmailKeyIsNil()),
+	)).Count(ctx)
+	if err != nil || pending == 0 {
+		return err
+	}
+	if err := checkUserCollisions(ctx, client); err != nil {
+		return err
+	}
+	users, err := client.User.Query().Select(user.FieldID, user.FieldUsername, user.FieldEmail).All(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		up := tx.User.UpdateOneID(u.ID).SetUsernameKey(repo.IdentifierKey(u.Username))
+		if u.Email != "" {
+			up.SetEmailKey(repo.IdentifierKey(u.Email))
+		}
+		if err := up.Exec(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// checkUserCollisions 检查规范化后重复的用户名和邮箱，只读取升级前就存在的列，
+// 因此也可以在创建唯一索引失败后调用以给出具体的冲突用户
+func checkUserCollisions(ctx context.Context, client *ent.Client) error {
+	users, err := client.User.Query().
+		Select(user.FieldID, user.FieldUsername, user.FieldEmail).
+		Order(ent.Asc(user.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	collisions := collisionsOf("username", users, func(u *ent.User) string { return u.Username })
+	collisions = append(collisions, collisionsOf("email", users, func(u *ent.User) string { return u.Email })...)
+	if len(collisions) == 0 {
+		return nil
+	}
+	return fmt.Errorf("users collide after normalization, rename them or change their emails before upgrading: %s",
+		strings.Join(collisions, "; "))
+}
+
+func collisionsOf(attr string, users []*ent.User, value func(*ent.User) string) []string {
+	byKey := make(map[string][]*ent.User)
+	for _, u := range users {
+		if v := value(u); v != "" {
+			key := repo.IdentifierKey(v)
+			byKey[key] = append(byKey[key], u)
+		}
+	}
+	var collisions []string
+	for key, us := range byKey {
+		if len(us) < 2 {
+			continue
+		}
+		names := make([]string, 0, len(us))
+		for _, u := range us {
+			names = append(names, fmt.Sprintf("%q (id=%d)", value(u), u.ID))
+		}
+		collisions = append(collisions, fmt.Sprintf("%s %q: %s", attr, key, strings.Join(names, ", ")))
+	}
+	sort.Strings(collisions)
+	return collisions
+}
@@ -0,0 +1,52 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"usm/internal/data/ent/user"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_migrateUserKeys(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		usernames []string
+		wantErr   bool
+	}{
+		{
+			name:      "should backfill keys of existing users",
+			usernames: []string{"Alice", "bob"},
+		},
+		{
+			name:      "should fail if usernames collide after normalization",
+			usernames: []string{"Alice", "alice", "bob"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, teardown := NewTestData(t)
+			defer teardown()
+			// 模拟升级前的数据，没有 username_key 和 email_key
+			for _, name := range tt.usernames {
+				data.db.User.Create().SetUsername(name).SetEmail(name + "@Example.com").SetPassword("").SaveX(ctx)
+			}
+			err := migrateUserKeys(ctx, data.db)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), `"alice"`)
+				return
+			}
+			assert.NoError(t, err)
+			n, err := data.db.User.Query().Where(user.Or(user.UsernameKeyIsNil(), user.EmailKeyIsNil())).Count(ctx)
+			assert.NoError(t, err)
+			assert.Zero(t, n)
+			u, err := NewUserRepo(data).GetByUsername(ctx, "ALICE")
+			assert.NoError(t, err)
+			assert.Equal(t, "Alice", u.Username)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package data
+
+import (
+	"context"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+)
+
+// mailNotifier 通过邮件通知用户
+type mailNotifier struct {
+	mailer repo.Mailer
+}
+
+func NewNotifier(mailer repo.Mailer) repo.Notifier {
+	return &mailNotifier{
+		mailer: mailer,
+	}
+}
+
+func (n *mailNotifier) Notify(ctx context.Context, u *repo.User, m *repo.Notification) error {
+	if u.Email == "" {
+		return biz.ErrEmailNotSet
+	}
+	return n.mailer.Send(ctx, &repo.Mail{
+		To:      u.Email,
+		Subject: m.Subject,
+		Body:    m.Body,
+	})
+}
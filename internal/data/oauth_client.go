@@ -0,0 +1,115 @@
+package data
+
+import (
+	"context"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/data/ent"
+	"usm/internal/data/ent/oauthclient"
+)
+
+type oauthClientRepo struct {
+	data *Data
+}
+
+func NewOAuthClientRepo(data *Data) repo.OAuthClientRepo {
+	return &oauthClientRepo{
+		data: data,
+	}
+}
+
+func (r *oauthClientRepo) Create(ctx context.Context, m *repo.OAuthClient) (*repo.OAuthClient, error) {
+	c := r.data.DB(ctx).OAuthClient.
+		Create().
+		SetClientID(m.ClientID).
+		SetName(m.Name).
+		SetRedirectUris(m.RedirectURIs).
+		SetGrantTypes(m.GrantTypes).
+		SetScopes(m.Scopes).
+		SetPublic(m.Public)
+	if m.SecretHash != "" {
+		c.SetSecretHash(m.SecretHash)
+	}
+	if m.ServiceAccountID != 0 {
+		c.SetServiceAccountID(int64(m.ServiceAccountID))
+	}
+	oc, err := c.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.ErrResourceAlreadyExists
+		}
+		return nil, err
+	}
+	return r.clientFromEntity(oc), nil
+}
+
+func (r *oauthClientRepo) Delete(ctx context.Context, id int) error {
+	err := r.data.DB(ctx).OAuthClient.DeleteOneID(int64(id)).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
+}
+
+func (r *oauthClientRepo) Get(ctx context.Context, id int) (*repo.OAuthClient, error) {
+	oc, err := r.data.DB(ctx).OAuthClient.Get(ctx, int64(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.clientFromEntity(oc), nil
+}
+
+func (r *oauthClientRepo) GetByClientID(ctx context.Context, clientID string) (*repo.OAuthClient, error) {
+	oc, err := r.data.DB(ctx).OAuthClient.Query().Where(
+		oauthclient.ClientID(clientID),
+	).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.clientFromEntity(oc), nil
+}
+
+func (r *oauthClientRepo) List(ctx context.Context, offset, limit int) ([]*repo.OAuthClient, error) {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	ents, err := r.data.DB(ctx).OAuthClient.Query().
+		Order(ent.Asc(oauthclient.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clients := make([]*repo.OAuthClient, 0, len(ents))
+	for _, oc := range ents {
+		clients = append(clients, r.clientFromEntity(oc))
+	}
+	return clients, nil
+}
+
+func (r *oauthClientRepo) clientFromEntity(oc *ent.OAuthClient) *repo.OAuthClient {
+	return &repo.OAuthClient{
+		ID:               int(oc.ID),
+		ClientID:         oc.ClientID,
+		SecretHash:       oc.SecretHash,
+		Name:             oc.Name,
+		RedirectURIs:     oc.RedirectUris,
+		GrantTypes:       oc.GrantTypes,
+		Scopes:           oc.Scopes,
+		Public:           oc.Public,
+		ServiceAccountID: int(oc.ServiceAccountID),
+		CreateTime:       oc.CreateTime,
+		UpdateTime:       oc.UpdateTime,
+	}
+}
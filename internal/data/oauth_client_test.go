@@ -0,0 +1,96 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_oauthClientRepo_Create(t *testing.T) {
+	ctx := context.Background()
+	type args struct {
+		client *repo.OAuthClient
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *repo.OAuthClient
+		wantErr error
+	}{
+		{
+			name: "should create client successfully",
+			args: args{
+				client: &repo.OAuthClient{
+					ClientID:     "web",
+					SecretHash:   "hash",
+					Name:         "web",
+					RedirectURIs: []string{"https://app.example.com/callback"},
+					GrantTypes:   []string{repo.GrantTypeAuthorizationCode},
+					Scopes:       []string{"openid"},
+				},
+			},
+			want: &repo.OAuthClient{
+				ID:           1,
+				ClientID:     "web",
+				SecretHash:   "hash",
+				Name:         "web",
+				RedirectURIs: []string{"https://app.example.com/callback"},
+				GrantTypes:   []string{repo.GrantTypeAuthorizationCode},
+				Scopes:       []string{"openid"},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, teardown := NewTestData(t)
+			defer teardown()
+			r := NewOAuthClientRepo(data)
+			got, err := r.Create(ctx, tt.args.client)
+			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
+			got.CreateTime = time.Time{}
+			got.UpdateTime = time.Time{}
+			assert.Equal(t, tt.want, got, "mismatch: got=%v, want=%v", got, tt.want)
+
+			got, err = r.GetByClientID(ctx, "web")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.RedirectURIs, got.RedirectURIs)
+
+			_, err = r.Create(ctx, tt.args.client)
+			assert.Equal(t, biz.ErrResourceAlreadyExists, err)
+		})
+	}
+}
+
+func Test_oauthTokenRepo_RevokeByUser(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	r := NewOAuthTokenRepo(data)
+	expireTime := time.Now().Add(time.Hour)
+	for i, hash := range []string{"a", "b"} {
+		_, err := r.Create(ctx, &repo.OAuthToken{
+			TokenHash:  hash,
+			Kind:       repo.TokenKindAccess,
+			ClientID:   "web",
+			UserID:     i + 1,
+			Scopes:     []string{"openid"},
+			ExpireTime: expireTime,
+		})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, r.RevokeByUser(ctx, 1))
+	got, err := r.GetByHash(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, got.Revoked)
+	got, err = r.GetByHash(ctx, "b")
+	assert.NoError(t, err)
+	assert.False(t, got.Revoked)
+	_, err = r.GetByHash(ctx, "c")
+	assert.Equal(t, biz.ErrResourceNotFound, err)
+}
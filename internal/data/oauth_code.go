@@ -0,0 +1,79 @@
+package data
+
+import (
+	"context"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/data/ent"
+	"usm/internal/data/ent/oauthcode"
+)
+
+type oauthCodeRepo struct {
+	data *Data
+}
+
+func NewOAuthCodeRepo(data *Data) repo.OAuthCodeRepo {
+	return &oauthCodeRepo{
+		data: data,
+	}
+}
+
+func (r *oauthCodeRepo) Create(ctx context.Context, m *repo.OAuthCode) (*repo.OAuthCode, error) {
+	c, err := r.data.DB(ctx).OAuthCode.
+		Create().
+		SetCodeHash(m.CodeHash).
+		SetClientID(m.ClientID).
+		SetUserID(int64(m.UserID)).
+		SetRedirectURI(m.RedirectURI).
+		SetScopes(m.Scopes).
+		SetCodeChallenge(m.CodeChallenge).
+		SetCodeChallengeMethod(m.CodeChallengeMethod).
+		SetNonce(m.Nonce).
+		SetExpireTime(m.ExpireTime).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.ErrResourceAlreadyExists
+		}
+		return nil, err
+	}
+	return r.codeFromEntity(c), nil
+}
+
+func (r *oauthCodeRepo) Delete(ctx context.Context, id int) error {
+	err := r.data.DB(ctx).OAuthCode.DeleteOneID(int64(id)).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
+}
+
+func (r *oauthCodeRepo) GetByHash(ctx context.Context, hash string) (*repo.OAuthCode, error) {
+	c, err := r.data.DB(ctx).OAuthCode.Query().Where(
+		oauthcode.CodeHash(hash),
+	).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.codeFromEntity(c), nil
+}
+
+func (r *oauthCodeRepo) codeFromEntity(c *ent.OAuthCode) *repo.OAuthCode {
+	return &repo.OAuthCode{
+		ID:                  int(c.ID),
+		CodeHash:            c.CodeHash,
+		ClientID:            c.ClientID,
+		UserID:              int(c.UserID),
+		RedirectURI:         c.RedirectURI,
+		Scopes:              c.Scopes,
+		CodeChallenge:       c.CodeChallenge,
+		CodeChallengeMethod: c.CodeChallengeMethod,
+		Nonce:               c.Nonce,
+		ExpireTime:          c.ExpireTime,
+		CreateTime:          c.CreateTime,
+	}
+}
@@ -0,0 +1,46 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_oauthCodeRepo(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	r := NewOAuthCodeRepo(data)
+
+	c, err := r.Create(ctx, &repo.OAuthCode{
+		CodeHash:            "hash-1",
+		ClientID:            "web",
+		UserID:              1,
+		RedirectURI:         "https://app.example.com/callback",
+		Scopes:              []string{"openid", "email"},
+		CodeChallenge:       "challenge",
+		CodeChallengeMethod: "S256",
+		Nonce:               "nonce",
+		ExpireTime:          time.Now().Add(time.Minute),
+	})
+	assert.NoError(t, err)
+	_, err = r.Create(ctx, &repo.OAuthCode{CodeHash: "hash-1", ClientID: "web", UserID: 1, RedirectURI: "https://app.example.com/callback", ExpireTime: time.Now()})
+	assert.Equal(t, biz.ErrResourceAlreadyExists, err)
+
+	got, err := r.GetByHash(ctx, "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, c.ID, got.ID)
+	assert.Equal(t, []string{"openid", "email"}, got.Scopes)
+	assert.Equal(t, "S256", got.CodeChallengeMethod)
+	assert.Equal(t, "nonce", got.Nonce)
+
+	assert.NoError(t, r.Delete(ctx, c.ID))
+	assert.Equal(t, biz.ErrResourceNotFound, r.Delete(ctx, c.ID), "code must be deleted only once")
+	_, err = r.GetByHash(ctx, "hash-1")
+	assert.Equal(t, biz.ErrResourceNotFound, err)
+}
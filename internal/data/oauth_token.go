@@ -0,0 +1,89 @@
+package data
+
+import (
+	"context"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/data/ent"
+	"usm/internal/data/ent/oauthtoken"
+)
+
+type oauthTokenRepo struct {
+	data *Data
+}
+
+func NewOAuthTokenRepo(data *Data) repo.OAuthTokenRepo {
+	return &oauthTokenRepo{
+		data: data,
+	}
+}
+
+func (r *oauthTokenRepo) Create(ctx context.Context, m *repo.OAuthToken) (*repo.OAuthToken, error) {
+	t, err := r.data.DB(ctx).OAuthToken.
+		Create().
+		SetTokenHash(m.TokenHash).
+		SetKind(oauthtoken.Kind(m.Kind)).
+		SetClientID(m.ClientID).
+		SetUserID(int64(m.UserID)).
+		SetScopes(m.Scopes).
+		SetExpireTime(m.ExpireTime).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.ErrResourceAlreadyExists
+		}
+		return nil, err
+	}
+	return r.tokenFromEntity(t), nil
+}
+
+func (r *oauthTokenRepo) GetByHash(ctx context.Context, hash string) (*repo.OAuthToken, error) {
+	t, err := r.data.DB(ctx).OAuthToken.Query().Where(
+		oauthtoken.TokenHash(hash),
+	).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.tokenFromEntity(t), nil
+}
+
+func (r *oauthTokenRepo) Revoke(ctx context.Context, id int) error {
+	n, err := r.data.DB(ctx).OAuthToken.Update().Where(
+		oauthtoken.ID(int64(id)),
+		oauthtoken.Revoked(false),
+	).SetRevoked(true).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return biz.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (r *oauthTokenRepo) RevokeByUser(ctx context.Context, userID int) error {
+	_, err := r.data.DB(ctx).OAuthToken.Update().Where(
+		oauthtoken.UserID(int64(userID)),
+		oauthtoken.Revoked(false),
+	).SetRevoked(true).Save(ctx)
+	return err
+}
+
+func (r *oauthTokenRepo) tokenFromEntity(t *ent.OAuthToken) *repo.OAuthToken {
+	return &repo.OAuthToken{
+		ID:         int(t.ID),
+		TokenHash:  t.TokenHash,
+		Kind:       repo.TokenKind(t.Kind),
+		ClientID:   t.ClientID,
+		UserID:     int(t.UserID),
+		Scopes:     t.Scopes,
+		ExpireTime: t.ExpireTime,
+		Revoked:    t.Revoked,
+		CreateTime: t.CreateTime,
+		UpdateTime: t.UpdateTime,
+	}
+}
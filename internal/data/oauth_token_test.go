@@ -0,0 +1,55 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_oauthTokenRepo(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	r := NewOAuthTokenRepo(data)
+
+	exp := time.Now().Add(time.Hour)
+	refresh, err := r.Create(ctx, &repo.OAuthToken{TokenHash: "hash-1", Kind: repo.TokenKindRefresh, ClientID: "web", UserID: 1, Scopes: []string{"openid"}, ExpireTime: exp})
+	assert.NoError(t, err)
+	access, err := r.Create(ctx, &repo.OAuthToken{TokenHash: "hash-2", Kind: repo.TokenKindAccess, ClientID: "web", UserID: 1, ExpireTime: exp})
+	assert.NoError(t, err)
+	other, err := r.Create(ctx, &repo.OAuthToken{TokenHash: "hash-3", Kind: repo.TokenKindAccess, ClientID: "web", UserID: 2, ExpireTime: exp})
+	assert.NoError(t, err)
+	_, err = r.Create(ctx, &repo.OAuthToken{TokenHash: "hash-1", Kind: repo.TokenKindAccess, ClientID: "web", UserID: 1, ExpireTime: exp})
+	assert.Equal(t, biz.ErrResourceAlreadyExists, err)
+
+	got, err := r.GetByHash(ctx, "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, refresh.ID, got.ID)
+	assert.Equal(t, repo.TokenKindRefresh, got.Kind)
+	assert.True(t, got.Active(time.Now()))
+
+	assert.NoError(t, r.Revoke(ctx, refresh.ID))
+	assert.Equal(t, biz.ErrResourceNotFound, r.Revoke(ctx, refresh.ID), "revoked token must not be revoked again")
+	assert.Equal(t, biz.ErrResourceNotFound, r.Revoke(ctx, 99))
+	got, err = r.GetByHash(ctx, "hash-1")
+	assert.NoError(t, err)
+	assert.True(t, got.Revoked)
+
+	assert.NoError(t, r.RevokeByUser(ctx, 1))
+	got, err = r.GetByHash(ctx, "hash-2")
+	assert.NoError(t, err)
+	assert.Equal(t, access.ID, got.ID)
+	assert.True(t, got.Revoked)
+	got, err = r.GetByHash(ctx, "hash-3")
+	assert.NoError(t, err)
+	assert.Equal(t, other.ID, got.ID)
+	assert.False(t, got.Revoked)
+
+	_, err = r.GetByHash(ctx, "missing")
+	assert.Equal(t, biz.ErrResourceNotFound, err)
+}
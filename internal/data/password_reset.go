@@ -0,0 +1,66 @@
+package data
+
+import (
+	"context"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/data/ent"
+	"usm/internal/data/ent/passwordreset"
+)
+
+type passwordResetRepo struct {
+	data *Data
+}
+
+func NewPasswordResetRepo(data *Data) repo.PasswordResetRepo {
+	return &passwordResetRepo{
+		data: data,
+	}
+}
+
+func (r *passwordResetRepo) Create(ctx context.Context, m *repo.PasswordReset) (*repo.PasswordReset, error) {
+	p, err := r.data.DB(ctx).PasswordReset.
+		Create().
+		SetUserID(int64(m.UserID)).
+		SetTokenHash(m.TokenHash).
+		SetExpireTime(m.ExpireTime).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.ErrResourceAlreadyExists
+		}
+		return nil, err
+	}
+	return r.resetFromEntity(p), nil
+}
+
+func (r *passwordResetRepo) GetByHash(ctx context.Context, hash string) (*repo.PasswordReset, error) {
+	p, err := r.data.DB(ctx).PasswordReset.Query().Where(
+		passwordreset.TokenHash(hash),
+	).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.resetFromEntity(p), nil
+}
+
+func (r *passwordResetRepo) DeleteByUser(ctx context.Context, userID int) error {
+	_, err := r.data.DB(ctx).PasswordReset.Delete().Where(
+		passwordreset.UserID(int64(userID)),
+	).Exec(ctx)
+	return err
+}
+
+func (r *passwordResetRepo) resetFromEntity(p *ent.PasswordReset) *repo.PasswordReset {
+	return &repo.PasswordReset{
+		ID:         int(p.ID),
+		UserID:     int(p.UserID),
+		TokenHash:  p.TokenHash,
+		ExpireTime: p.ExpireTime,
+		CreateTime: p.CreateTime,
+	}
+}
@@ -0,0 +1,47 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/biz/repo/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_passwordResetRepo(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	r := NewPasswordResetRepo(data)
+
+	_, err := r.Create(ctx, &repo.PasswordReset{UserID: 1, TokenHash: "hash-1", ExpireTime: time.Now().Add(time.Hour)})
+	assert.NoError(t, err)
+	_, err = r.Create(ctx, &repo.PasswordReset{UserID: 2, TokenHash: "hash-2", ExpireTime: time.Now().Add(time.Hour)})
+	assert.NoError(t, err)
+
+	got, err := r.GetByHash(ctx, "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, got.UserID)
+
+	assert.NoError(t, r.DeleteByUser(ctx, 1))
+	_, err = r.GetByHash(ctx, "hash-1")
+	assert.Equal(t, biz.ErrResourceNotFound, err)
+	_, err = r.GetByHash(ctx, "hash-2")
+	assert.NoError(t, err)
+}
+
+func Test_mailNotifier(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mailer := mock.NewMockMailer(ctrl)
+	mailer.EXPECT().Send(gomock.Any(), &repo.Mail{To: "alice@example.com", Subject: "s", Body: "b"}).Return(nil)
+	n := NewNotifier(mailer)
+
+	assert.NoError(t, n.Notify(ctx, &repo.User{Email: "alice@example.com"}, &repo.Notification{Subject: "s", Body: "b"}))
+	assert.Equal(t, biz.ErrEmailNotSet, n.Notify(ctx, &repo.User{}, &repo.Notification{Subject: "s", Body: "b"}))
+}
@@ -0,0 +1,178 @@
+package data
+
+import (
+	"context"
+	"crypto/x509"
+	"database/sql/driver"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
+	"usm/internal/data/ent"
+	"usm/internal/data/ent/signingkey"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// signingKeyLockID postgres 咨询锁的 ID，所有实例使用相同的值
+const signingKeyLockID = 0x75736d6b6579 // "usmkey"
+
+type signingKeyRepo struct {
+	data   *Data
+	cipher *keyCipher
+	// mu 同一进程内互斥，sqlite 只在进程内加锁
+	mu sync.Mutex
+}
+
+func NewSigningKeyRepo(data *Data, c *conf.Data, logger log.Logger) (repo.SigningKeyRepo, func(), error) {
+	kc, err := newKeyCipher(c.GetSigningKeyEncryption(), log.With(logger, "module", "data"))
+	if err != nil {
+		return nil, nil, err
+	}
+	return &signingKeyRepo{
+		data:   data,
+		cipher: kc,
+	}, kc.close, nil
+}
+
+func (r *signingKeyRepo) Create(ctx context.Context, m *repo.SigningKey) (*repo.SigningKey, error) {
+	block := &pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(m.PrivateKey),
+	}
+	stored, err := r.cipher.seal(m.KID, pem.EncodeToMemory(block))
+	if err != nil {
+		return nil, err
+	}
+	c := r.data.DB(ctx).SigningKey.
+		Create().
+		SetKid(m.KID).
+		SetAlgorithm(m.Algorithm).
+		SetPrivateKey(stored)
+	if m.Status != "" {
+		c.SetStatus(signingkey.Status(m.Status))
+	}
+	k, err := c.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.ErrResourceAlreadyExists
+		}
+		return nil, err
+	}
+	return r.signingKeyFromEntity(k)
+}
+
+func (r *signingKeyRepo) Active(ctx context.Context) (*repo.SigningKey, error) {
+	k, err := r.data.DB(ctx).SigningKey.Query().Where(
+		signingkey.StatusEQ(signingkey.StatusActive),
+	).Order(ent.Desc(signingkey.FieldActivateTime)).First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.signingKeyFromEntity(k)
+}
+
+func (r *signingKeyRepo) List(ctx context.Context) ([]*repo.SigningKey, error) {
+	ks, err := r.data.DB(ctx).SigningKey.Query().Order(ent.Asc(signingkey.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var res []*repo.SigningKey
+	for _, k := range ks {
+		m, err := r.signingKeyFromEntity(k)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, m)
+	}
+	return res, nil
+}
+
+func (r *signingKeyRepo) Activate(ctx context.Context, id int, now time.Time) error {
+	err := r.data.DB(ctx).SigningKey.UpdateOneID(int64(id)).
+		SetStatus(signingkey.StatusActive).
+		SetActivateTime(now).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
+}
+
+func (r *signingKeyRepo) Retire(ctx context.Context, id int, now time.Time) error {
+	err := r.data.DB(ctx).SigningKey.UpdateOneID(int64(id)).
+		SetStatus(signingkey.StatusRetired).
+		SetRetireTime(now).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
+}
+
+func (r *signingKeyRepo) DeleteRetiredBefore(ctx context.Context, t time.Time) (int, error) {
+	return r.data.DB(ctx).SigningKey.Delete().Where(
+		signingkey.StatusEQ(signingkey.StatusRetired),
+		signingkey.RetireTimeLT(t),
+	).Exec(ctx)
+}
+
+// Lock 在独立的连接上获取 postgres 会话级的咨询锁，持有到 unlock 调用，期间其他实例的维护操作等待
+func (r *signingKeyRepo) Lock(ctx context.Context) (func(), error) {
+	r.mu.Lock()
+	if r.data.drv.Dialect() != dialect.Postgres {
+		return r.mu.Unlock, nil
+	}
+	conn, err := r.data.drv.DB().Conn(ctx)
+	if err != nil {
+		r.mu.Unlock()
+		return nil, err
+	}
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", signingKeyLockID); err != nil {
+		conn.Close()
+		r.mu.Unlock()
+		return nil, fmt.Errorf("lock signing keys: %w", err)
+	}
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", signingKeyLockID); err != nil {
+			// 释放失败时丢弃连接而不是放回连接池，会话结束时数据库释放锁
+			conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+		}
+		conn.Close()
+		r.mu.Unlock()
+	}, nil
+}
+
+func (r *signingKeyRepo) signingKeyFromEntity(k *ent.SigningKey) (*repo.SigningKey, error) {
+	plain, err := r.cipher.open(k.Kid, k.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(plain)
+	if block == nil {
+		return nil, errors.New("invalid PEM signing key")
+	}
+	pk, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return &repo.SigningKey{
+		ID:           int(k.ID),
+		KID:          k.Kid,
+		Algorithm:    k.Algorithm,
+		PrivateKey:   pk,
+		Status:       repo.SigningKeyStatus(k.Status),
+		ActivateTime: k.ActivateTime,
+		RetireTime:   k.RetireTime,
+		CreateTime:   k.CreateTime,
+	}, nil
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
+	"usm/internal/data/ent/signingkey"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_signingKeyRepo_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	r, cleanup, err := NewSigningKeyRepo(data, &conf.Data{}, log.DefaultLogger)
+	require.NoError(t, err)
+	defer cleanup()
+
+	_, err = r.Active(ctx)
+	assert.Equal(t, biz.ErrResourceNotFound, err)
+
+	pk, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	k, err := r.Create(ctx, &repo.SigningKey{
+		KID:        "kid-1",
+		Algorithm:  "RS256",
+		PrivateKey: pk,
+		Status:     repo.SigningKeyStatusPending,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, repo.SigningKeyStatusPending, k.Status)
+	assert.True(t, pk.Equal(k.PrivateKey), "private key must round trip")
+
+	now := time.Now()
+	assert.NoError(t, r.Activate(ctx, k.ID, now))
+	active, err := r.Active(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "kid-1", active.KID)
+
+	assert.NoError(t, r.Retire(ctx, k.ID, now))
+	_, err = r.Active(ctx)
+	assert.Equal(t, biz.ErrResourceNotFound, err)
+
+	n, err := r.DeleteRetiredBefore(ctx, now.Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+	n, err = r.DeleteRetiredBefore(ctx, now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	ks, err := r.List(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, ks)
+}
+
+func Test_signingKeyRepo_Encryption(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	newKEK := func() string {
+		b := make([]byte, 32)
+		_, err := rand.Read(b)
+		require.NoError(t, err)
+		return base64.StdEncoding.EncodeToString(b)
+	}
+	kek1, kek2 := newKEK(), newKEK()
+	newRepo := func(keys ...string) repo.SigningKeyRepo {
+		r, cleanup, err := NewSigningKeyRepo(data, &conf.Data{SigningKeyEncryption: &conf.Data_KeyEncryption{Keys: keys}}, log.DefaultLogger)
+		require.NoError(t, err)
+		t.Cleanup(cleanup)
+		return r
+	}
+	pk, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	// 配置 KEK 之前保存的明文私钥仍然可以读取
+	_, err = newRepo().Create(ctx, &repo.SigningKey{KID: "plain", Algorithm: "RS256", PrivateKey: pk})
+	require.NoError(t, err)
+	_, err = newRepo(kek1).Create(ctx, &repo.SigningKey{KID: "sealed", Algorithm: "RS256", PrivateKey: pk})
+	require.NoError(t, err)
+
+	stored, err := data.db.SigningKey.Query().Where(signingkey.Kid("sealed")).Only(ctx)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(stored.PrivateKey, encryptedKeyPrefix))
+	assert.NotContains(t, stored.PrivateKey, "PRIVATE KEY")
+
+	// 更换 KEK 后旧密钥放在后面用于解密
+	ks, err := newRepo(kek2, kek1).List(ctx)
+	require.NoError(t, err)
+	require.Len(t, ks, 2)
+	for _, k := range ks {
+		assert.True(t, pk.Equal(k.PrivateKey), k.KID)
+	}
+
+	_, err = newRepo(kek2).List(ctx)
+	assert.Equal(t, errKeyDecrypt, err)
+	// 密文绑定 kid，复制到其他记录后无法解密
+	require.NoError(t, data.db.SigningKey.DeleteOne(stored).Exec(ctx))
+	require.NoError(t, data.db.SigningKey.Create().SetKid("copied").SetAlgorithm("RS256").SetPrivateKey(stored.PrivateKey).Exec(ctx))
+	_, err = newRepo(kek1).List(ctx)
+	assert.Equal(t, errKeyDecrypt, err)
+
+	_, _, err = NewSigningKeyRepo(data, &conf.Data{SigningKeyEncryption: &conf.Data_KeyEncryption{Keys: []string{"short"}}}, log.DefaultLogger)
+	assert.Error(t, err)
+}
+
+func Test_signingKeyRepo_Lock(t *testing.T) {
+	data, teardown := NewTestData(t)
+	defer teardown()
+	// 非 postgres 数据库只在进程内互斥
+	data.drv = entsql.OpenDB(dialect.SQLite, nil)
+	r, closeRepo, err := NewSigningKeyRepo(data, &conf.Data{}, log.DefaultLogger)
+	require.NoError(t, err)
+	defer closeRepo()
+
+	unlock, err := r.Lock(context.Background())
+	require.NoError(t, err)
+	var acquired int32
+	go func() {
+		unlock, err := r.Lock(context.Background())
+		if err == nil {
+			atomic.StoreInt32(&acquired, 1)
+			unlock()
+		}
+	}()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&acquired), "second lock must wait for the first")
+	unlock()
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&acquired) == 1 }, time.Second, 10*time.Millisecond)
+}
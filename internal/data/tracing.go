@@ -0,0 +1,92 @@
+package data
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("usm/internal/data")
+
+// tracingDriver 为每条语句创建 span，只记录带占位符的语句，不记录参数
+type tracingDriver struct {
+	dialect.Driver
+}
+
+func startQuerySpan(ctx context.Context, driver, operation, query string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "db."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemKey.String(driver),
+			semconv.DBStatementKey.String(query),
+		),
+	)
+}
+
+func endQuerySpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (d *tracingDriver) Exec(ctx context.Context, query string, args, v interface{}) (err error) {
+	ctx, span := startQuerySpan(ctx, d.Dialect(), "exec", query)
+	defer func() { endQuerySpan(span, err) }()
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *tracingDriver) Query(ctx context.Context, query string, args, v interface{}) (err error) {
+	ctx, span := startQuerySpan(ctx, d.Dialect(), "query", query)
+	defer func() { endQuerySpan(span, err) }()
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *tracingDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, span := tracer.Start(ctx, "db.tx", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemKey.String(d.Dialect())))
+	return &tracingTx{Tx: tx, driver: d.Dialect(), span: span}, nil
+}
+
+// tracingTx 的 span 覆盖整个事务，事务中的语句作为子 span
+type tracingTx struct {
+	dialect.Tx
+	driver string
+	span   trace.Span
+}
+
+func (tx *tracingTx) Exec(ctx context.Context, query string, args, v interface{}) (err error) {
+	ctx, span := startQuerySpan(trace.ContextWithSpan(ctx, tx.span), tx.driver, "exec", query)
+	defer func() { endQuerySpan(span, err) }()
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+func (tx *tracingTx) Query(ctx context.Context, query string, args, v interface{}) (err error) {
+	ctx, span := startQuerySpan(trace.ContextWithSpan(ctx, tx.span), tx.driver, "query", query)
+	defer func() { endQuerySpan(span, err) }()
+	return tx.Tx.Query(ctx, query, args, v)
+}
+
+func (tx *tracingTx) Commit() error {
+	err := tx.Tx.Commit()
+	tx.span.SetAttributes(attribute.String("db.tx.result", "commit"))
+	endQuerySpan(tx.span, err)
+	return err
+}
+
+func (tx *tracingTx) Rollback() error {
+	err := tx.Tx.Rollback()
+	tx.span.SetAttributes(attribute.String("db.tx.result", "rollback"))
+	endQuerySpan(tx.span, err)
+	return err
+}
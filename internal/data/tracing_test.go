@@ -0,0 +1,42 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingDriver(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(tracesdk.NewTracerProvider(tracesdk.WithSpanProcessor(sr)))
+	ctx := context.Background()
+	drv, err := entsql.Open(dialect.SQLite, "file:tracing?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	d := &tracingDriver{Driver: drv}
+
+	assert.NoError(t, d.Exec(ctx, "CREATE TABLE t (id INTEGER)", []interface{}{}, nil))
+	assert.Error(t, d.Exec(ctx, "INSERT INTO missing VALUES (?)", []interface{}{1}, nil))
+	tx, err := d.Tx(ctx)
+	require.NoError(t, err)
+	assert.NoError(t, tx.Exec(ctx, "INSERT INTO t VALUES (?)", []interface{}{1}, nil))
+	assert.NoError(t, tx.Commit())
+
+	spans := sr.Ended()
+	require.Len(t, spans, 4)
+	assert.Equal(t, "db.exec", spans[0].Name())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	// 事务中的语句是事务 span 的子 span
+	assert.Equal(t, "db.exec", spans[2].Name())
+	assert.Equal(t, "db.tx", spans[3].Name())
+	assert.Equal(t, spans[3].SpanContext().SpanID(), spans[2].Parent().SpanID())
+}
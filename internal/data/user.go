@@ -2,11 +2,16 @@ package data
 
 import (
 	"context"
+	"strings"
 
 	"usm/internal/biz"
 	"usm/internal/biz/repo"
 	"usm/internal/data/ent"
+	"usm/internal/data/ent/predicate"
 	"usm/internal/data/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 )
 
 type userRepo struct {
@@ -19,22 +24,87 @@ func NewUserRepo(data *Data) repo.UserRepo {
 	}
 }
 
-func (r *userRepo) Create(ctx context.Context, user *repo.User) (*repo.User, error) {
-	u, err := r.data.DB(ctx).User.
-		Create().
-		SetUsername(user.Username).
-		SetEmail(user.Email).
-		SetPassword(user.Password).
-		Save(ctx)
+func (r *userRepo) Create(ctx context.Context, m *repo.User) (*repo.User, error) {
+	u, err := r.userCreate(r.data.DB(ctx), m).Save(ctx)
 	if err != nil {
 		if ent.IsConstraintError(err) {
-			return nil, biz.ErrResourceAlreadyExists
+			return nil, userConstraintError(err)
 		}
 		return nil, err
 	}
 	return r.userFromEntity(u), nil
 }
 
+// userConstraintError 区分邮箱和用户名冲突，postgres 和 sqlite 的错误中都包含冲突的 email_key 字段名
+func userConstraintError(err error) error {
+	if strings.Contains(err.Error(), user.FieldEmailKey) {
+		return biz.ErrEmailAlreadyExists
+	}
+	return biz.ErrResourceAlreadyExists
+}
+
+func (r *userRepo) CreateBulk(ctx context.Context, ms []*repo.User) ([]*repo.User, error) {
+	if len(ms) == 0 {
+		return nil, nil
+	}
+	client := r.data.DB(ctx)
+	builders := make([]*ent.UserCreate, 0, len(ms))
+	for _, m := range ms {
+		builders = append(builders, r.userCreate(client, m))
+	}
+	ents, err := client.User.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.ErrResourceAlreadyExists
+		}
+		return nil, err
+	}
+	users := make([]*repo.User, 0, len(ents))
+	for _, u := range ents {
+		// sqlite 驱动不会把 INSERT ... RETURNING 的约束错误返回给 ent，整条语句失败时 ID 为 0
+		if u.ID == 0 {
+			return nil, biz.ErrResourceAlreadyExists
+		}
+		users = append(users, r.userFromEntity(u))
+	}
+	return users, nil
+}
+
+func (r *userRepo) userCreate(client *ent.Client, m *repo.User) *ent.UserCreate {
+	c := client.User.
+		Create().
+		SetUsername(m.Username).
+		SetUsernameKey(repo.IdentifierKey(m.Username)).
+		SetEmail(m.Email).
+		SetPassword(m.Password).
+		SetEmailVerified(m.EmailVerified).
+		SetDisabled(m.Disabled)
+	if m.Email != "" {
+		c.SetEmailKey(repo.IdentifierKey(m.Email))
+	}
+	if m.Kind != "" {
+		c.SetKind(user.Kind(m.Kind))
+	}
+	if m.OwnerKind != "" {
+		c.SetOwnerKind(user.OwnerKind(m.OwnerKind)).SetOwnerID(int64(m.OwnerID))
+	}
+	if m.Source != "" {
+		c.SetSource(m.Source)
+	}
+	if m.ExternalID != "" {
+		c.SetExternalID(m.ExternalID)
+	}
+	c.SetDisplayName(m.DisplayName).
+		SetPhone(m.Phone).
+		SetAvatarURL(m.AvatarURL).
+		SetLocale(m.Locale).
+		SetTimezone(m.Timezone)
+	if len(m.Attributes) > 0 {
+		c.SetAttributes(m.Attributes)
+	}
+	return c
+}
+
 func (r *userRepo) Update(ctx context.Context, user *repo.User) (*repo.User, error) {
 	u, err := r.data.DB(ctx).User.Get(ctx, int64(user.ID))
 	if err != nil {
@@ -43,17 +113,51 @@ func (r *userRepo) Update(ctx context.Context, user *repo.User) (*repo.User, err
 		}
 		return nil, err
 	}
-	u, err = u.Update().
-		SetEmail(user.Email).
-		Save(ctx)
+	up := u.Update().SetEmail(user.Email)
+	// 邮箱变更后需要重新验证
+	if user.Email != u.Email {
+		up.SetEmailVerified(false)
+	}
+	if user.Email != "" {
+		up.SetEmailKey(repo.IdentifierKey(user.Email))
+	} else {
+		up.ClearEmailKey()
+	}
+	if user.Username != "" {
+		up.SetUsername(user.Username).SetUsernameKey(repo.IdentifierKey(user.Username))
+	}
+	// 调用方传入完整的用户，外部 ID 为空表示删除，例如 SCIM PATCH remove externalId
+	if user.ExternalID != "" {
+		up.SetExternalID(user.ExternalID)
+	} else {
+		up.ClearExternalID()
+	}
+	up.SetDisplayName(user.DisplayName).
+		SetPhone(user.Phone).
+		SetAvatarURL(user.AvatarURL).
+		SetLocale(user.Locale).
+		SetTimezone(user.Timezone)
+	if len(user.Attributes) > 0 {
+		up.SetAttributes(user.Attributes)
+	} else {
+		up.ClearAttributes()
+	}
+	u, err = up.Save(ctx)
 	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, userConstraintError(err)
+		}
 		return nil, err
 	}
 	return r.userFromEntity(u), nil
 }
 
 func (r *userRepo) Delete(ctx context.Context, id int) error {
-	return r.data.DB(ctx).User.DeleteOneID(int64(id)).Exec(ctx)
+	err := r.data.DB(ctx).User.DeleteOneID(int64(id)).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
 }
 
 func (r *userRepo) Get(ctx context.Context, id int) (*repo.User, error) {
@@ -91,14 +195,15 @@ func (r *userRepo) Disable(ctx context.Context, id int) error {
 	return err
 }
 
-func (r *userRepo) List(ctx context.Context, offset, limit int) ([]*repo.User, error) {
+func (r *userRepo) List(ctx context.Context, filter *repo.UserFilter, offset, limit int) ([]*repo.User, error) {
 	if offset < 0 {
 		offset = 0
 	}
 	if limit <= 0 {
 		limit = 20
 	}
-	ents, err := r.data.DB(ctx).User.Query().Offset(offset).Limit(limit).All(ctx)
+	q := r.data.DB(ctx).User.Query().Where(userPredicates(filter)...)
+	ents, err := q.Order(ent.Asc(user.FieldID)).Offset(offset).Limit(limit).All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +214,47 @@ func (r *userRepo) List(ctx context.Context, offset, limit int) ([]*repo.User, e
 	return users, nil
 }
 
+func (r *userRepo) Count(ctx context.Context, filter *repo.UserFilter) (int, error) {
+	return r.data.DB(ctx).User.Query().Where(userPredicates(filter)...).Count(ctx)
+}
+
+func userPredicates(filter *repo.UserFilter) []predicate.User {
+	var ps []predicate.User
+	if filter == nil {
+		return ps
+	}
+	if len(filter.IDs) > 0 {
+		ids := make([]int64, 0, len(filter.IDs))
+		for _, id := range filter.IDs {
+			ids = append(ids, int64(id))
+		}
+		ps = append(ps, user.IDIn(ids...))
+	}
+	if filter.AfterID > 0 {
+		ps = append(ps, user.IDGT(int64(filter.AfterID)))
+	}
+	if filter.Kind != "" {
+		ps = append(ps, user.KindEQ(user.Kind(filter.Kind)))
+	}
+	if filter.Username != "" {
+		ps = append(ps, user.UsernameKey(repo.IdentifierKey(filter.Username)))
+	}
+	if filter.Email != "" {
+		ps = append(ps, user.EmailKey(repo.IdentifierKey(filter.Email)))
+	}
+	if filter.ExternalID != "" {
+		ps = append(ps, user.ExternalID(filter.ExternalID))
+	}
+	// 属性名已由 usecase 按配置校验
+	for k, v := range filter.Attributes {
+		k, v := k, v
+		ps = append(ps, func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(user.FieldAttributes, v, sqljson.Path(k)))
+		})
+	}
+	return ps
+}
+
 func (r *userRepo) SetPassword(ctx context.Context, id int, password string) error {
 	u, err := r.data.DB(ctx).User.Get(ctx, int64(id))
 	if err != nil {
@@ -125,7 +271,7 @@ func (r *userRepo) SetPassword(ctx context.Context, id int, password string) err
 
 func (r *userRepo) GetByUsername(ctx context.Context, username string) (*repo.User, error) {
 	u, err := r.data.DB(ctx).User.Query().Where(
-		user.Username(username),
+		user.UsernameKey(repo.IdentifierKey(username)),
 	).First(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -136,14 +282,60 @@ func (r *userRepo) GetByUsername(ctx context.Context, username string) (*repo.Us
 	return r.userFromEntity(u), nil
 }
 
+func (r *userRepo) GetByEmail(ctx context.Context, email string) (*repo.User, error) {
+	u, err := r.data.DB(ctx).User.Query().Where(
+		user.EmailKey(repo.IdentifierKey(email)),
+	).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.userFromEntity(u), nil
+}
+
+func (r *userRepo) GetByPhone(ctx context.Context, phone string) (*repo.User, error) {
+	u, err := r.data.DB(ctx).User.Query().Where(
+		user.Phone(phone),
+	).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) || ent.IsNotSingular(err) {
+			return nil, biz.ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return r.userFromEntity(u), nil
+}
+
+func (r *userRepo) SetEmailVerified(ctx context.Context, id int, verified bool) error {
+	err := r.data.DB(ctx).User.UpdateOneID(int64(id)).SetEmailVerified(verified).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return biz.ErrResourceNotFound
+	}
+	return err
+}
+
 func (r *userRepo) userFromEntity(u *ent.User) *repo.User {
 	return &repo.User{
-		ID:         int(u.ID),
-		Username:   u.Username,
-		Email:      u.Email,
-		Password:   u.Password,
-		Disabled:   u.Disabled,
-		CreateTime: u.CreateTime,
-		UpdateTime: u.UpdateTime,
+		ID:            int(u.ID),
+		Username:      u.Username,
+		Email:         u.Email,
+		EmailVerified: u.EmailVerified,
+		Password:      u.Password,
+		Disabled:      u.Disabled,
+		CreateTime:    u.CreateTime,
+		UpdateTime:    u.UpdateTime,
+		Kind:          repo.UserKind(u.Kind),
+		OwnerKind:     repo.OwnerKind(u.OwnerKind),
+		OwnerID:       int(u.OwnerID),
+		Source:        u.Source,
+		ExternalID:    u.ExternalID,
+		DisplayName:   u.DisplayName,
+		Phone:         u.Phone,
+		AvatarURL:     u.AvatarURL,
+		Locale:        u.Locale,
+		Timezone:      u.Timezone,
+		Attributes:    u.Attributes,
 	}
 }
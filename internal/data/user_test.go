@@ -30,6 +30,8 @@ func Test_userRepo_Create(t *testing.T) {
 					Username: "liubo",
 					Email:    "findliubo@163.com",
 					Password: "Admin@169+-",
+					Kind:     repo.UserKindHuman,
+					Source:   repo.UserSourceLocal,
 				},
 			},
 			want: &repo.User{
@@ -37,6 +39,8 @@ func Test_userRepo_Create(t *testing.T) {
 				Username: "liubo",
 				Email:    "findliubo@163.com",
 				Password: "Admin@169+-",
+				Kind:     repo.UserKindHuman,
+				Source:   repo.UserSourceLocal,
 			},
 			wantErr: nil,
 		},
@@ -55,6 +59,83 @@ func Test_userRepo_Create(t *testing.T) {
 	}
 }
 
+func Test_userRepo_CreateNormalizedDuplicate(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	r := NewUserRepo(data)
+	_, err := r.Create(ctx, &repo.User{Username: "Alice", Email: "Alice@Example.com"})
+	assert.NoError(t, err)
+	_, err = r.Create(ctx, &repo.User{Username: "ALICE"})
+	assert.Equal(t, biz.ErrResourceAlreadyExists, err)
+	_, err = r.Create(ctx, &repo.User{Username: "ａｌｉｃｅ"})
+	assert.Equal(t, biz.ErrResourceAlreadyExists, err)
+
+	got, err := r.List(ctx, &repo.UserFilter{Email: "alice@example.com"}, 0, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, "Alice", got[0].Username)
+	}
+}
+
+func Test_userRepo_GetByEmailAndPhone(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	r := NewUserRepo(data)
+	r.Create(ctx, &repo.User{Username: "liubo", Email: "FindLiubo@163.com", Phone: "+8613800000001"})
+	r.Create(ctx, &repo.User{Username: "alice", Phone: "+8613800000002"})
+	r.Create(ctx, &repo.User{Username: "bob", Phone: "+8613800000002"})
+
+	_, err := r.Create(ctx, &repo.User{Username: "carol", Email: "findliubo@163.com"})
+	assert.Equal(t, biz.ErrEmailAlreadyExists, err)
+	_, err = r.Create(ctx, &repo.User{Username: "LiuBo", Email: "carol@163.com"})
+	assert.Equal(t, biz.ErrResourceAlreadyExists, err)
+	carol, err := r.Create(ctx, &repo.User{Username: "carol", Email: "carol@163.com"})
+	assert.NoError(t, err)
+	carol.Email = "FINDLIUBO@163.com"
+	_, err = r.Update(ctx, carol)
+	assert.Equal(t, biz.ErrEmailAlreadyExists, err)
+
+	u, err := r.GetByEmail(ctx, "findliubo@163.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "liubo", u.Username)
+	_, err = r.GetByEmail(ctx, "nobody@163.com")
+	assert.Equal(t, biz.ErrResourceNotFound, err)
+
+	u, err = r.GetByPhone(ctx, "+8613800000001")
+	assert.NoError(t, err)
+	assert.Equal(t, "liubo", u.Username)
+	// 多个用户使用同一手机号
+	_, err = r.GetByPhone(ctx, "+8613800000002")
+	assert.Equal(t, biz.ErrResourceNotFound, err)
+}
+
+func Test_userRepo_CreateBulk(t *testing.T) {
+	ctx := context.Background()
+	data, teardown := NewTestData(t)
+	defer teardown()
+	r := NewUserRepo(data)
+	got, err := r.CreateBulk(ctx, []*repo.User{{Username: "alice", Email: "alice@example.com"}, {Username: "bob"}})
+	assert.NoError(t, err)
+	if assert.Len(t, got, 2) {
+		assert.Equal(t, "alice", got[0].Username)
+		assert.Equal(t, "bob", got[1].Username)
+	}
+	// 任一用户冲突时全部失败
+	_, err = r.CreateBulk(ctx, []*repo.User{{Username: "carol"}, {Username: "Alice"}})
+	assert.Equal(t, biz.ErrResourceAlreadyExists, err)
+
+	us, err := r.List(ctx, &repo.UserFilter{IDs: []int{got[1].ID, 100}}, 0, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, us, 1) {
+		assert.Equal(t, "bob", us[0].Username)
+	}
+	n, err := r.Count(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
 func Test_userRepo_Update(t *testing.T) {
 	ctx := context.Background()
 	defaultUser := &repo.User{
@@ -62,15 +143,18 @@ func Test_userRepo_Update(t *testing.T) {
 		Username: "liubo",
 		Email:    "findliubo@163.com",
 		Password: "Admin@169+-",
+		Kind:     repo.UserKindHuman,
+		Source:   repo.UserSourceLocal,
 	}
 	type args struct {
 		user *repo.User
 	}
 	tests := []struct {
-		name    string
-		args    args
-		want    *repo.User
-		wantErr error
+		name       string
+		externalID string
+		args       args
+		want       *repo.User
+		wantErr    error
 	}{
 		{
 			name: "should update user successfully",
@@ -85,6 +169,27 @@ func Test_userRepo_Update(t *testing.T) {
 				Username: "liubo",
 				Email:    "findliubo@361.com",
 				Password: "Admin@169+-",
+				Kind:     repo.UserKindHuman,
+				Source:   repo.UserSourceLocal,
+			},
+			wantErr: nil,
+		},
+		{
+			name:       "should clear external id",
+			externalID: "ext-1",
+			args: args{
+				user: &repo.User{
+					ID:    1,
+					Email: "findliubo@163.com",
+				},
+			},
+			want: &repo.User{
+				ID:       1,
+				Username: "liubo",
+				Email:    "findliubo@163.com",
+				Password: "Admin@169+-",
+				Kind:     repo.UserKindHuman,
+				Source:   repo.UserSourceLocal,
 			},
 			wantErr: nil,
 		},
@@ -94,7 +199,9 @@ func Test_userRepo_Update(t *testing.T) {
 			data, teardown := NewTestData(t)
 			defer teardown()
 			r := NewUserRepo(data)
-			r.Create(ctx, defaultUser)
+			created := *defaultUser
+			created.ExternalID = tt.externalID
+			r.Create(ctx, &created)
 			got, err := r.Update(ctx, tt.args.user)
 			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
 			got.CreateTime = time.Time{}
@@ -111,6 +218,8 @@ func Test_userRepo_Delete(t *testing.T) {
 		Username: "liubo",
 		Email:    "findliubo@163.com",
 		Password: "Admin@169+-",
+		Kind:     repo.UserKindHuman,
+		Source:   repo.UserSourceLocal,
 	}
 	type args struct {
 		id int
@@ -149,6 +258,8 @@ func Test_userRepo_Get(t *testing.T) {
 		Username: "liubo",
 		Email:    "findliubo@163.com",
 		Password: "Admin@169+-",
+		Kind:     repo.UserKindHuman,
+		Source:   repo.UserSourceLocal,
 	}
 	type args struct {
 		id int
@@ -190,6 +301,8 @@ func Test_userRepo_SetPassword(t *testing.T) {
 		Username: "liubo",
 		Email:    "findliubo@163.com",
 		Password: "Admin@169+-",
+		Kind:     repo.UserKindHuman,
+		Source:   repo.UserSourceLocal,
 	}
 	type args struct {
 		id       int
@@ -212,6 +325,8 @@ func Test_userRepo_SetPassword(t *testing.T) {
 				Username: "liubo",
 				Email:    "findliubo@163.com",
 				Password: "Admin@169--",
+				Kind:     repo.UserKindHuman,
+				Source:   repo.UserSourceLocal,
 			},
 			wantErr: nil,
 		},
@@ -239,6 +354,8 @@ func Test_userRepo_GetByUsername(t *testing.T) {
 		Username: "liubo",
 		Email:    "findliubo@163.com",
 		Password: "Admin@169+-",
+		Kind:     repo.UserKindHuman,
+		Source:   repo.UserSourceLocal,
 	}
 	type args struct {
 		username string
@@ -257,6 +374,22 @@ func Test_userRepo_GetByUsername(t *testing.T) {
 			want:    defaultUser,
 			wantErr: nil,
 		},
+		{
+			name: "should get user ignoring case",
+			args: args{
+				username: "LiuBo",
+			},
+			want:    defaultUser,
+			wantErr: nil,
+		},
+		{
+			name: "should get user by fullwidth username",
+			args: args{
+				username: "ｌｉｕｂｏ",
+			},
+			want:    defaultUser,
+			wantErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -315,3 +448,80 @@ func Test_userRepo_userFromEntity(t *testing.T) {
 		})
 	}
 }
+
+func Test_userRepo_List(t *testing.T) {
+	ctx := context.Background()
+	type args struct {
+		filter *repo.UserFilter
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr error
+	}{
+		{
+			name:    "should list all users",
+			args:    args{},
+			want:    []string{"liubo", "robot"},
+			wantErr: nil,
+		},
+		{
+			name: "should list service accounts only",
+			args: args{
+				filter: &repo.UserFilter{Kind: repo.UserKindService},
+			},
+			want:    []string{"robot"},
+			wantErr: nil,
+		},
+		{
+			name: "should list users by string attribute",
+			args: args{
+				filter: &repo.UserFilter{Attributes: map[string]interface{}{"department": "rd"}},
+			},
+			want:    []string{"liubo"},
+			wantErr: nil,
+		},
+		{
+			name: "should list users by number and bool attributes",
+			args: args{
+				filter: &repo.UserFilter{Attributes: map[string]interface{}{"level": float64(3), "contractor": true}},
+			},
+			want:    []string{"robot"},
+			wantErr: nil,
+		},
+		{
+			name: "should list users after id",
+			args: args{
+				filter: &repo.UserFilter{AfterID: 1},
+			},
+			want:    []string{"robot"},
+			wantErr: nil,
+		},
+		{
+			name: "should list nothing if attribute does not match",
+			args: args{
+				filter: &repo.UserFilter{Attributes: map[string]interface{}{"department": "sales"}},
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, teardown := NewTestData(t)
+			defer teardown()
+			r := NewUserRepo(data)
+			r.Create(ctx, &repo.User{Username: "liubo", Password: "Admin@169+-", Attributes: map[string]interface{}{"department": "rd", "level": float64(5)}})
+			r.Create(ctx, &repo.User{Username: "robot", Kind: repo.UserKindService, OwnerKind: repo.OwnerKindUser, OwnerID: 1,
+				Attributes: map[string]interface{}{"level": float64(3), "contractor": true}})
+			got, err := r.List(ctx, tt.args.filter, 0, 10)
+			assert.Equal(t, tt.wantErr, err, "error=%v, wantErr=%v", err, tt.wantErr)
+			var names []string
+			for _, u := range got {
+				names = append(names, u.Username)
+			}
+			assert.Equal(t, tt.want, names, "mismatch: got=%v, want=%v", names, tt.want)
+		})
+	}
+}
@@ -5,6 +5,20 @@ import "github.com/google/wire"
 var ProviderSet = wire.NewSet(
 	NewData,
 	NewTransaction,
+	NewHealthChecker,
 
 	NewUserRepo,
+	NewGroupRepo,
+	NewAPIKeyRepo,
+	NewOAuthClientRepo,
+	NewOAuthCodeRepo,
+	NewOAuthTokenRepo,
+	NewSigningKeyRepo,
+	NewEmailVerificationRepo,
+	NewPasswordResetRepo,
+	NewInvitationRepo,
+	NewMailer,
+	NewNotifier,
+	NewExternalAuthenticators,
+	NewMetrics,
 )
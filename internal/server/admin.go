@@ -0,0 +1,47 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"usm/internal/conf"
+	"usm/pkg/secret"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// adminAuth 管理接口的 Bearer Token 认证，没有配置 token 时管理接口返回 404
+type adminAuth struct {
+	tokens *secret.Secret
+}
+
+func newAdminAuth(c *conf.Server_Admin, logger log.Logger) (*adminAuth, error) {
+	ref := c.GetBearerTokensRef()
+	tokens, err := secret.New(ref.GetFile(), ref.GetEnv(), strings.Join(c.GetBearerTokens(), "\n"), logger)
+	if err != nil {
+		return nil, fmt.Errorf("admin bearer tokens: %v", err)
+	}
+	return &adminAuth{tokens: tokens}, nil
+}
+
+func (a *adminAuth) handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 每次请求时读取，密钥文件更新后立即生效
+		tokens := strings.Fields(a.tokens.Value())
+		if len(tokens) == 0 {
+			http.NotFound(w, r)
+			return
+		}
+		if !secret.BearerAuthorized(r, tokens) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+			http.Error(w, "invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (a *adminAuth) close() {
+	a.tokens.Close()
+}
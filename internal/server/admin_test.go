@@ -0,0 +1,65 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"usm/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminAuth(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens")
+	require.NoError(t, os.WriteFile(path, []byte("t1\nt2\n"), 0o600))
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	serve := func(c *conf.Server_Admin, auth string) int {
+		a, err := newAdminAuth(c, log.DefaultLogger)
+		require.NoError(t, err)
+		defer a.close()
+		req := httptest.NewRequest(http.MethodPut, PathLogLevels, nil)
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		w := httptest.NewRecorder()
+		a.handler(ok).ServeHTTP(w, req)
+		return w.Code
+	}
+	tests := []struct {
+		name string
+		c    *conf.Server_Admin
+		auth string
+		want int
+	}{
+		{name: "disabled without tokens", c: nil, auth: "Bearer t1", want: http.StatusNotFound},
+		{name: "missing token", c: &conf.Server_Admin{BearerTokens: []string{"t1"}}, want: http.StatusUnauthorized},
+		{name: "wrong token", c: &conf.Server_Admin{BearerTokens: []string{"t1"}}, auth: "Bearer t2", want: http.StatusUnauthorized},
+		{name: "valid token", c: &conf.Server_Admin{BearerTokens: []string{"t1"}}, auth: "Bearer t1", want: http.StatusOK},
+		{name: "token from file", c: &conf.Server_Admin{BearerTokensRef: &conf.SecretRef{File: path}}, auth: "bearer t2", want: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, serve(tt.c, tt.auth))
+		})
+	}
+
+	a, err := newAdminAuth(&conf.Server_Admin{BearerTokensRef: &conf.SecretRef{File: path}}, log.DefaultLogger)
+	require.NoError(t, err)
+	defer a.close()
+	require.NoError(t, os.WriteFile(path, []byte("t3\n"), 0o600))
+	assert.Eventually(t, func() bool {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, PathLogLevels, nil)
+		req.Header.Set("Authorization", "Bearer t3")
+		a.handler(ok).ServeHTTP(w, req)
+		return w.Code == http.StatusOK
+	}, 3*time.Second, 20*time.Millisecond, "rotated token should be accepted")
+	_, err = newAdminAuth(&conf.Server_Admin{BearerTokensRef: &conf.SecretRef{Env: "TEST_ADMIN_TOKENS_MISSING"}}, log.DefaultLogger)
+	assert.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"usm/internal/conf"
+)
+
+var (
+	defaultCORSMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+	defaultCORSHeaders = []string{"Authorization", "Content-Type"}
+)
+
+// CORS 跨域请求过滤器，配置可以通过 Set 在运行时替换
+type CORS struct {
+	policy atomic.Value // *corsPolicy
+}
+
+type corsPolicy struct {
+	anyOrigin   bool
+	origins     map[string]bool
+	methods     string
+	headers     string
+	exposed     string
+	credentials bool
+	maxAge      string
+}
+
+func NewCORS(c *conf.Server) *CORS {
+	cors := &CORS{}
+	cors.Set(c.GetHttp().GetCors())
+	return cors
+}
+
+// Set 替换跨域配置，没有配置允许的来源时不处理跨域请求
+func (c *CORS) Set(cc *conf.Server_HTTP_CORS) {
+	p := &corsPolicy{origins: make(map[string]bool), credentials: cc.GetAllowCredentials()}
+	for _, o := range cc.GetAllowedOrigins() {
+		if o == "*" {
+			p.anyOrigin = true
+			continue
+		}
+		p.origins[strings.ToLower(strings.TrimRight(o, "/"))] = true
+	}
+	methods, headers := cc.GetAllowedMethods(), cc.GetAllowedHeaders()
+	if len(methods) == 0 {
+		methods = defaultCORSMethods
+	}
+	if len(headers) == 0 {
+		headers = defaultCORSHeaders
+	}
+	p.methods = strings.Join(methods, ", ")
+	p.headers = strings.Join(headers, ", ")
+	p.exposed = strings.Join(cc.GetExposedHeaders(), ", ")
+	if cc.GetMaxAge() != nil {
+		p.maxAge = strconv.Itoa(int(cc.GetMaxAge().AsDuration().Seconds()))
+	}
+	c.policy.Store(p)
+}
+
+// Filter 作为 http.Filter 使用，预检请求直接返回 204，不进入路由
+func (c *CORS) Filter(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		p := c.policy.Load().(*corsPolicy)
+		if origin == "" || (!p.anyOrigin && len(p.origins) == 0) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+		h := w.Header()
+		h.Add("Vary", "Origin")
+		allowed := p.allowed(origin)
+		if allowed {
+			if p.anyOrigin && !p.credentials {
+				h.Set("Access-Control-Allow-Origin", "*")
+			} else {
+				h.Set("Access-Control-Allow-Origin", origin)
+			}
+			if p.credentials {
+				h.Set("Access-Control-Allow-Credentials", "true")
+			}
+		}
+		if !preflight {
+			if allowed && p.exposed != "" {
+				h.Set("Access-Control-Expose-Headers", p.exposed)
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+		h.Add("Vary", "Access-Control-Request-Method")
+		h.Add("Vary", "Access-Control-Request-Headers")
+		if allowed {
+			h.Set("Access-Control-Allow-Methods", p.methods)
+			h.Set("Access-Control-Allow-Headers", p.headers)
+			if p.maxAge != "" {
+				h.Set("Access-Control-Max-Age", p.maxAge)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (p *corsPolicy) allowed(origin string) bool {
+	return p.anyOrigin || p.origins[strings.ToLower(origin)]
+}
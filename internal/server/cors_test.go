@@ -0,0 +1,70 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"usm/internal/conf"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestCORS(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTeapot) })
+	cors := NewCORS(&conf.Server{Http: &conf.Server_HTTP{Cors: &conf.Server_HTTP_CORS{
+		AllowedOrigins: []string{"https://app.example.com/"},
+		ExposedHeaders: []string{"X-Request-Id"},
+		MaxAge:         durationpb.New(10 * time.Minute),
+	}}})
+	serve := func(method, origin string, preflight bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/v1/users", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		if preflight {
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		}
+		w := httptest.NewRecorder()
+		cors.Filter(next).ServeHTTP(w, req)
+		return w
+	}
+
+	w := serve(http.MethodGet, "https://app.example.com", false)
+	assert.Equal(t, http.StatusTeapot, w.Code)
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "X-Request-Id", w.Header().Get("Access-Control-Expose-Headers"))
+
+	w = serve(http.MethodOptions, "https://APP.example.com", true)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "GET, POST, PUT, PATCH, DELETE", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Authorization, Content-Type", w.Header().Get("Access-Control-Allow-Headers"))
+	assert.Equal(t, "600", w.Header().Get("Access-Control-Max-Age"))
+
+	w = serve(http.MethodOptions, "https://evil.example.com", true)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Methods"))
+
+	w = serve(http.MethodGet, "", false)
+	assert.Equal(t, http.StatusTeapot, w.Code)
+	assert.Empty(t, w.Header().Get("Vary"))
+
+	// 凭据和 * 同时配置时返回请求的来源
+	cors.Set(&conf.Server_HTTP_CORS{AllowedOrigins: []string{"*"}, AllowCredentials: true})
+	w = serve(http.MethodGet, "https://other.example.com", false)
+	assert.Equal(t, "https://other.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+
+	cors.Set(&conf.Server_HTTP_CORS{AllowedOrigins: []string{"*"}})
+	w = serve(http.MethodGet, "https://other.example.com", false)
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+
+	// 没有配置来源时不处理跨域请求，预检请求交给路由
+	cors.Set(nil)
+	w = serve(http.MethodOptions, "https://app.example.com", true)
+	assert.Equal(t, http.StatusTeapot, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+}
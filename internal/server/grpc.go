@@ -3,22 +3,32 @@ package server
 import (
 	"usm/internal/conf"
 	"usm/internal/service/account"
+	"usm/internal/service/apierr"
+	"usm/internal/service/oauth"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
-	"github.com/go-kratos/kratos/v2/middleware/validate"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 
 	apb "usm/api/account/v1"
+	opb "usm/api/oauth/v1"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, acctSrv *account.Service, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, acctSrv *account.Service, oauthSrv *oauth.Service, health *Health, metrics *Metrics, logger log.Logger) *grpc.Server {
+	logger = log.With(logger, "module", "server")
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
-			validate.Validator(),
+			tracing.Server(),
+			metrics.middleware(),
+			requestLogger(logger),
+			apierr.Translator(logger),
+			apierr.Validator(),
 		),
+		grpc.UnaryInterceptor(health.unaryInterceptor()),
+		grpc.StreamInterceptor(apierr.StreamServerInterceptor(logger), health.streamInterceptor()),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -31,5 +41,6 @@ func NewGRPCServer(c *conf.Server, acctSrv *account.Service, logger log.Logger)
 	}
 	srv := grpc.NewServer(opts...)
 	apb.RegisterAccountServer(srv, acctSrv)
+	opb.RegisterOAuthServer(srv, oauthSrv)
 	return srv
 }
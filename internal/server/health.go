@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"usm/internal/biz/repo"
+	"usm/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	PathLiveness  = "/healthz"
+	PathReadiness = "/readyz"
+
+	healthCheckTimeout = 3 * time.Second
+	healthWatchPeriod  = time.Second
+)
+
+const (
+	healthStarting int32 = iota
+	healthServing
+	healthDraining
+)
+
+var _ registry.Registrar = (*Health)(nil)
+
+type healthCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+// Health 提供存活和就绪检查，gRPC 健康检查服务返回同样的就绪状态。
+// 作为 kratos 的 Registrar 感知应用的启动和停止：全部服务启动后才就绪，
+// 停止时先返回未就绪，等待 drain_period 后再关闭服务
+type Health struct {
+	checks []healthCheck
+	drain  time.Duration
+	state  int32
+	log    *log.Helper
+}
+
+func NewHealth(c *conf.Server, db repo.HealthChecker, ks *KeyRotationServer, logger log.Logger) *Health {
+	return &Health{
+		checks: []healthCheck{
+			{name: "database", check: db.CheckHealth},
+			{name: "signing_keys", check: ks.CheckHealth},
+		},
+		drain: c.DrainPeriod.AsDuration(),
+		log:   log.NewHelper(log.With(logger, "module", "server")),
+	}
+}
+
+// DrainPeriod 停止前等待的时间
+func (h *Health) DrainPeriod() time.Duration {
+	return h.drain
+}
+
+func (h *Health) Register(ctx context.Context, service *registry.ServiceInstance) error {
+	atomic.CompareAndSwapInt32(&h.state, healthStarting, healthServing)
+	return nil
+}
+
+func (h *Health) Deregister(ctx context.Context, service *registry.ServiceInstance) error {
+	atomic.StoreInt32(&h.state, healthDraining)
+	if h.drain <= 0 {
+		return nil
+	}
+	h.log.Infof("draining for %s before stopping", h.drain)
+	select {
+	case <-time.After(h.drain):
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// Ready 返回每一项检查的结果，未通过的为错误信息
+func (h *Health) Ready(ctx context.Context) (map[string]string, bool) {
+	res := make(map[string]string, len(h.checks)+1)
+	ready := true
+	switch atomic.LoadInt32(&h.state) {
+	case healthStarting:
+		res["lifecycle"] = "starting"
+		ready = false
+	case healthServing:
+		res["lifecycle"] = "ok"
+	case healthDraining:
+		res["lifecycle"] = "draining"
+		ready = false
+	}
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	for _, c := range h.checks {
+		if err := c.check(ctx); err != nil {
+			res[c.name] = err.Error()
+			ready = false
+			continue
+		}
+		res[c.name] = "ok"
+	}
+	return res, ready
+}
+
+// Liveness 进程能处理请求即返回 200，停止前的 drain 期间也是如此，避免被重启
+func (h *Health) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, http.StatusOK, map[string]interface{}{"status": "ok"})
+}
+
+func (h *Health) Readiness(w http.ResponseWriter, r *http.Request) {
+	checks, ready := h.Ready(r.Context())
+	if !ready {
+		writeHealth(w, http.StatusServiceUnavailable, map[string]interface{}{"status": "unavailable", "checks": checks})
+		return
+	}
+	writeHealth(w, http.StatusOK, map[string]interface{}{"status": "ok", "checks": checks})
+}
+
+func writeHealth(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (h *Health) servingStatus(ctx context.Context) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if _, ready := h.Ready(ctx); ready {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
+
+// kratos 已注册 grpc.health.v1.Health 服务，但只在服务启动和停止时切换状态，
+// 通过拦截器返回就绪检查的结果
+func (h *Health) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if info.FullMethod != "/grpc.health.v1.Health/Check" {
+			return handler(ctx, req)
+		}
+		return &grpc_health_v1.HealthCheckResponse{Status: h.servingStatus(ctx)}, nil
+	}
+}
+
+func (h *Health) streamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.FullMethod != "/grpc.health.v1.Health/Watch" {
+			return handler(srv, ss)
+		}
+		if err := ss.RecvMsg(&grpc_health_v1.HealthCheckRequest{}); err != nil {
+			return err
+		}
+		ticker := time.NewTicker(healthWatchPeriod)
+		defer ticker.Stop()
+		last := grpc_health_v1.HealthCheckResponse_UNKNOWN
+		for {
+			// 状态变化时才发送
+			if s := h.servingStatus(ss.Context()); s != last {
+				if err := ss.SendMsg(&grpc_health_v1.HealthCheckResponse{Status: s}); err != nil {
+					return err
+				}
+				last = s
+			}
+			select {
+			case <-ss.Context().Done():
+				return ss.Context().Err()
+			case <-ticker.C:
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHealth(t *testing.T) {
+	ctx := context.Background()
+	var dbErr error
+	h := &Health{
+		checks: []healthCheck{{name: "database", check: func(ctx context.Context) error { return dbErr }}},
+		log:    log.NewHelper(log.DefaultLogger),
+	}
+	readyz := func() int {
+		w := httptest.NewRecorder()
+		h.Readiness(w, httptest.NewRequest(http.MethodGet, PathReadiness, nil))
+		return w.Code
+	}
+	livez := func() int {
+		w := httptest.NewRecorder()
+		h.Liveness(w, httptest.NewRequest(http.MethodGet, PathLiveness, nil))
+		return w.Code
+	}
+	check := func() grpc_health_v1.HealthCheckResponse_ServingStatus {
+		res, err := h.unaryInterceptor()(ctx, &grpc_health_v1.HealthCheckRequest{},
+			&grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, nil)
+		assert.NoError(t, err)
+		return res.(*grpc_health_v1.HealthCheckResponse).Status
+	}
+
+	// 服务启动前
+	assert.Equal(t, http.StatusServiceUnavailable, readyz())
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, check())
+
+	assert.NoError(t, h.Register(ctx, nil))
+	assert.Equal(t, http.StatusOK, readyz())
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, check())
+
+	dbErr = errors.New("connection refused")
+	checks, ready := h.Ready(ctx)
+	assert.False(t, ready)
+	assert.Equal(t, "connection refused", checks["database"])
+	assert.Equal(t, http.StatusServiceUnavailable, readyz())
+
+	dbErr = nil
+	assert.NoError(t, h.Deregister(ctx, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, readyz())
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, check())
+	assert.Equal(t, http.StatusOK, livez())
+}
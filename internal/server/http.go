@@ -3,21 +3,39 @@ package server
 import (
 	"usm/internal/conf"
 	"usm/internal/service/account"
+	"usm/internal/service/apierr"
+	"usm/internal/service/oauth"
+	"usm/internal/service/scim"
+	"usm/pkg/kratos/contrib/log/zap"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
-	"github.com/go-kratos/kratos/v2/middleware/validate"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/http"
 
 	apb "usm/api/account/v1"
+	opb "usm/api/oauth/v1"
 )
 
+// PathLogLevels 查看和修改日志级别，见 zap.Levels，需要 server.admin 中配置的 Bearer Token
+const PathLogLevels = "/admin/log/levels"
+
 // NewHTTPServer new a HTTP server.
-func NewHTTPServer(c *conf.Server, acctSrv *account.Service, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, acctSrv *account.Service, oauthSrv *oauth.Service, scimSrv *scim.Service, health *Health, cors *CORS, metrics *Metrics, levels *zap.Levels, logger log.Logger) (*http.Server, func(), error) {
+	logger = log.With(logger, "module", "server")
+	admin, err := newAdminAuth(c.GetAdmin(), logger)
+	if err != nil {
+		return nil, nil, err
+	}
 	var opts = []http.ServerOption{
+		http.Filter(cors.Filter),
 		http.Middleware(
 			recovery.Recovery(),
-			validate.Validator(),
+			tracing.Server(),
+			metrics.middleware(),
+			requestLogger(logger),
+			apierr.Translator(logger),
+			apierr.Validator(),
 		),
 	}
 	if c.Http.Network != "" {
@@ -31,5 +49,20 @@ func NewHTTPServer(c *conf.Server, acctSrv *account.Service, logger log.Logger)
 	}
 	srv := http.NewServer(opts...)
 	apb.RegisterAccountHTTPServer(srv, acctSrv)
-	return srv
+	opb.RegisterOAuthHTTPServer(srv, oauthSrv)
+	srv.HandleFunc(oauth.PathAuthorize, oauthSrv.Authorize)
+	srv.HandleFunc(oauth.PathToken, oauthSrv.Token)
+	srv.HandleFunc(oauth.PathIntrospect, oauthSrv.Introspect)
+	srv.HandleFunc(oauth.PathRevoke, oauthSrv.Revoke)
+	srv.HandleFunc(oauth.PathUserInfo, oauthSrv.UserInfo)
+	srv.HandleFunc(oauth.PathDiscovery, oauthSrv.Discovery)
+	srv.HandleFunc(oauth.PathJWKS, oauthSrv.JWKS)
+	srv.HandleFunc(account.PathImportUsers, acctSrv.ImportUsersHTTP)
+	srv.HandleFunc(account.PathExportUsers, acctSrv.ExportUsersHTTP)
+	srv.HandlePrefix(scim.PathPrefix, scimSrv)
+	srv.Handle("/metrics", metrics.handler())
+	srv.HandleFunc(PathLiveness, health.Liveness)
+	srv.HandleFunc(PathReadiness, health.Readiness)
+	srv.Handle(PathLogLevels, admin.handler(levels))
+	return srv, func() { admin.close() }, nil
 }
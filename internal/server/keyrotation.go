@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	oauthuc "usm/internal/biz/usecase/oauth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+var _ transport.Server = (*KeyRotationServer)(nil)
+
+// KeyRotationServer 定时轮换 OAuth 签名密钥，作为 kratos.Server 随应用启动和停止
+type KeyRotationServer struct {
+	uc    *oauthuc.Usecase
+	log   *log.Helper
+	stop  chan struct{}
+	ready int32
+}
+
+func NewKeyRotationServer(uc *oauthuc.Usecase, logger log.Logger) *KeyRotationServer {
+	return &KeyRotationServer{
+		uc:   uc,
+		log:  log.NewHelper(log.With(logger, "module", "server")),
+		stop: make(chan struct{}),
+	}
+}
+
+func (s *KeyRotationServer) Start(ctx context.Context) error {
+	// 启动时先确保存在签名密钥，失败则阻止应用启动
+	if err := s.uc.MaintainSigningKeys(ctx); err != nil {
+		return err
+	}
+	atomic.StoreInt32(&s.ready, 1)
+	ticker := time.NewTicker(s.uc.KeyCheckInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.uc.MaintainSigningKeys(ctx); err != nil {
+				s.log.Errorf("maintain signing keys failed: %v", err)
+			}
+		}
+	}
+}
+
+// CheckHealth 启动时的签名密钥检查完成前返回错误
+func (s *KeyRotationServer) CheckHealth(ctx context.Context) error {
+	if atomic.LoadInt32(&s.ready) == 0 {
+		return errors.New("signing keys not initialized")
+	}
+	return nil
+}
+
+func (s *KeyRotationServer) Stop(ctx context.Context) error {
+	close(s.stop)
+	return nil
+}
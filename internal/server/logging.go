@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// requestLogger 每个请求记录一条日志，包括操作、调用方、结果和耗时，不记录请求和响应内容。
+// 应放在 tracing 之后以记录 trace_id，apierr.Translator 之前以记录转换后的错误原因
+func requestLogger(logger log.Logger) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			start := time.Now()
+			reply, err := handler(ctx, req)
+			level := log.LevelInfo
+			kvs := []interface{}{
+				"msg", "request",
+				"kind", tr.Kind().String(),
+				"operation", tr.Operation(),
+				"caller", callerIdentity(tr.RequestHeader().Get("Authorization")),
+				"peer", peerAddr(ctx, tr),
+			}
+			if se := errors.FromError(err); se != nil {
+				kvs = append(kvs, "code", se.Code, "reason", se.Reason)
+				if se.Code >= 500 {
+					level = log.LevelError
+					kvs = append(kvs, "error", se.Message)
+				}
+			} else {
+				kvs = append(kvs, "code", int32(200))
+			}
+			kvs = append(kvs, "latency", time.Since(start))
+			log.WithContext(ctx, logger).Log(level, kvs...)
+			return reply, err
+		}
+	}
+}
+
+// callerIdentity 从认证头中取出不含凭据的调用方标识：basic:<username>、api_key:<prefix>、bearer 或 anonymous
+func callerIdentity(auth string) string {
+	i := strings.IndexByte(auth, ' ')
+	if i < 0 {
+		return "anonymous"
+	}
+	cred := strings.TrimSpace(auth[i+1:])
+	switch strings.ToLower(auth[:i]) {
+	case "basic":
+		b, err := base64.StdEncoding.DecodeString(cred)
+		if err != nil {
+			return "basic"
+		}
+		return "basic:" + strings.SplitN(string(b), ":", 2)[0]
+	case "bearer":
+		// API Key 格式为 usm_<prefix>_<secret>
+		if parts := strings.SplitN(cred, "_", 3); len(parts) == 3 && parts[0] == "usm" {
+			return "api_key:" + parts[1]
+		}
+		return "bearer"
+	}
+	return "anonymous"
+}
+
+func peerAddr(ctx context.Context, tr transport.Transporter) string {
+	if ht, ok := tr.(http.Transporter); ok {
+		return ht.Request().RemoteAddr
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		return p.Addr.String()
+	}
+	return ""
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	pb "usm/api/account/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCallerIdentity(t *testing.T) {
+	basic := "Basic " + base64.StdEncoding.EncodeToString([]byte("alice:secret"))
+	tests := []struct {
+		auth string
+		want string
+	}{
+		{auth: "", want: "anonymous"},
+		{auth: basic, want: "basic:alice"},
+		{auth: "Basic !!!", want: "basic"},
+		{auth: "Bearer usm_ab12_s3cr3t", want: "api_key:ab12"},
+		{auth: "bearer eyJhbGciOi.xx.yy", want: "bearer"},
+		{auth: "Digest x", want: "anonymous"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, callerIdentity(tt.auth), tt.auth)
+	}
+}
+
+type testTransport struct {
+	header metadata.MD
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return "/api.account.v1.Account/GetUser" }
+func (tr *testTransport) RequestHeader() transport.Header { return headerCarrier(tr.header) }
+func (tr *testTransport) ReplyHeader() transport.Header   { return headerCarrier(metadata.MD{}) }
+
+type headerCarrier metadata.MD
+
+func (h headerCarrier) Get(key string) string {
+	if v := metadata.MD(h).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+func (h headerCarrier) Set(key, value string) { metadata.MD(h).Set(key, value) }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type captureLogger struct {
+	level   log.Level
+	keyvals map[interface{}]interface{}
+}
+
+func (l *captureLogger) Log(level log.Level, keyvals ...interface{}) error {
+	l.level = level
+	l.keyvals = make(map[interface{}]interface{})
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		l.keyvals[keyvals[i]] = keyvals[i+1]
+	}
+	return nil
+}
+
+func TestRequestLogger(t *testing.T) {
+	ctx := transport.NewServerContext(context.Background(), &testTransport{
+		header: metadata.Pairs("authorization", "Bearer usm_ab12_s3cr3t"),
+	})
+	logger := &captureLogger{}
+	h := requestLogger(logger)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, pb.ErrorUserNotFound("user 1 not found")
+	})
+	_, err := h(ctx, &pb.GetUserRequest{Id: 1})
+	assert.Error(t, err)
+	assert.Equal(t, log.LevelInfo, logger.level)
+	assert.Equal(t, "/api.account.v1.Account/GetUser", logger.keyvals["operation"])
+	assert.Equal(t, "api_key:ab12", logger.keyvals["caller"])
+	assert.Equal(t, int32(404), logger.keyvals["code"])
+	assert.Equal(t, pb.ErrorReason_USER_NOT_FOUND.String(), logger.keyvals["reason"])
+	assert.Contains(t, logger.keyvals, "latency")
+
+	h = requestLogger(logger)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, pb.ErrorInternal("internal error")
+	})
+	_, _ = h(ctx, &pb.GetUserRequest{Id: 1})
+	assert.Equal(t, log.LevelError, logger.level)
+	assert.Equal(t, "internal error", logger.keyvals["error"])
+}
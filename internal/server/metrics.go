@@ -0,0 +1,55 @@
+package server
+
+import (
+	"net/http"
+
+	"usm/pkg/kratos/contrib/metrics/prometheus"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/metrics"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics gRPC 和 HTTP 共用的请求指标，通过 kind 标签区分
+type Metrics struct {
+	requests *prom.CounterVec
+	seconds  *prom.HistogramVec
+	gatherer prom.Gatherer
+}
+
+func NewMetrics(reg prom.Registerer, gatherer prom.Gatherer) (*Metrics, error) {
+	requests, err := prometheus.RegisterCounterVec(reg, prom.CounterOpts{
+		Namespace: "usm",
+		Subsystem: "server",
+		Name:      "requests_code_total",
+		Help:      "The total number of processed requests.",
+	}, "kind", "operation", "code", "reason")
+	if err != nil {
+		return nil, err
+	}
+	seconds, err := prometheus.RegisterHistogramVec(reg, prom.HistogramOpts{
+		Namespace: "usm",
+		Subsystem: "server",
+		Name:      "requests_seconds",
+		Help:      "Requests duration in seconds.",
+		Buckets:   prom.DefBuckets,
+	}, "kind", "operation")
+	if err != nil {
+		return nil, err
+	}
+	return &Metrics{requests: requests, seconds: seconds, gatherer: gatherer}, nil
+}
+
+// middleware 应放在 apierr.Translator 之前，错误原因取转换后的值
+func (m *Metrics) middleware() middleware.Middleware {
+	return metrics.Server(
+		metrics.WithRequests(prometheus.NewCounter(m.requests)),
+		metrics.WithSeconds(prometheus.NewHistogram(m.seconds)),
+	)
+}
+
+// handler 导出 gatherer 中的指标
+func (m *Metrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.gatherer, promhttp.HandlerOpts{})
+}
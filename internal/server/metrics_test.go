@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	reg := prom.NewRegistry()
+	m, err := NewMetrics(reg, reg)
+	require.NoError(t, err)
+	// 重复创建时共用已注册的指标
+	_, err = NewMetrics(reg, reg)
+	require.NoError(t, err)
+
+	ctx := transport.NewServerContext(context.Background(), &testTransport{})
+	_, err = m.middleware()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})(ctx, nil)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	m.handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `usm_server_requests_code_total{code="0",kind="grpc",operation="/api.account.v1.Account/GetUser",reason=""} 1`)
+}
@@ -0,0 +1,109 @@
+package account
+
+import (
+	"context"
+
+	pb "usm/api/account/v1"
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	acctuc "usm/internal/biz/usecase/account"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func (s *Service) BatchCreateUsers(ctx context.Context, req *pb.BatchCreateUsersRequest) (*pb.BatchUsersResponse, error) {
+	s.log.WithContext(ctx).Infof("batch create %d users, atomic=%v", len(req.Requests), req.Atomic)
+	users := make([]*repo.User, 0, len(req.Requests))
+	for _, r := range req.Requests {
+		users = append(users, bizUserFromCreateRequest(r))
+	}
+	results, err := s.uc.BatchCreateUsers(ctx, users, req.Atomic)
+	if err != nil {
+		return nil, err
+	}
+	return protoFromBatchResults(results, func(i int, err error) error {
+		return createUserError(err, req.Requests[i])
+	}), nil
+}
+
+func (s *Service) BatchGetUsers(ctx context.Context, req *pb.BatchGetUsersRequest) (*pb.BatchUsersResponse, error) {
+	s.log.WithContext(ctx).Infof("batch get %d users", len(req.Ids))
+	results, err := s.uc.BatchGetUsers(ctx, bizIDs(req.Ids))
+	if err != nil {
+		return nil, err
+	}
+	return protoFromBatchResults(results, func(i int, err error) error {
+		return userNotFoundError(err, req.Ids[i])
+	}), nil
+}
+
+func (s *Service) BatchUpdateUsers(ctx context.Context, req *pb.BatchUpdateUsersRequest) (*pb.BatchUsersResponse, error) {
+	s.log.WithContext(ctx).Infof("batch update %d users, atomic=%v", len(req.Requests), req.Atomic)
+	updates := make([]*acctuc.UserUpdate, 0, len(req.Requests))
+	for _, r := range req.Requests {
+		updates = append(updates, &acctuc.UserUpdate{
+			User:  bizUserFromUpdateRequest(r),
+			Paths: r.GetUpdateMask().GetPaths(),
+		})
+	}
+	results, err := s.uc.BatchUpdateUsers(ctx, updates, req.Atomic)
+	if err != nil {
+		return nil, err
+	}
+	return protoFromBatchResults(results, func(i int, err error) error {
+		return updateUserError(err, req.Requests[i])
+	}), nil
+}
+
+func (s *Service) BatchDeleteUsers(ctx context.Context, req *pb.BatchDeleteUsersRequest) (*pb.BatchUsersResponse, error) {
+	s.log.WithContext(ctx).Infof("batch delete %d users, atomic=%v", len(req.Ids), req.Atomic)
+	results, err := s.uc.BatchDeleteUsers(ctx, bizIDs(req.Ids), req.Atomic)
+	if err != nil {
+		return nil, err
+	}
+	return protoFromBatchResults(results, func(i int, err error) error {
+		return userNotFoundError(err, req.Ids[i])
+	}), nil
+}
+
+func userNotFoundError(err error, id int64) error {
+	if err == biz.ErrResourceNotFound {
+		return pb.ErrorUserNotFound("user %d not found", id)
+	}
+	return err
+}
+
+// protoFromBatchResults 转换批量操作结果，mapErr 将第 i 个用户的业务错误转换为接口错误
+func protoFromBatchResults(results []*acctuc.BatchResult, mapErr func(i int, err error) error) *pb.BatchUsersResponse {
+	res := &pb.BatchUsersResponse{Results: make([]*pb.BatchUserResult, 0, len(results))}
+	for i, r := range results {
+		item := &pb.BatchUserResult{}
+		switch {
+		case r.Err == biz.ErrBatchAborted:
+			item.Error = protoFromError(pb.ErrorBatchAborted("aborted because another user in the batch failed"))
+		case r.Err != nil:
+			item.Error = protoFromError(mapErr(i, r.Err))
+		case r.User != nil:
+			item.User = protoFromBizUser(r.User)
+		}
+		res.Results = append(res.Results, item)
+	}
+	return res
+}
+
+func protoFromError(err error) *pb.BatchError {
+	e := errors.FromError(err)
+	return &pb.BatchError{
+		Code:    e.Code,
+		Reason:  e.Reason,
+		Message: e.Message,
+	}
+}
+
+func bizIDs(ids []int64) []int {
+	res := make([]int, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, int(id))
+	}
+	return res
+}
@@ -2,6 +2,7 @@ package account
 
 import (
 	"context"
+	"time"
 
 	pb "usm/api/account/v1"
 	"usm/internal/biz"
@@ -9,8 +10,7 @@ import (
 	acctuc "usm/internal/biz/usecase/account"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type Service struct {
@@ -24,56 +24,111 @@ type Service struct {
 func NewService(uc *acctuc.Usecase, logger log.Logger) *Service {
 	return &Service{
 		uc:  uc,
-		log: log.NewHelper(logger),
+		log: log.NewHelper(log.With(logger, "module", "account")),
 	}
 }
 
 func (s *Service) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
-	log.Infof("create user %s, email=%s", req.Username, req.Email)
-	u, err := s.uc.CreateUser(ctx, &repo.User{
-		Username: req.Username,
-		Email:    req.Email,
-		Password: "Admin@169+-",
-	})
+	s.log.WithContext(ctx).Infof("create user %s, email=%s, kind=%s", req.Username, req.Email, req.Kind)
+	u, err := s.uc.CreateUser(ctx, bizUserFromCreateRequest(req))
 	if err != nil {
-		if err == biz.ErrResourceAlreadyExists {
-			return nil, pb.ErrorUserAlreadyExisted("user %s already existed", req.Username)
-		}
-		return nil, err
+		return nil, createUserError(err, req)
 	}
 	return protoFromBizUser(u), nil
 }
 
+func bizUserFromCreateRequest(req *pb.CreateUserRequest) *repo.User {
+	return &repo.User{
+		Username:  req.Username,
+		Email:     req.Email,
+		Password:  "Admin@169+-",
+		Kind:      bizUserKindFromProto(req.Kind),
+		OwnerKind: bizOwnerKindFromProto(req.OwnerKind),
+		OwnerID:   int(req.OwnerId),
+
+		DisplayName: req.DisplayName,
+		Phone:       req.Phone,
+		AvatarURL:   req.AvatarUrl,
+		Locale:      req.Locale,
+		Timezone:    req.Timezone,
+		Attributes:  req.GetAttributes().AsMap(),
+	}
+}
+
+func createUserError(err error, req *pb.CreateUserRequest) error {
+	switch err {
+	case biz.ErrResourceAlreadyExists:
+		return pb.ErrorUserAlreadyExisted("user %s already existed", req.Username)
+	case biz.ErrEmailAlreadyExists:
+		return pb.ErrorEmailAlreadyExisted("email %s is used by another user", req.Email)
+	case biz.ErrInvalidOwner:
+		return pb.ErrorInvalidOwner("invalid owner %s/%d", req.OwnerKind, req.OwnerId)
+	case biz.ErrInvalidProfile:
+		return pb.ErrorInvalidProfile("invalid user profile or attributes")
+	case biz.ErrInvalidUsername:
+		return pb.ErrorInvalidUsername("invalid username %s", req.Username)
+	}
+	return err
+}
+
 func (s *Service) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
-	log.Infof("update user %d, email=%s", req.Id, req.Email)
-	u, err := s.uc.UpdateUser(ctx, &repo.User{
-		ID:    int(req.Id),
-		Email: req.Email,
-	})
+	s.log.WithContext(ctx).Infof("update user %d, email=%s, update_mask=%v", req.Id, req.Email, req.GetUpdateMask().GetPaths())
+	u, err := s.uc.UpdateUser(ctx, bizUserFromUpdateRequest(req), req.GetUpdateMask().GetPaths())
 	if err != nil {
-		return nil, err
+		return nil, updateUserError(err, req)
 	}
 	return protoFromBizUser(u), nil
 }
 
+func bizUserFromUpdateRequest(req *pb.UpdateUserRequest) *repo.User {
+	return &repo.User{
+		ID:          int(req.Id),
+		Email:       req.Email,
+		DisplayName: req.DisplayName,
+		Phone:       req.Phone,
+		AvatarURL:   req.AvatarUrl,
+		Locale:      req.Locale,
+		Timezone:    req.Timezone,
+		Attributes:  req.GetAttributes().AsMap(),
+	}
+}
+
+func updateUserError(err error, req *pb.UpdateUserRequest) error {
+	switch err {
+	case biz.ErrInvalidProfile:
+		return pb.ErrorInvalidProfile("invalid user profile, attributes or update mask")
+	case biz.ErrEmailAlreadyExists:
+		return pb.ErrorEmailAlreadyExisted("email %s is used by another user", req.Email)
+	}
+	return userNotFoundError(err, req.Id)
+}
+
 func (s *Service) SetUserPassword(ctx context.Context, req *pb.SetUserPasswordRequest) (*pb.SetUserPasswordResponse, error) {
-	log.Infof("user %d set password", req.Id)
+	s.log.WithContext(ctx).Infof("user %d set password", req.Id)
 	if err := s.uc.SetUserPassword(ctx, int(req.Id), req.Password); err != nil {
+		switch err {
+		case biz.ErrHumanOnly:
+			return nil, pb.ErrorHumanOnlyOperation("service account %d can not set password", req.Id)
+		case biz.ErrExternalUser:
+			return nil, pb.ErrorExternallyManagedUser("user %d is managed by an external identity source", req.Id)
+		case biz.ErrWeakPassword:
+			return nil, pb.ErrorWeakPassword("password does not satisfy the password policy")
+		}
 		return nil, err
 	}
 	return &pb.SetUserPasswordResponse{}, nil
 }
 
 func (s *Service) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
-	log.Infof("delete user %d", req.Id)
+	s.log.WithContext(ctx).Infof("delete user %d", req.Id)
 	if err := s.uc.DeleteUser(ctx, int(req.Id)); err != nil {
-		return nil, err
+		return nil, userNotFoundError(err, req.Id)
 	}
 	return &pb.DeleteUserResponse{}, nil
 }
 
 func (s *Service) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
-	log.Infof("get user %d", req.Id)
+	s.log.WithContext(ctx).Infof("get user %d", req.Id)
 	u, err := s.uc.GetUser(ctx, int(req.Id))
 	if err != nil {
 		if err == biz.ErrResourceNotFound {
@@ -85,9 +140,12 @@ func (s *Service) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User
 }
 
 func (s *Service) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
-	log.Infof("list users, offset=%d, limit=%d", req.Offset, req.Limit)
-	us, err := s.uc.ListUsers(ctx, int(req.Offset), int(req.Limit))
+	s.log.WithContext(ctx).Infof("list users, offset=%d, limit=%d", req.Offset, req.Limit)
+	us, err := s.uc.ListUsers(ctx, bizUserFilterFromProto(req.GetFilters()), int(req.Offset), int(req.Limit))
 	if err != nil {
+		if err == biz.ErrInvalidProfile {
+			return nil, pb.ErrorInvalidProfile("invalid attribute filter")
+		}
 		return nil, err
 	}
 	var resp pb.ListUsersResponse
@@ -98,29 +156,336 @@ func (s *Service) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.
 }
 
 func (s *Service) Authenticate(ctx context.Context, req *pb.AuthenticateRequest) (*pb.AuthenticateResponse, error) {
+	var (
+		u   *repo.User
+		err error
+	)
 	switch method := req.AuthMethod.(type) {
 	default:
-		return nil, status.Errorf(codes.InvalidArgument, "invalid auth method")
+		return nil, pb.ErrorInvalidArgument("invalid auth method")
 	case *pb.AuthenticateRequest_BasicAuth_:
 		auth := method.BasicAuth
-		log.Infof("user %s authenticate, method: basic auth", auth.Username)
-		u, err := s.uc.GetUserByUsername(ctx, auth.GetUsername())
+		s.log.WithContext(ctx).Infow("msg", "authenticate", "method", "basic auth", "username", auth.Username)
+		u, err = s.uc.AuthenticateBasic(ctx, auth.GetUsername(), auth.GetPassword())
+		if err != nil {
+			switch err {
+			case biz.ErrInvalidCredentials:
+				return nil, pb.ErrorMismatchUsernamePassword("mismatch password")
+			case biz.ErrHumanOnly:
+				return nil, pb.ErrorHumanOnlyOperation("service account can not use password login")
+			}
+			return nil, err
+		}
+	case *pb.AuthenticateRequest_ApiKey:
+		s.log.WithContext(ctx).Infow("msg", "authenticate", "method", "api key")
+		u, err = s.uc.AuthenticateAPIKey(ctx, method.ApiKey)
 		if err != nil {
+			if err == biz.ErrInvalidCredentials {
+				return nil, pb.ErrorInvalidApiKey("invalid api key")
+			}
 			return nil, err
 		}
-		if u.Password != auth.GetPassword() {
-			return nil, pb.ErrorMismatchUsernamePassword("mismatch password")
+	}
+	return &pb.AuthenticateResponse{User: protoFromBizUser(u)}, nil
+}
+
+func (s *Service) SendVerificationEmail(ctx context.Context, req *pb.SendVerificationEmailRequest) (*pb.SendVerificationEmailResponse, error) {
+	s.log.WithContext(ctx).Infof("send verification email to user %d", req.Id)
+	if err := s.uc.SendVerificationEmail(ctx, int(req.Id)); err != nil {
+		switch err {
+		case biz.ErrResourceNotFound:
+			return nil, pb.ErrorUserNotFound("user %d not found", req.Id)
+		case biz.ErrHumanOnly:
+			return nil, pb.ErrorHumanOnlyOperation("service account %d has no email to verify", req.Id)
+		case biz.ErrEmailNotSet:
+			return nil, pb.ErrorEmailNotSet("user %d has no email", req.Id)
+		case biz.ErrEmailAlreadyVerified:
+			return nil, pb.ErrorEmailAlreadyVerified("email of user %d is already verified", req.Id)
+		}
+		return nil, err
+	}
+	return &pb.SendVerificationEmailResponse{}, nil
+}
+
+func (s *Service) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.User, error) {
+	u, err := s.uc.VerifyEmail(ctx, req.Token)
+	if err != nil {
+		if err == biz.ErrInvalidToken {
+			return nil, pb.ErrorInvalidToken("invalid or expired verification token")
+		}
+		return nil, err
+	}
+	s.log.WithContext(ctx).Infof("user %d verified email", u.ID)
+	return protoFromBizUser(u), nil
+}
+
+func (s *Service) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	s.log.WithContext(ctx).Infof("request password reset for %s", req.Identifier)
+	// 错误只记录日志，不返回给调用方，避免泄露用户是否存在
+	if err := s.uc.RequestPasswordReset(ctx, req.Identifier); err != nil {
+		s.log.WithContext(ctx).Errorf("request password reset for %s: %v", req.Identifier, err)
+	}
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+func (s *Service) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	if err := s.uc.ResetPassword(ctx, req.Token, req.Password); err != nil {
+		switch err {
+		case biz.ErrInvalidToken:
+			return nil, pb.ErrorInvalidToken("invalid or expired password reset token")
+		case biz.ErrWeakPassword:
+			return nil, pb.ErrorWeakPassword("password does not satisfy the password policy")
+		}
+		return nil, err
+	}
+	return &pb.ResetPasswordResponse{}, nil
+}
+
+func (s *Service) CreateInvitation(ctx context.Context, req *pb.CreateInvitationRequest) (*pb.Invitation, error) {
+	s.log.WithContext(ctx).Infof("create invitation for %s, groups=%v", req.Email, req.GroupIds)
+	groupIDs := make([]int, 0, len(req.GroupIds))
+	for _, id := range req.GroupIds {
+		groupIDs = append(groupIDs, int(id))
+	}
+	inv, err := s.uc.CreateInvitation(ctx, &repo.Invitation{
+		Email:    req.Email,
+		GroupIDs: groupIDs,
+	})
+	if err != nil {
+		if err == biz.ErrInvalidGroup {
+			return nil, pb.ErrorInvalidGroup("invalid groups %v", req.GroupIds)
+		}
+		return nil, err
+	}
+	return protoFromBizInvitation(inv, time.Now()), nil
+}
+
+func (s *Service) ListInvitations(ctx context.Context, req *pb.ListInvitationsRequest) (*pb.ListInvitationsResponse, error) {
+	s.log.WithContext(ctx).Infof("list invitations, offset=%d, limit=%d", req.Offset, req.Limit)
+	filter := &repo.InvitationFilter{
+		Status: bizInvitationStatusFromProto(req.GetFilters().GetStatus()),
+		Email:  req.GetFilters().GetEmail(),
+	}
+	invs, err := s.uc.ListInvitations(ctx, filter, int(req.Offset), int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var resp pb.ListInvitationsResponse
+	for _, inv := range invs {
+		resp.Invitations = append(resp.Invitations, protoFromBizInvitation(inv, now))
+	}
+	return &resp, nil
+}
+
+func (s *Service) RevokeInvitation(ctx context.Context, req *pb.RevokeInvitationRequest) (*pb.RevokeInvitationResponse, error) {
+	s.log.WithContext(ctx).Infof("revoke invitation %d", req.Id)
+	if err := s.uc.RevokeInvitation(ctx, int(req.Id)); err != nil {
+		switch err {
+		case biz.ErrResourceNotFound:
+			return nil, pb.ErrorInvitationNotFound("invitation %d not found", req.Id)
+		case biz.ErrInvitationNotPending:
+			return nil, pb.ErrorInvitationNotPending("invitation %d is no longer pending", req.Id)
+		}
+		return nil, err
+	}
+	return &pb.RevokeInvitationResponse{}, nil
+}
+
+func (s *Service) AcceptInvitation(ctx context.Context, req *pb.AcceptInvitationRequest) (*pb.User, error) {
+	s.log.WithContext(ctx).Infof("accept invitation, username=%s", req.Username)
+	u, err := s.uc.AcceptInvitation(ctx, req.Token, req.Username, req.Password)
+	if err != nil {
+		switch err {
+		case biz.ErrInvalidToken:
+			return nil, pb.ErrorInvalidToken("invalid or expired invitation")
+		case biz.ErrWeakPassword:
+			return nil, pb.ErrorWeakPassword("password does not satisfy the password policy")
+		case biz.ErrResourceAlreadyExists:
+			return nil, pb.ErrorUserAlreadyExisted("user %s already existed", req.Username)
+		case biz.ErrEmailAlreadyExists:
+			return nil, pb.ErrorEmailAlreadyExisted("email of the invitation is used by another user")
+		case biz.ErrInvalidUsername:
+			return nil, pb.ErrorInvalidUsername("invalid username %s", req.Username)
 		}
+		return nil, err
+	}
+	return protoFromBizUser(u), nil
+}
+
+func (s *Service) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	s.log.WithContext(ctx).Infof("create api key %s for user %d", req.Name, req.UserId)
+	k, key, err := s.uc.CreateAPIKey(ctx, int(req.UserId), req.Name, time.Duration(req.Ttl)*time.Second)
+	if err != nil {
+		switch err {
+		case biz.ErrResourceNotFound:
+			return nil, pb.ErrorUserNotFound("user %d not found", req.UserId)
+		case biz.ErrServiceAccountOnly:
+			return nil, pb.ErrorServiceAccountOnlyOperation("user %d is not a service account", req.UserId)
+		}
+		return nil, err
 	}
-	return &pb.AuthenticateResponse{}, nil
+	return &pb.CreateAPIKeyResponse{
+		ApiKey: protoFromBizAPIKey(k),
+		Key:    key,
+	}, nil
+}
+
+func (s *Service) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	s.log.WithContext(ctx).Infof("list api keys of user %d", req.UserId)
+	ks, err := s.uc.ListAPIKeys(ctx, int(req.UserId))
+	if err != nil {
+		if err == biz.ErrResourceNotFound {
+			return nil, pb.ErrorUserNotFound("user %d not found", req.UserId)
+		}
+		return nil, err
+	}
+	var resp pb.ListAPIKeysResponse
+	for _, k := range ks {
+		resp.ApiKeys = append(resp.ApiKeys, protoFromBizAPIKey(k))
+	}
+	return &resp, nil
+}
+
+func (s *Service) DeleteAPIKey(ctx context.Context, req *pb.DeleteAPIKeyRequest) (*pb.DeleteAPIKeyResponse, error) {
+	s.log.WithContext(ctx).Infof("delete api key %d of user %d", req.Id, req.UserId)
+	if err := s.uc.DeleteAPIKey(ctx, int(req.UserId), int(req.Id)); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteAPIKeyResponse{}, nil
 }
 
 func protoFromBizUser(u *repo.User) *pb.User {
+	// 属性值已经过校验，只包含 string、float64 和 bool
+	attrs, _ := structpb.NewStruct(u.Attributes)
 	return &pb.User{
-		Id:         int64(u.ID),
-		Username:   u.Username,
-		Email:      u.Email,
-		CreateTime: u.CreateTime.Unix(),
-		UpdateTime: u.UpdateTime.Unix(),
+		Id:            int64(u.ID),
+		Username:      u.Username,
+		Email:         u.Email,
+		CreateTime:    u.CreateTime.Unix(),
+		UpdateTime:    u.UpdateTime.Unix(),
+		Kind:          protoFromBizUserKind(u.Kind),
+		OwnerKind:     protoFromBizOwnerKind(u.OwnerKind),
+		OwnerId:       int64(u.OwnerID),
+		Source:        u.Source,
+		EmailVerified: u.EmailVerified,
+		DisplayName:   u.DisplayName,
+		Phone:         u.Phone,
+		AvatarUrl:     u.AvatarURL,
+		Locale:        u.Locale,
+		Timezone:      u.Timezone,
+		Attributes:    attrs,
+	}
+}
+
+func protoFromBizAPIKey(k *repo.APIKey) *pb.APIKey {
+	ak := &pb.APIKey{
+		Id:         int64(k.ID),
+		UserId:     int64(k.UserID),
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		CreateTime: k.CreateTime.Unix(),
+	}
+	if !k.ExpireTime.IsZero() {
+		ak.ExpireTime = k.ExpireTime.Unix()
+	}
+	if !k.LastUsedTime.IsZero() {
+		ak.LastUsedTime = k.LastUsedTime.Unix()
+	}
+	return ak
+}
+
+func protoFromBizInvitation(inv *repo.Invitation, now time.Time) *pb.Invitation {
+	res := &pb.Invitation{
+		Id:         int64(inv.ID),
+		Email:      inv.Email,
+		Status:     protoFromBizInvitationStatus(inv.Status),
+		ExpireTime: inv.ExpireTime.Unix(),
+		UserId:     int64(inv.UserID),
+		CreateTime: inv.CreateTime.Unix(),
+	}
+	for _, id := range inv.GroupIDs {
+		res.GroupIds = append(res.GroupIds, int64(id))
+	}
+	if inv.Status == repo.InvitationStatusPending && inv.Expired(now) {
+		res.Status = pb.InvitationStatus_INVITATION_STATUS_EXPIRED
+	}
+	if !inv.AcceptTime.IsZero() {
+		res.AcceptTime = inv.AcceptTime.Unix()
+	}
+	return res
+}
+
+func protoFromBizInvitationStatus(s repo.InvitationStatus) pb.InvitationStatus {
+	switch s {
+	case repo.InvitationStatusPending:
+		return pb.InvitationStatus_INVITATION_STATUS_PENDING
+	case repo.InvitationStatusAccepted:
+		return pb.InvitationStatus_INVITATION_STATUS_ACCEPTED
+	case repo.InvitationStatusRevoked:
+		return pb.InvitationStatus_INVITATION_STATUS_REVOKED
+	case repo.InvitationStatusExpired:
+		return pb.InvitationStatus_INVITATION_STATUS_EXPIRED
+	default:
+		return pb.InvitationStatus_INVITATION_STATUS_UNSPECIFIED
+	}
+}
+
+func bizInvitationStatusFromProto(s pb.InvitationStatus) repo.InvitationStatus {
+	switch s {
+	case pb.InvitationStatus_INVITATION_STATUS_PENDING:
+		return repo.InvitationStatusPending
+	case pb.InvitationStatus_INVITATION_STATUS_ACCEPTED:
+		return repo.InvitationStatusAccepted
+	case pb.InvitationStatus_INVITATION_STATUS_REVOKED:
+		return repo.InvitationStatusRevoked
+	case pb.InvitationStatus_INVITATION_STATUS_EXPIRED:
+		return repo.InvitationStatusExpired
+	default:
+		return ""
+	}
+}
+
+func protoFromBizUserKind(k repo.UserKind) pb.UserKind {
+	switch k {
+	case repo.UserKindHuman:
+		return pb.UserKind_USER_KIND_HUMAN
+	case repo.UserKindService:
+		return pb.UserKind_USER_KIND_SERVICE
+	default:
+		return pb.UserKind_USER_KIND_UNSPECIFIED
+	}
+}
+
+func bizUserKindFromProto(k pb.UserKind) repo.UserKind {
+	switch k {
+	case pb.UserKind_USER_KIND_HUMAN:
+		return repo.UserKindHuman
+	case pb.UserKind_USER_KIND_SERVICE:
+		return repo.UserKindService
+	default:
+		return ""
+	}
+}
+
+func protoFromBizOwnerKind(k repo.OwnerKind) pb.OwnerKind {
+	switch k {
+	case repo.OwnerKindUser:
+		return pb.OwnerKind_OWNER_KIND_USER
+	case repo.OwnerKindGroup:
+		return pb.OwnerKind_OWNER_KIND_GROUP
+	default:
+		return pb.OwnerKind_OWNER_KIND_UNSPECIFIED
+	}
+}
+
+func bizOwnerKindFromProto(k pb.OwnerKind) repo.OwnerKind {
+	switch k {
+	case pb.OwnerKind_OWNER_KIND_USER:
+		return repo.OwnerKindUser
+	case pb.OwnerKind_OWNER_KIND_GROUP:
+		return repo.OwnerKindGroup
+	default:
+		return ""
 	}
 }
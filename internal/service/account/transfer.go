@@ -0,0 +1,285 @@
+package account
+
+import (
+	"bufio"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pb "usm/api/account/v1"
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	acctuc "usm/internal/biz/usecase/account"
+	"usm/internal/service/apierr"
+
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// 流式 RPC 没有生成 HTTP 路由，导入导出使用单独的 HTTP 端点：
+// 导入时请求体为文件内容，导出时响应体为分块传输的文件内容，参数通过查询字符串传递
+const (
+	PathImportUsers = "/account/v1/users:import"
+	PathExportUsers = "/account/v1/users:export"
+	exportChunkSize = 64 << 10
+)
+
+func (s *Service) ImportUsers(stream pb.Account_ImportUsersServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	o := req.GetOptions()
+	if o == nil {
+		return pb.ErrorInvalidImport("the first message must be options")
+	}
+	if err := apierr.Validate(req); err != nil {
+		return err
+	}
+	opts := &acctuc.ImportOptions{
+		Format:  bizTransferFormatFromProto(o.Format),
+		Mapping: o.Mapping,
+		DryRun:  o.DryRun,
+		Upsert:  o.Upsert,
+	}
+	s.log.WithContext(stream.Context()).Infof("import users, format=%s, dry_run=%v, upsert=%v", opts.Format, opts.DryRun, opts.Upsert)
+	report, err := s.uc.ImportUsers(stream.Context(), &importStreamReader{stream: stream}, opts)
+	if err != nil {
+		return importUsersError(err)
+	}
+	return stream.SendAndClose(protoFromImportReport(report))
+}
+
+// importStreamReader 将后续消息中的 data 拼接为文件内容
+type importStreamReader struct {
+	stream pb.Account_ImportUsersServer
+	buf    []byte
+}
+
+func (r *importStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if req.GetOptions() != nil {
+			return 0, pb.ErrorInvalidImport("options must only be sent in the first message")
+		}
+		r.buf = req.GetData()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (s *Service) ExportUsers(req *pb.ExportUsersRequest, stream pb.Account_ExportUsersServer) error {
+	format := bizTransferFormatFromProto(req.Format)
+	s.log.WithContext(stream.Context()).Infof("export users, format=%s", format)
+	w := bufio.NewWriterSize(&exportStreamWriter{stream: stream}, exportChunkSize)
+	if _, err := s.uc.ExportUsers(stream.Context(), w, bizUserFilterFromProto(req.GetFilters()), format); err != nil {
+		return exportUsersError(err)
+	}
+	return w.Flush()
+}
+
+type exportStreamWriter struct {
+	stream pb.Account_ExportUsersServer
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&pb.ExportUsersResponse{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// ImportUsersHTTP 处理 POST /account/v1/users:import?format=csv&dry_run=true&upsert=true&mapping=login:username，
+// 响应与 ImportUsers 相同
+func (s *Service) ImportUsersHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	opts, err := importOptionsFromQuery(r)
+	if err != nil {
+		khttp.DefaultErrorEncoder(w, r, apierr.Translate(err))
+		return
+	}
+	s.log.WithContext(r.Context()).Infof("import users over http, format=%s, dry_run=%v, upsert=%v", opts.Format, opts.DryRun, opts.Upsert)
+	report, err := s.uc.ImportUsers(r.Context(), r.Body, opts)
+	if err != nil {
+		khttp.DefaultErrorEncoder(w, r, apierr.Translate(importUsersError(err)))
+		return
+	}
+	if err := khttp.DefaultResponseEncoder(w, r, protoFromImportReport(report)); err != nil {
+		s.log.WithContext(r.Context()).Errorf("encode import report: %v", err)
+	}
+}
+
+func importOptionsFromQuery(r *http.Request) (*acctuc.ImportOptions, error) {
+	q := r.URL.Query()
+	format, err := acctuc.ParseTransferFormat(q.Get("format"))
+	if err != nil {
+		return nil, pb.ErrorInvalidImport("unsupported format %q", q.Get("format"))
+	}
+	opts := &acctuc.ImportOptions{Format: format}
+	if opts.DryRun, err = queryBool(q.Get("dry_run")); err != nil {
+		return nil, pb.ErrorInvalidImport("invalid dry_run %q", q.Get("dry_run"))
+	}
+	if opts.Upsert, err = queryBool(q.Get("upsert")); err != nil {
+		return nil, pb.ErrorInvalidImport("invalid upsert %q", q.Get("upsert"))
+	}
+	for _, m := range q["mapping"] {
+		// 用户字段不包含冒号，源列名可以包含
+		i := strings.LastIndex(m, ":")
+		if i < 0 {
+			return nil, pb.ErrorInvalidImport("invalid mapping %q, expected source:field", m)
+		}
+		if opts.Mapping == nil {
+			opts.Mapping = make(map[string]string)
+		}
+		opts.Mapping[m[:i]] = m[i+1:]
+	}
+	return opts, nil
+}
+
+// ExportUsersHTTP 处理 GET /account/v1/users:export?format=ndjson&kind=human&attributes.department=dev
+func (s *Service) ExportUsersHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	q := r.URL.Query()
+	format, err := acctuc.ParseTransferFormat(q.Get("format"))
+	if err != nil {
+		khttp.DefaultErrorEncoder(w, r, pb.ErrorInvalidArgument("unsupported format %q", q.Get("format")))
+		return
+	}
+	filter := &repo.UserFilter{}
+	switch k := q.Get("kind"); k {
+	case "":
+	case string(repo.UserKindHuman), string(repo.UserKindService):
+		filter.Kind = repo.UserKind(k)
+	default:
+		khttp.DefaultErrorEncoder(w, r, pb.ErrorInvalidArgument("kind must be human or service"))
+		return
+	}
+	for k, v := range q {
+		if name := strings.TrimPrefix(k, "attributes."); name != k && len(v) > 0 {
+			if filter.Attributes == nil {
+				filter.Attributes = make(map[string]interface{})
+			}
+			filter.Attributes[name] = v[0]
+		}
+	}
+	s.log.WithContext(r.Context()).Infof("export users over http, format=%s", format)
+	fw := &exportHTTPWriter{w: w, format: format}
+	bw := bufio.NewWriterSize(fw, exportChunkSize)
+	_, err = s.uc.ExportUsers(r.Context(), bw, filter, format)
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		if !fw.started {
+			khttp.DefaultErrorEncoder(w, r, apierr.Translate(exportUsersError(err)))
+			return
+		}
+		// 已经开始发送响应，只能中断连接
+		s.log.WithContext(r.Context()).Errorf("export users: %v", err)
+		panic(http.ErrAbortHandler)
+	}
+	if !fw.started {
+		fw.writeHeader()
+	}
+}
+
+// exportHTTPWriter 第一次写入时发送响应头，每次写入后立即发送给客户端
+type exportHTTPWriter struct {
+	w       http.ResponseWriter
+	format  acctuc.TransferFormat
+	started bool
+}
+
+func (w *exportHTTPWriter) writeHeader() {
+	w.started = true
+	if w.format == acctuc.TransferFormatNDJSON {
+		w.w.Header().Set("Content-Type", "application/x-ndjson")
+	} else {
+		w.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	}
+	w.w.Header().Set("Content-Disposition", `attachment; filename="users.`+string(w.format)+`"`)
+	w.w.WriteHeader(http.StatusOK)
+}
+
+func (w *exportHTTPWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.writeHeader()
+	}
+	n, err := w.w.Write(p)
+	if f, ok := w.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return n, err
+}
+
+func queryBool(s string) (bool, error) {
+	if s == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(s)
+}
+
+func importUsersError(err error) error {
+	if errors.Is(err, biz.ErrInvalidImport) {
+		return pb.ErrorInvalidImport("%s", err.Error())
+	}
+	return err
+}
+
+func exportUsersError(err error) error {
+	if err == biz.ErrInvalidProfile {
+		return pb.ErrorInvalidProfile("invalid attribute filter")
+	}
+	return err
+}
+
+func protoFromImportReport(r *acctuc.ImportReport) *pb.ImportUsersResponse {
+	res := &pb.ImportUsersResponse{
+		Total:   int64(r.Total),
+		Created: int64(r.Created),
+		Updated: int64(r.Updated),
+		Failed:  int64(r.Failed),
+		DryRun:  r.DryRun,
+		Errors:  make([]*pb.ImportUsersResponse_RowError, 0, len(r.Errors)),
+	}
+	for _, e := range r.Errors {
+		res.Errors = append(res.Errors, &pb.ImportUsersResponse_RowError{
+			Line:     int64(e.Line),
+			Username: e.Username,
+			Message:  e.Err.Error(),
+		})
+	}
+	return res
+}
+
+func bizTransferFormatFromProto(f pb.TransferFormat) acctuc.TransferFormat {
+	if f == pb.TransferFormat_TRANSFER_FORMAT_NDJSON {
+		return acctuc.TransferFormatNDJSON
+	}
+	return acctuc.TransferFormatCSV
+}
+
+func bizUserFilterFromProto(f *pb.ListUsersRequest_Filters) *repo.UserFilter {
+	filter := &repo.UserFilter{
+		Kind: bizUserKindFromProto(f.GetKind()),
+	}
+	if attrs := f.GetAttributes(); len(attrs) > 0 {
+		filter.Attributes = make(map[string]interface{}, len(attrs))
+		for k, v := range attrs {
+			filter.Attributes[k] = v
+		}
+	}
+	return filter
+}
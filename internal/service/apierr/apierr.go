@@ -0,0 +1,131 @@
+// Package apierr 将业务错误统一转换为 api 中定义的错误原因，保证数据库等内部错误不会返回给客户端
+package apierr
+
+import (
+	"context"
+	"errors"
+
+	pb "usm/api/account/v1"
+	"usm/internal/biz"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	httpstatus "github.com/go-kratos/kratos/v2/transport/http/status"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 服务没有转换的业务错误按此表转换，消息为业务错误本身的消息，不包含包装的内部错误
+var bizErrors = []struct {
+	err error
+	new func(format string, args ...interface{}) *kerrors.Error
+}{
+	{biz.ErrResourceNotFound, pb.ErrorResourceNotFound},
+	{biz.ErrResourceAlreadyExists, pb.ErrorResourceAlreadyExists},
+	{biz.ErrEmailAlreadyExists, pb.ErrorEmailAlreadyExisted},
+	{biz.ErrInvalidCredentials, pb.ErrorMismatchUsernamePassword},
+	{biz.ErrHumanOnly, pb.ErrorHumanOnlyOperation},
+	{biz.ErrServiceAccountOnly, pb.ErrorServiceAccountOnlyOperation},
+	{biz.ErrInvalidOwner, pb.ErrorInvalidOwner},
+	{biz.ErrExternalUser, pb.ErrorExternallyManagedUser},
+	{biz.ErrInvalidToken, pb.ErrorInvalidToken},
+	{biz.ErrEmailNotSet, pb.ErrorEmailNotSet},
+	{biz.ErrEmailAlreadyVerified, pb.ErrorEmailAlreadyVerified},
+	{biz.ErrWeakPassword, pb.ErrorWeakPassword},
+	{biz.ErrInvitationNotPending, pb.ErrorInvitationNotPending},
+	{biz.ErrInvalidGroup, pb.ErrorInvalidGroup},
+	{biz.ErrInvalidProfile, pb.ErrorInvalidProfile},
+	{biz.ErrInvalidUsername, pb.ErrorInvalidUsername},
+	{biz.ErrBatchAborted, pb.ErrorBatchAborted},
+	{biz.ErrInvalidImport, pb.ErrorInvalidImport},
+}
+
+// Error 附带 google.rpc.BadRequest 字段错误的 API 错误，字段错误同时写入 ErrorInfo 的 metadata 以便 HTTP 客户端获取
+type Error struct {
+	err        *kerrors.Error
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+func (e *Error) Violations() []*errdetails.BadRequest_FieldViolation {
+	return e.violations
+}
+
+func (e *Error) GRPCStatus() *status.Status {
+	s, _ := status.New(httpstatus.ToGRPCCode(int(e.err.Code)), e.err.Message).WithDetails(
+		&errdetails.ErrorInfo{Reason: e.err.Reason, Metadata: e.err.Metadata},
+		&errdetails.BadRequest{FieldViolations: e.violations},
+	)
+	return s
+}
+
+// Translate 转换错误：已经是 API 错误或 gRPC 状态的保持不变，业务错误按 bizErrors 转换，
+// 其他错误记录日志后只返回 INTERNAL
+func Translate(err error) error {
+	return translate(log.GetLogger(), err)
+}
+
+func translate(logger log.Logger, err error) error {
+	if err == nil {
+		return nil
+	}
+	var ae *Error
+	if errors.As(err, &ae) {
+		return ae
+	}
+	var ke *kerrors.Error
+	if errors.As(err, &ke) {
+		return ke
+	}
+	if s, ok := status.FromError(err); ok && s.Code() != codes.Unknown && s.Code() != codes.Internal {
+		return err
+	}
+	for _, e := range bizErrors {
+		if errors.Is(err, e.err) {
+			// 包装的错误可能带有事务回滚等内部错误，只记录在日志中
+			if err != e.err {
+				log.NewHelper(logger).Warnf("%v", err)
+			}
+			return e.new("%s", e.err.Error())
+		}
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return pb.ErrorRequestCanceled("request canceled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return pb.ErrorDeadlineExceeded("deadline exceeded")
+	}
+	log.NewHelper(logger).Errorf("internal error: %v", err)
+	return pb.ErrorInternal("internal error")
+}
+
+// Translator 转换 handler 返回的错误，应放在 recovery 之后、validator 之前，
+// 内部错误通过 logger 记录，日志中带有请求的 trace_id
+func Translator(logger log.Logger) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			if err != nil {
+				return nil, translate(log.WithContext(ctx, logger), err)
+			}
+			return reply, nil
+		}
+	}
+}
+
+// StreamServerInterceptor 流式 RPC 不经过 kratos 中间件，通过拦截器转换错误
+func StreamServerInterceptor(logger log.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return translate(log.WithContext(ss.Context(), logger), handler(srv, ss))
+	}
+}
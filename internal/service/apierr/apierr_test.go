@@ -0,0 +1,144 @@
+package apierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	pb "usm/api/account/v1"
+	"usm/internal/biz"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    int32
+		wantReason  string
+		wantMessage string
+	}{
+		{
+			name:       "should keep api error",
+			err:        pb.ErrorUserNotFound("user 1 not found"),
+			wantCode:   404,
+			wantReason: pb.ErrorReason_USER_NOT_FOUND.String(),
+		},
+		{
+			name:       "should translate biz error",
+			err:        biz.ErrResourceNotFound,
+			wantCode:   404,
+			wantReason: pb.ErrorReason_RESOURCE_NOT_FOUND.String(),
+		},
+		{
+			name:        "should translate wrapped biz error",
+			err:         fmt.Errorf("%w: missing csv header", biz.ErrInvalidImport),
+			wantCode:    400,
+			wantReason:  pb.ErrorReason_INVALID_IMPORT.String(),
+			wantMessage: biz.ErrInvalidImport.Error(),
+		},
+		{
+			name:        "should hide internal error wrapped with biz error",
+			err:         fmt.Errorf("%w, rolling back transaction: sql: transaction has already been committed or rolled back", biz.ErrResourceAlreadyExists),
+			wantCode:    409,
+			wantReason:  pb.ErrorReason_RESOURCE_ALREADY_EXISTS.String(),
+			wantMessage: biz.ErrResourceAlreadyExists.Error(),
+		},
+		{
+			name:       "should keep grpc status",
+			err:        status.Error(codes.PermissionDenied, "denied"),
+			wantCode:   403,
+			wantReason: "",
+		},
+		{
+			name:       "should translate canceled context",
+			err:        context.Canceled,
+			wantCode:   499,
+			wantReason: pb.ErrorReason_REQUEST_CANCELED.String(),
+		},
+		{
+			name:       "should hide internal error",
+			err:        errors.New("sql: database is closed"),
+			wantCode:   500,
+			wantReason: pb.ErrorReason_INTERNAL.String(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := kerrors.FromError(Translate(tt.err))
+			assert.Equal(t, tt.wantCode, e.Code)
+			assert.Equal(t, tt.wantReason, e.Reason)
+			assert.NotContains(t, e.Message, "sql")
+			if tt.wantMessage != "" {
+				assert.Equal(t, tt.wantMessage, e.Message)
+			}
+		})
+	}
+	assert.Nil(t, Translate(nil))
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+		want map[string]string
+	}{
+		{
+			name: "should pass valid request",
+			req:  &pb.CreateUserRequest{Username: "alice"},
+		},
+		{
+			name: "should report all invalid fields",
+			req:  &pb.CreateUserRequest{Username: "a", Email: "not-an-email"},
+			want: map[string]string{
+				"username": "value length must be between 2 and 50 runes, inclusive",
+				"email":    "value must be a valid email address",
+			},
+		},
+		{
+			name: "should report nested fields",
+			req:  &pb.BatchCreateUsersRequest{Requests: []*pb.CreateUserRequest{{Username: "alice"}, {Username: "-bob"}}},
+			want: map[string]string{
+				"requests[1].username": "value does not match regex pattern \"^[\\\\p{L}\\\\p{N}][\\\\p{L}\\\\p{N}._@+-]*$\"",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.req)
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			e := kerrors.FromError(err)
+			assert.Equal(t, int32(400), e.Code)
+			assert.Equal(t, pb.ErrorReason_INVALID_ARGUMENT.String(), e.Reason)
+			assert.Equal(t, tt.want, e.Metadata)
+
+			s, _ := status.FromError(err)
+			assert.Equal(t, codes.InvalidArgument, s.Code())
+			var got map[string]string
+			for _, d := range s.Details() {
+				if br, ok := d.(*errdetails.BadRequest); ok {
+					got = make(map[string]string)
+					for _, v := range br.FieldViolations {
+						got[v.Field] = v.Description
+					}
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestProtoFieldName(t *testing.T) {
+	assert.Equal(t, "avatar_url", protoFieldName("AvatarUrl"))
+	assert.Equal(t, "requests[0]", protoFieldName("Requests[0]"))
+	assert.Equal(t, "owner_id", protoFieldName("OwnerId"))
+}
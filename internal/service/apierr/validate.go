@@ -0,0 +1,111 @@
+package apierr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	pb "usm/api/account/v1"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// protoc-gen-validate 生成的校验错误
+type validationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+type multiError interface {
+	AllErrors() []error
+}
+
+// Validator 校验请求的全部字段，替代 kratos 的 validate 中间件以返回每个字段的错误
+func Validator() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if err := Validate(req); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Validate 校验请求，失败时返回 INVALID_ARGUMENT
+func Validate(req interface{}) error {
+	var err error
+	switch v := req.(type) {
+	case interface{ ValidateAll() error }:
+		err = v.ValidateAll()
+	case interface{ Validate() error }:
+		err = v.Validate()
+	}
+	if err == nil {
+		return nil
+	}
+	return InvalidArgument(err)
+}
+
+// InvalidArgument 将校验错误转换为 INVALID_ARGUMENT，字段名使用 proto 字段名，例如 requests[0].username
+func InvalidArgument(err error) *Error {
+	violations := fieldViolations("", err)
+	md := make(map[string]string, len(violations))
+	for _, v := range violations {
+		if d, ok := md[v.Field]; ok {
+			md[v.Field] = d + "; " + v.Description
+		} else {
+			md[v.Field] = v.Description
+		}
+	}
+	message := fmt.Sprintf("%d invalid fields", len(violations))
+	if len(violations) == 1 {
+		message = fmt.Sprintf("invalid %s: %s", violations[0].Field, violations[0].Description)
+	}
+	return &Error{
+		err:        pb.ErrorInvalidArgument("%s", message).WithMetadata(md),
+		violations: violations,
+	}
+}
+
+func fieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	if m, ok := err.(multiError); ok {
+		var res []*errdetails.BadRequest_FieldViolation
+		for _, e := range m.AllErrors() {
+			res = append(res, fieldViolations(prefix, e)...)
+		}
+		return res
+	}
+	v, ok := err.(validationError)
+	if !ok {
+		return []*errdetails.BadRequest_FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+	field := protoFieldName(v.Field())
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+	// 嵌套消息的错误在 Cause 中
+	switch cause := v.Cause().(type) {
+	case validationError, multiError:
+		return fieldViolations(field, cause)
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: v.Reason()}}
+}
+
+// protoFieldName 将生成代码中的 Go 字段名转换为 proto 字段名，例如 AvatarUrl -> avatar_url，Requests[0] -> requests[0]
+func protoFieldName(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 && s[i-1] != '[' {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
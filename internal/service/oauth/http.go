@@ -0,0 +1,317 @@
+package oauth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"html/template"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	oauthuc "usm/internal/biz/usecase/oauth"
+)
+
+// 协议端点不走 protobuf，请求和响应格式由 OAuth 2.0 / OIDC 规范约定
+const (
+	PathAuthorize     = "/oauth2/authorize"
+	PathToken         = "/oauth2/token"
+	PathIntrospect    = "/oauth2/introspect"
+	PathRevoke        = "/oauth2/revoke"
+	PathUserInfo      = "/oauth2/userinfo"
+	PathDiscovery     = "/.well-known/openid-configuration"
+	PathJWKS          = "/.well-known/jwks.json"
+	maxFormBodyLength = 1 << 20
+)
+
+var loginTemplate = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Sign in to {{.Client}}</title></head>
+<body>
+<h3>Sign in to {{.Client}}</h3>
+{{if .Error}}<p style="color:red">{{.Error}}</p>{{end}}
+<form method="post" action="{{.Action}}">
+{{range $k, $v := .Params}}<input type="hidden" name="{{$k}}" value="{{$v}}">
+{{end}}<p><input name="username" placeholder="username" autofocus></p>
+<p><input name="password" type="password" placeholder="password"></p>
+<p><button type="submit">Sign in</button></p>
+</form>
+</body>
+</html>
+`))
+
+func (s *Service) Authorize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxFormBodyLength)
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, oauthuc.ErrCodeInvalidRequest, "malformed request")
+		return
+	}
+	req := &oauthuc.AuthorizeRequest{
+		ResponseType:        r.Form.Get("response_type"),
+		ClientID:            r.Form.Get("client_id"),
+		RedirectURI:         r.Form.Get("redirect_uri"),
+		Scope:               r.Form.Get("scope"),
+		State:               r.Form.Get("state"),
+		CodeChallenge:       r.Form.Get("code_challenge"),
+		CodeChallengeMethod: r.Form.Get("code_challenge_method"),
+		Nonce:               r.Form.Get("nonce"),
+	}
+	client, redirect, err := s.uc.ValidateAuthorizeRequest(r.Context(), req)
+	if err != nil {
+		if redirect {
+			redirectError(w, r, req, err)
+			return
+		}
+		s.writeUsecaseError(w, err)
+		return
+	}
+	if r.Method == http.MethodGet {
+		s.renderLogin(w, r, client, "")
+		return
+	}
+	user, err := s.acctuc.AuthenticateBasic(r.Context(), r.PostForm.Get("username"), r.PostForm.Get("password"))
+	if err != nil {
+		if err == biz.ErrInvalidCredentials || err == biz.ErrHumanOnly {
+			s.renderLogin(w, r, client, "invalid username or password")
+			return
+		}
+		s.log.Errorw("msg", "authenticate failed", "username", r.PostForm.Get("username"), "error", err)
+		redirectError(w, r, req, err)
+		return
+	}
+	code, err := s.uc.Authorize(r.Context(), req, user)
+	if err != nil {
+		redirectError(w, r, req, err)
+		return
+	}
+	q := url.Values{"code": {code}}
+	if req.State != "" {
+		q.Set("state", req.State)
+	}
+	http.Redirect(w, r, appendQuery(req.RedirectURI, q), http.StatusFound)
+}
+
+func (s *Service) renderLogin(w http.ResponseWriter, r *http.Request, client *repo.OAuthClient, msg string) {
+	params := map[string]string{}
+	for _, k := range []string{"response_type", "client_id", "redirect_uri", "scope", "state", "code_challenge", "code_challenge_method", "nonce"} {
+		if v := r.Form.Get(k); v != "" {
+			params[k] = v
+		}
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	if msg != "" {
+		w.WriteHeader(http.StatusUnauthorized)
+	}
+	err := loginTemplate.Execute(w, map[string]interface{}{
+		"Client": client.Name,
+		"Error":  msg,
+		"Action": PathAuthorize,
+		"Params": params,
+	})
+	if err != nil {
+		s.log.Errorf("render login page failed: %v", err)
+	}
+}
+
+func (s *Service) Token(w http.ResponseWriter, r *http.Request) {
+	if !s.parsePostForm(w, r) {
+		return
+	}
+	clientID, clientSecret := clientCredentials(r)
+	resp, err := s.uc.Token(r.Context(), &oauthuc.TokenRequest{
+		GrantType:    r.PostForm.Get("grant_type"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		RefreshToken: r.PostForm.Get("refresh_token"),
+		Scope:        r.PostForm.Get("scope"),
+	})
+	if err != nil {
+		s.writeUsecaseError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Service) Introspect(w http.ResponseWriter, r *http.Request) {
+	if !s.parsePostForm(w, r) {
+		return
+	}
+	clientID, clientSecret := clientCredentials(r)
+	resp, err := s.uc.Introspect(r.Context(), clientID, clientSecret, r.PostForm.Get("token"))
+	if err != nil {
+		s.writeUsecaseError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Service) Revoke(w http.ResponseWriter, r *http.Request) {
+	if !s.parsePostForm(w, r) {
+		return
+	}
+	clientID, clientSecret := clientCredentials(r)
+	if err := s.uc.Revoke(r.Context(), clientID, clientSecret, r.PostForm.Get("token")); err != nil {
+		s.writeUsecaseError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Service) UserInfo(w http.ResponseWriter, r *http.Request) {
+	token := bearerToken(r)
+	if token == "" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="usm"`)
+		writeError(w, http.StatusUnauthorized, oauthuc.ErrCodeInvalidToken, "bearer token required")
+		return
+	}
+	claims, err := s.uc.UserInfo(r.Context(), token)
+	if err != nil {
+		if e, ok := err.(*oauthuc.Error); ok {
+			w.Header().Set("WWW-Authenticate", `Bearer error="`+e.Code+`"`)
+		}
+		s.writeUsecaseError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, claims)
+}
+
+func (s *Service) Discovery(w http.ResponseWriter, r *http.Request) {
+	issuer := strings.TrimSuffix(s.uc.Issuer(), "/")
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                issuer,
+		"authorization_endpoint":                issuer + PathAuthorize,
+		"token_endpoint":                        issuer + PathToken,
+		"introspection_endpoint":                issuer + PathIntrospect,
+		"revocation_endpoint":                   issuer + PathRevoke,
+		"userinfo_endpoint":                     issuer + PathUserInfo,
+		"jwks_uri":                              issuer + PathJWKS,
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 oauthuc.SupportedGrantTypes,
+		"scopes_supported":                      oauthuc.SupportedScopes,
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{oauthuc.CodeChallengeMethodS256, oauthuc.CodeChallengeMethodPlain},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"claims_supported":                      []string{"sub", "iss", "aud", "exp", "iat", "nonce", "preferred_username", "email", "updated_at"},
+	})
+}
+
+func (s *Service) JWKS(w http.ResponseWriter, r *http.Request) {
+	keys, err := s.uc.SigningKeys(r.Context())
+	if err != nil {
+		s.writeUsecaseError(w, err)
+		return
+	}
+	var jwks []map[string]string
+	for _, k := range keys {
+		jwks = append(jwks, jwkFromPublicKey(k.KID, k.Algorithm, &k.PrivateKey.PublicKey))
+	}
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	writeJSON(w, http.StatusOK, map[string]interface{}{"keys": jwks})
+}
+
+func jwkFromPublicKey(kid, alg string, pub *rsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kty": "RSA",
+		"use": "sig",
+		"kid": kid,
+		"alg": alg,
+		"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}
+}
+
+func (s *Service) parsePostForm(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return false
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxFormBodyLength)
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, oauthuc.ErrCodeInvalidRequest, "malformed request")
+		return false
+	}
+	return true
+}
+
+// writeUsecaseError 将 usecase 的协议错误按 RFC 6749 5.2 输出，其他错误视为服务端错误
+func (s *Service) writeUsecaseError(w http.ResponseWriter, err error) {
+	e, ok := err.(*oauthuc.Error)
+	if !ok {
+		s.log.Errorf("oauth request failed: %v", err)
+		writeError(w, http.StatusInternalServerError, "server_error", "internal server error")
+		return
+	}
+	status := http.StatusBadRequest
+	switch e.Code {
+	case oauthuc.ErrCodeInvalidClient, oauthuc.ErrCodeInvalidToken:
+		status = http.StatusUnauthorized
+	case oauthuc.ErrCodeAccessDenied:
+		status = http.StatusForbidden
+	}
+	writeError(w, status, e.Code, e.Description)
+}
+
+func redirectError(w http.ResponseWriter, r *http.Request, req *oauthuc.AuthorizeRequest, err error) {
+	q := url.Values{"error": {"server_error"}}
+	if e, ok := err.(*oauthuc.Error); ok {
+		q.Set("error", e.Code)
+		q.Set("error_description", e.Description)
+	}
+	if req.State != "" {
+		q.Set("state", req.State)
+	}
+	http.Redirect(w, r, appendQuery(req.RedirectURI, q), http.StatusFound)
+}
+
+func appendQuery(uri string, q url.Values) string {
+	if strings.Contains(uri, "?") {
+		return uri + "&" + q.Encode()
+	}
+	return uri + "?" + q.Encode()
+}
+
+func clientCredentials(r *http.Request) (string, string) {
+	if id, secret, ok := r.BasicAuth(); ok {
+		id, _ = url.QueryUnescape(id)
+		secret, _ = url.QueryUnescape(secret)
+		return id, secret
+	}
+	return r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+}
+
+func bearerToken(r *http.Request) string {
+	auth := r.Header.Get("Authorization")
+	if len(auth) > 7 && strings.EqualFold(auth[:7], "bearer ") {
+		return strings.TrimSpace(auth[7:])
+	}
+	return ""
+}
+
+func writeError(w http.ResponseWriter, status int, code, desc string) {
+	writeJSON(w, status, map[string]string{
+		"error":             code,
+		"error_description": desc,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if w.Header().Get("Cache-Control") == "" {
+		w.Header().Set("Cache-Control", "no-store")
+	}
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,103 @@
+package oauth
+
+import (
+	"context"
+
+	pb "usm/api/oauth/v1"
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+	acctuc "usm/internal/biz/usecase/account"
+	oauthuc "usm/internal/biz/usecase/oauth"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type Service struct {
+	pb.UnimplementedOAuthServer
+
+	log *log.Helper
+
+	uc     *oauthuc.Usecase
+	acctuc *acctuc.Usecase
+}
+
+func NewService(uc *oauthuc.Usecase, acctuc *acctuc.Usecase, logger log.Logger) *Service {
+	return &Service{
+		uc:     uc,
+		acctuc: acctuc,
+		log:    log.NewHelper(log.With(logger, "module", "oauth")),
+	}
+}
+
+func (s *Service) CreateClient(ctx context.Context, req *pb.CreateClientRequest) (*pb.CreateClientResponse, error) {
+	s.log.WithContext(ctx).Infof("create oauth client %s, grant_types=%v", req.Name, req.GrantTypes)
+	c, secret, err := s.uc.RegisterClient(ctx, &repo.OAuthClient{
+		Name:             req.Name,
+		RedirectURIs:     req.RedirectUris,
+		GrantTypes:       req.GrantTypes,
+		Scopes:           req.Scopes,
+		Public:           req.Public,
+		ServiceAccountID: int(req.ServiceAccountId),
+	})
+	if err != nil {
+		if e, ok := err.(*oauthuc.Error); ok {
+			return nil, pb.ErrorInvalidClientMetadata(e.Description)
+		}
+		if err == biz.ErrServiceAccountOnly {
+			return nil, pb.ErrorInvalidClientMetadata("user %d is not a service account", req.ServiceAccountId)
+		}
+		return nil, err
+	}
+	return &pb.CreateClientResponse{
+		Client:       protoFromBizClient(c),
+		ClientSecret: secret,
+	}, nil
+}
+
+func (s *Service) GetClient(ctx context.Context, req *pb.GetClientRequest) (*pb.Client, error) {
+	s.log.WithContext(ctx).Infof("get oauth client %d", req.Id)
+	c, err := s.uc.GetClient(ctx, int(req.Id))
+	if err != nil {
+		if err == biz.ErrResourceNotFound {
+			return nil, pb.ErrorClientNotFound("client %d not found", req.Id)
+		}
+		return nil, err
+	}
+	return protoFromBizClient(c), nil
+}
+
+func (s *Service) ListClients(ctx context.Context, req *pb.ListClientsRequest) (*pb.ListClientsResponse, error) {
+	s.log.WithContext(ctx).Infof("list oauth clients, offset=%d, limit=%d", req.Offset, req.Limit)
+	cs, err := s.uc.ListClients(ctx, int(req.Offset), int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	var resp pb.ListClientsResponse
+	for _, c := range cs {
+		resp.Clients = append(resp.Clients, protoFromBizClient(c))
+	}
+	return &resp, nil
+}
+
+func (s *Service) DeleteClient(ctx context.Context, req *pb.DeleteClientRequest) (*pb.DeleteClientResponse, error) {
+	s.log.WithContext(ctx).Infof("delete oauth client %d", req.Id)
+	if err := s.uc.DeleteClient(ctx, int(req.Id)); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteClientResponse{}, nil
+}
+
+func protoFromBizClient(c *repo.OAuthClient) *pb.Client {
+	return &pb.Client{
+		Id:               int64(c.ID),
+		ClientId:         c.ClientID,
+		Name:             c.Name,
+		RedirectUris:     c.RedirectURIs,
+		GrantTypes:       c.GrantTypes,
+		Scopes:           c.Scopes,
+		Public:           c.Public,
+		ServiceAccountId: int64(c.ServiceAccountID),
+		CreateTime:       c.CreateTime.Unix(),
+		UpdateTime:       c.UpdateTime.Unix(),
+	}
+}
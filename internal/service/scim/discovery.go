@@ -0,0 +1,136 @@
+package scim
+
+// 服务发现文档，见 RFC 7643 5-7
+
+func serviceProviderConfig(base string) map[string]interface{} {
+	return map[string]interface{}{
+		"schemas":          []string{schemaServiceProviderConfig},
+		"documentationUri": "https://datatracker.ietf.org/doc/html/rfc7644",
+		"patch":            map[string]bool{"supported": true},
+		"bulk":             map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":           map[string]interface{}{"supported": true, "maxResults": maxCount},
+		"changePassword":   map[string]bool{"supported": false},
+		"sort":             map[string]bool{"supported": false},
+		"etag":             map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]interface{}{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "Bearer Token",
+				"description": "Authentication using a static bearer token configured on the server",
+				"primary":     true,
+			},
+		},
+		"meta": map[string]string{
+			"resourceType": "ServiceProviderConfig",
+			"location":     base + "/ServiceProviderConfig",
+		},
+	}
+}
+
+func resourceTypes(base string) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"schemas":     []string{schemaResourceType},
+			"id":          "User",
+			"name":        "User",
+			"endpoint":    "/Users",
+			"description": "User Account",
+			"schema":      schemaUser,
+			"meta": map[string]string{
+				"resourceType": "ResourceType",
+				"location":     base + "/ResourceTypes/User",
+			},
+		},
+		{
+			"schemas":     []string{schemaResourceType},
+			"id":          "Group",
+			"name":        "Group",
+			"endpoint":    "/Groups",
+			"description": "Group",
+			"schema":      schemaGroup,
+			"meta": map[string]string{
+				"resourceType": "ResourceType",
+				"location":     base + "/ResourceTypes/Group",
+			},
+		},
+	}
+}
+
+func schemas(base string) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"schemas":     []string{schemaSchema},
+			"id":          schemaUser,
+			"name":        "User",
+			"description": "User Account",
+			"attributes": []map[string]interface{}{
+				attribute("userName", "string", false, "readWrite", "server", true),
+				attribute("externalId", "string", false, "readWrite", "none", false),
+				attribute("active", "boolean", false, "readWrite", "none", false),
+				attribute("password", "string", false, "writeOnly", "none", false),
+				{
+					"name":          "emails",
+					"type":          "complex",
+					"multiValued":   true,
+					"required":      false,
+					"mutability":    "readWrite",
+					"returned":      "default",
+					"subAttributes": []map[string]interface{}{attribute("value", "string", false, "readWrite", "none", false)},
+				},
+				{
+					"name":          "groups",
+					"type":          "complex",
+					"multiValued":   true,
+					"required":      false,
+					"mutability":    "readOnly",
+					"returned":      "default",
+					"subAttributes": []map[string]interface{}{attribute("value", "string", false, "readOnly", "none", false)},
+				},
+			},
+			"meta": map[string]string{
+				"resourceType": "Schema",
+				"location":     base + "/Schemas/" + schemaUser,
+			},
+		},
+		{
+			"schemas":     []string{schemaSchema},
+			"id":          schemaGroup,
+			"name":        "Group",
+			"description": "Group",
+			"attributes": []map[string]interface{}{
+				attribute("displayName", "string", false, "readWrite", "server", true),
+				attribute("externalId", "string", false, "readWrite", "none", false),
+				{
+					"name":          "members",
+					"type":          "complex",
+					"multiValued":   true,
+					"required":      false,
+					"mutability":    "readWrite",
+					"returned":      "default",
+					"subAttributes": []map[string]interface{}{attribute("value", "string", false, "immutable", "none", false)},
+				},
+			},
+			"meta": map[string]string{
+				"resourceType": "Schema",
+				"location":     base + "/Schemas/" + schemaGroup,
+			},
+		},
+	}
+}
+
+func attribute(name, typ string, multiValued bool, mutability, uniqueness string, required bool) map[string]interface{} {
+	returned := "default"
+	if mutability == "writeOnly" {
+		returned = "never"
+	}
+	return map[string]interface{}{
+		"name":        name,
+		"type":        typ,
+		"multiValued": multiValued,
+		"required":    required,
+		"caseExact":   false,
+		"mutability":  mutability,
+		"returned":    returned,
+		"uniqueness":  uniqueness,
+	}
+}
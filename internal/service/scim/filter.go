@@ -0,0 +1,132 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// comparison 过滤器中的一个比较表达式，例如 userName eq "liubo"
+type comparison struct {
+	// Attr 为小写并去掉 schema URN 前缀后的属性路径
+	Attr  string
+	Op    string
+	Value interface{}
+}
+
+// parseFilter 解析 SCIM 过滤器（RFC 7644 3.4.2.2），目前只支持使用 and 连接的 eq 比较，
+// 这也是各 IdP 在同步时实际使用的形式
+func parseFilter(filter string) ([]comparison, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	var res []comparison
+	for i := 0; i < len(tokens); {
+		if len(tokens)-i < 3 {
+			return nil, fmt.Errorf("incomplete expression in filter %q", filter)
+		}
+		attr, op, value := tokens[i], strings.ToLower(tokens[i+1].text), tokens[i+2]
+		if attr.quoted || tokens[i+1].quoted || strings.ContainsAny(attr.text, "[]()") {
+			return nil, fmt.Errorf("unsupported filter %q", filter)
+		}
+		if op != "eq" {
+			return nil, fmt.Errorf("unsupported operator %q", op)
+		}
+		v, err := value.value()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, comparison{
+			Attr:  normalizeAttr(attr.text),
+			Op:    op,
+			Value: v,
+		})
+		i += 3
+		if i == len(tokens) {
+			break
+		}
+		if strings.ToLower(tokens[i].text) != "and" || tokens[i].quoted {
+			return nil, fmt.Errorf("unsupported logical operator %q", tokens[i].text)
+		}
+		i++
+		if i == len(tokens) {
+			return nil, fmt.Errorf("incomplete expression in filter %q", filter)
+		}
+	}
+	return res, nil
+}
+
+type filterToken struct {
+	text   string
+	quoted bool
+}
+
+func (t filterToken) value() (interface{}, error) {
+	if t.quoted {
+		return t.text, nil
+	}
+	switch strings.ToLower(t.text) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	var n json.Number
+	if err := json.Unmarshal([]byte(t.text), &n); err != nil {
+		return nil, fmt.Errorf("invalid value %q", t.text)
+	}
+	return n, nil
+}
+
+func tokenizeFilter(s string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"':
+			// 字符串使用 JSON 转义规则
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string in filter %q", s)
+			}
+			var v string
+			if err := json.Unmarshal([]byte(s[i:j+1]), &v); err != nil {
+				return nil, fmt.Errorf("invalid string in filter %q", s)
+			}
+			tokens = append(tokens, filterToken{text: v, quoted: true})
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(s) && !unicode.IsSpace(rune(s[j])) && s[j] != '"'; j++ {
+			}
+			tokens = append(tokens, filterToken{text: s[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+// normalizeAttr 属性名大小写不敏感，并可以带有 schema URN 前缀
+func normalizeAttr(attr string) string {
+	attr = strings.ToLower(attr)
+	for _, schema := range []string{schemaUser, schemaGroup} {
+		if p := strings.ToLower(schema) + ":"; strings.HasPrefix(attr, p) {
+			return strings.TrimPrefix(attr, p)
+		}
+	}
+	return attr
+}
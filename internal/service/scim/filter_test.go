@@ -0,0 +1,90 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"usm/internal/biz/repo"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		want    []comparison
+		wantErr bool
+	}{
+		{name: "empty", filter: " "},
+		{
+			name:   "eq",
+			filter: `userName eq "bjensen"`,
+			want:   []comparison{{Attr: "username", Op: "eq", Value: "bjensen"}},
+		},
+		{
+			name:   "schema prefix and case insensitive",
+			filter: `urn:ietf:params:scim:schemas:core:2.0:User:userName EQ "a b"`,
+			want:   []comparison{{Attr: "username", Op: "eq", Value: "a b"}},
+		},
+		{
+			name:   "and",
+			filter: `externalId eq "x\"y" and active eq true`,
+			want: []comparison{
+				{Attr: "externalid", Op: "eq", Value: `x"y`},
+				{Attr: "active", Op: "eq", Value: true},
+			},
+		},
+		{name: "number", filter: `id eq 12`, want: []comparison{{Attr: "id", Op: "eq", Value: json.Number("12")}}},
+		{name: "unsupported operator", filter: `userName co "b"`, wantErr: true},
+		{name: "or", filter: `userName eq "a" or userName eq "b"`, wantErr: true},
+		{name: "incomplete", filter: `userName eq`, wantErr: true},
+		{name: "trailing and", filter: `userName eq "a" and`, wantErr: true},
+		{name: "unterminated", filter: `userName eq "a`, wantErr: true},
+		{name: "value path", filter: `emails[type eq "work"] eq "a"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFilter(tt.filter)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_groupPatch(t *testing.T) {
+	ops := []patchOperation{
+		{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"3"},{"value":"1"}]`)},
+		{Op: "remove", Path: `members[value eq "1"]`},
+		{Op: "replace", Value: json.RawMessage(`{"displayName":"ops","externalId":"ext"}`)},
+	}
+	p := &groupPatch{group: &repo.Group{ID: 1, DisplayName: "dev"}, members: []int{1, 2}}
+	for _, op := range ops {
+		assert.NoError(t, p.apply(op))
+	}
+	assert.Equal(t, []int{2, 3}, p.members)
+	assert.Equal(t, "ops", p.group.DisplayName)
+	assert.Equal(t, "ext", p.group.ExternalID)
+
+	assert.NoError(t, p.apply(patchOperation{Op: "remove", Path: "members"}))
+	assert.Empty(t, p.members)
+	assert.Error(t, p.apply(patchOperation{Op: "remove", Path: "displayName"}))
+}
+
+func Test_applyUserPatch(t *testing.T) {
+	u := &user{ExternalID: "ext", UserName: "alice", Emails: []multiValue{{Value: "alice@example.com", Primary: true}}}
+	assert.NoError(t, applyUserPatch(u, patchOperation{Op: "remove", Path: "externalId"}))
+	assert.Empty(t, u.ExternalID)
+	got := u.toBiz(&repo.User{ID: 1})
+	assert.Equal(t, "", got.ExternalID)
+	assert.Equal(t, "alice@example.com", got.Email)
+
+	assert.NoError(t, applyUserPatch(u, patchOperation{Op: "remove", Path: "emails"}))
+	assert.Empty(t, u.primaryEmail())
+	assert.Error(t, applyUserPatch(u, patchOperation{Op: "remove", Path: "userName"}))
+	assert.Error(t, applyUserPatch(u, patchOperation{Op: "remove"}))
+}
@@ -0,0 +1,361 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"usm/internal/biz/repo"
+)
+
+type group struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []memberRef `json:"members,omitempty"`
+	Meta        *meta       `json:"meta,omitempty"`
+}
+
+func (g *group) toBiz(id int) *repo.Group {
+	return &repo.Group{
+		ID:          id,
+		DisplayName: g.DisplayName,
+		ExternalID:  g.ExternalID,
+	}
+}
+
+// memberIDs 解析成员 ID，成员只能是用户
+func (g *group) memberIDs() ([]int, error) {
+	return parseMemberIDs(g.Members)
+}
+
+func parseMemberIDs(members []memberRef) ([]int, error) {
+	ids := make([]int, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.Atoi(m.Value)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid member %q", m.Value)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (s *Service) groupFromBiz(ctx context.Context, g *repo.Group, base string, withMembers bool) (*group, error) {
+	res := &group{
+		Schemas:     []string{schemaGroup},
+		ID:          strconv.Itoa(g.ID),
+		ExternalID:  g.ExternalID,
+		DisplayName: g.DisplayName,
+		Meta:        newMeta("Group", fmt.Sprintf("%s/Groups/%d", base, g.ID), g.CreateTime, g.UpdateTime),
+	}
+	if !withMembers {
+		return res, nil
+	}
+	members, err := s.groupuc.ListMembers(ctx, g.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		res.Members = append(res.Members, memberRef{
+			Value:   strconv.Itoa(m.ID),
+			Display: m.Username,
+			Ref:     fmt.Sprintf("%s/Users/%d", base, m.ID),
+		})
+	}
+	return res, nil
+}
+
+// withMembers 大用户组的成员列表可能很大，IdP 通常使用 excludedAttributes=members 查询
+func withMembers(r *http.Request) bool {
+	for _, attr := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if normalizeAttr(strings.TrimSpace(attr)) == "members" {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Service) listGroups(w http.ResponseWriter, r *http.Request) {
+	filter := &repo.GroupFilter{}
+	cmps, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidFilter, err.Error())
+		return
+	}
+	for _, c := range cmps {
+		v, ok := c.Value.(string)
+		if !ok {
+			writeError(w, http.StatusBadRequest, scimTypeInvalidFilter, "attribute "+c.Attr+" must be compared with a string")
+			return
+		}
+		switch c.Attr {
+		case "displayname":
+			filter.DisplayName = v
+		case "externalid":
+			filter.ExternalID = v
+		default:
+			writeError(w, http.StatusBadRequest, scimTypeInvalidFilter, "filtering on attribute "+c.Attr+" is not supported")
+			return
+		}
+	}
+	startIndex, count := pagination(r)
+	total, err := s.groupuc.CountGroups(r.Context(), filter)
+	if err != nil {
+		s.writeUsecaseError(w, err, "group")
+		return
+	}
+	resources := []*group{}
+	if count > 0 {
+		gs, err := s.groupuc.ListGroups(r.Context(), filter, startIndex-1, count)
+		if err != nil {
+			s.writeUsecaseError(w, err, "group")
+			return
+		}
+		base, members := baseURL(r), withMembers(r)
+		for _, g := range gs {
+			sg, err := s.groupFromBiz(r.Context(), g, base, members)
+			if err != nil {
+				s.writeUsecaseError(w, err, "group")
+				return
+			}
+			resources = append(resources, sg)
+		}
+	}
+	writeJSON(w, http.StatusOK, &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (s *Service) createGroup(w http.ResponseWriter, r *http.Request) {
+	var req group
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidSyntax, err.Error())
+		return
+	}
+	if req.DisplayName == "" {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidValue, "displayName is required")
+		return
+	}
+	memberIDs, err := req.memberIDs()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidValue, err.Error())
+		return
+	}
+	s.log.Infof("scim create group %s, externalId=%s", req.DisplayName, req.ExternalID)
+	g, err := s.groupuc.CreateGroup(r.Context(), req.toBiz(0), memberIDs)
+	if err != nil {
+		s.writeUsecaseError(w, err, "group or member")
+		return
+	}
+	s.writeGroup(w, r, g, http.StatusCreated)
+}
+
+func (s *Service) getGroup(w http.ResponseWriter, r *http.Request, id int) {
+	g, err := s.groupuc.GetGroup(r.Context(), id)
+	if err != nil {
+		s.writeUsecaseError(w, err, "group")
+		return
+	}
+	s.writeGroup(w, r, g, http.StatusOK)
+}
+
+func (s *Service) replaceGroup(w http.ResponseWriter, r *http.Request, id int) {
+	var req group
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidSyntax, err.Error())
+		return
+	}
+	memberIDs, err := req.memberIDs()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidValue, err.Error())
+		return
+	}
+	s.saveGroup(w, r, req.toBiz(id), memberIDs)
+}
+
+func (s *Service) patchGroup(w http.ResponseWriter, r *http.Request, id int) {
+	req, err := decodePatch(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidSyntax, err.Error())
+		return
+	}
+	cur, err := s.groupuc.GetGroup(r.Context(), id)
+	if err != nil {
+		s.writeUsecaseError(w, err, "group")
+		return
+	}
+	members, err := s.groupuc.ListMembers(r.Context(), id)
+	if err != nil {
+		s.writeUsecaseError(w, err, "group")
+		return
+	}
+	p := &groupPatch{group: cur, members: make([]int, 0, len(members))}
+	for _, m := range members {
+		p.members = append(p.members, m.ID)
+	}
+	for _, op := range req.Operations {
+		if err := p.apply(op); err != nil {
+			writeError(w, http.StatusBadRequest, scimTypeInvalidPath, err.Error())
+			return
+		}
+	}
+	s.saveGroup(w, r, p.group, p.members)
+}
+
+func (s *Service) deleteGroup(w http.ResponseWriter, r *http.Request, id int) {
+	s.log.Infof("scim delete group %d", id)
+	if err := s.groupuc.DeleteGroup(r.Context(), id); err != nil {
+		s.writeUsecaseError(w, err, "group")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Service) saveGroup(w http.ResponseWriter, r *http.Request, g *repo.Group, memberIDs []int) {
+	if g.DisplayName == "" {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidValue, "displayName is required")
+		return
+	}
+	s.log.Infof("scim replace group %d, displayName=%s, members=%d", g.ID, g.DisplayName, len(memberIDs))
+	updated, err := s.groupuc.ReplaceGroup(r.Context(), g, memberIDs)
+	if err != nil {
+		s.writeUsecaseError(w, err, "group or member")
+		return
+	}
+	s.writeGroup(w, r, updated, http.StatusOK)
+}
+
+func (s *Service) writeGroup(w http.ResponseWriter, r *http.Request, g *repo.Group, status int) {
+	sg, err := s.groupFromBiz(r.Context(), g, baseURL(r), withMembers(r))
+	if err != nil {
+		s.writeUsecaseError(w, err, "group")
+		return
+	}
+	w.Header().Set("Location", sg.Meta.Location)
+	writeJSON(w, status, sg)
+}
+
+// groupPatch 在内存中依次应用 PATCH 操作，最后整体替换
+type groupPatch struct {
+	group   *repo.Group
+	members []int
+}
+
+func (p *groupPatch) apply(op patchOperation) error {
+	path := normalizeAttr(op.Path)
+	if path == "" {
+		if op.Op == "remove" {
+			return fmt.Errorf("remove operation requires a path")
+		}
+		var attrs map[string]json.RawMessage
+		if err := json.Unmarshal(op.Value, &attrs); err != nil {
+			return fmt.Errorf("value of a patch operation without path must be an object")
+		}
+		for k, v := range attrs {
+			if err := p.set(op.Op, normalizeAttr(k), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if op.Op == "remove" {
+		return p.remove(path, op.Value)
+	}
+	return p.set(op.Op, path, op.Value)
+}
+
+func (p *groupPatch) set(op, attr string, raw json.RawMessage) error {
+	switch attr {
+	case "displayname":
+		return json.Unmarshal(raw, &p.group.DisplayName)
+	case "externalid":
+		return json.Unmarshal(raw, &p.group.ExternalID)
+	case "members":
+		var members []memberRef
+		if err := json.Unmarshal(raw, &members); err != nil {
+			return err
+		}
+		ids, err := parseMemberIDs(members)
+		if err != nil {
+			return err
+		}
+		if op == "replace" {
+			p.members = nil
+		}
+		for _, id := range ids {
+			if !containsInt(p.members, id) {
+				p.members = append(p.members, id)
+			}
+		}
+	}
+	return nil
+}
+
+// remove 支持 members、members[value eq "1"] 以及在 value 中给出要删除的成员
+func (p *groupPatch) remove(path string, raw json.RawMessage) error {
+	switch {
+	case path == "externalid":
+		p.group.ExternalID = ""
+	case path == "displayname":
+		return fmt.Errorf("attribute displayName can not be removed")
+	case path == "members":
+		if len(raw) == 0 || string(raw) == "null" {
+			p.members = nil
+			return nil
+		}
+		var members []memberRef
+		if err := json.Unmarshal(raw, &members); err != nil {
+			return err
+		}
+		ids, err := parseMemberIDs(members)
+		if err != nil {
+			return err
+		}
+		p.removeMembers(ids)
+	case strings.HasPrefix(path, "members[") && strings.HasSuffix(path, "]"):
+		cmps, err := parseFilter(path[len("members[") : len(path)-1])
+		if err != nil {
+			return err
+		}
+		if len(cmps) != 1 || cmps[0].Attr != "value" {
+			return fmt.Errorf("unsupported path %q", path)
+		}
+		v, _ := cmps[0].Value.(string)
+		ids, err := parseMemberIDs([]memberRef{{Value: v}})
+		if err != nil {
+			return err
+		}
+		p.removeMembers(ids)
+	default:
+		return fmt.Errorf("unsupported path %q", path)
+	}
+	return nil
+}
+
+func (p *groupPatch) removeMembers(ids []int) {
+	members := p.members[:0]
+	for _, id := range p.members {
+		if !containsInt(ids, id) {
+			members = append(members, id)
+		}
+	}
+	p.members = members
+}
+
+func containsInt(s []int, v int) bool {
+	for _, i := range s {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,316 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"usm/internal/biz"
+	acctuc "usm/internal/biz/usecase/account"
+	groupuc "usm/internal/biz/usecase/group"
+	"usm/internal/conf"
+	"usm/pkg/secret"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SCIM 2.0 接口，见 RFC 7643、RFC 7644，资源格式由规范约定，不走 protobuf
+const (
+	PathPrefix = "/scim/v2/"
+
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	schemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+
+	contentType       = "application/scim+json"
+	defaultCount      = 100
+	maxCount          = 200
+	maxRequestBodyLen = 1 << 20
+)
+
+// SCIM 错误类型，见 RFC 7644 3.12
+const (
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeInvalidSyntax = "invalidSyntax"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeInvalidValue  = "invalidValue"
+	scimTypeUniqueness    = "uniqueness"
+	scimTypeMutability    = "mutability"
+)
+
+type Service struct {
+	log *log.Helper
+
+	tokens  *secret.Secret
+	acctuc  *acctuc.Usecase
+	groupuc *groupuc.Usecase
+}
+
+func NewService(c *conf.Auth, acctuc *acctuc.Usecase, groupuc *groupuc.Usecase, logger log.Logger) (*Service, func(), error) {
+	logger = log.With(logger, "module", "scim")
+	ref := c.GetScim().GetBearerTokensRef()
+	tokens, err := secret.New(ref.GetFile(), ref.GetEnv(), strings.Join(c.GetScim().GetBearerTokens(), "\n"), logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("scim bearer tokens: %v", err)
+	}
+	return &Service{
+		log:     log.NewHelper(logger),
+		tokens:  tokens,
+		acctuc:  acctuc,
+		groupuc: groupuc,
+	}, func() { tokens.Close() }, nil
+}
+
+// bearerTokens 每次请求时读取，密钥文件更新后立即生效
+func (s *Service) bearerTokens() []string {
+	return strings.Fields(s.tokens.Value())
+}
+
+func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tokens := s.bearerTokens()
+	if len(tokens) == 0 {
+		writeError(w, http.StatusNotFound, "", "SCIM provisioning is disabled")
+		return
+	}
+	if !secret.BearerAuthorized(r, tokens) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+		writeError(w, http.StatusUnauthorized, "", "invalid bearer token")
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyLen)
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, PathPrefix), "/"), "/")
+	resource, id := parts[0], ""
+	if len(parts) == 2 {
+		id = parts[1]
+	} else if len(parts) > 2 {
+		writeError(w, http.StatusNotFound, "", "resource not found")
+		return
+	}
+	switch resource {
+	case "Users":
+		s.serveResource(w, r, id, s.listUsers, s.createUser, s.getUser, s.replaceUser, s.patchUser, s.deleteUser)
+	case "Groups":
+		s.serveResource(w, r, id, s.listGroups, s.createGroup, s.getGroup, s.replaceGroup, s.patchGroup, s.deleteGroup)
+	case "ServiceProviderConfig":
+		s.serveStatic(w, r, id == "", serviceProviderConfig(baseURL(r)))
+	case "ResourceTypes":
+		s.serveDiscovery(w, r, id, resourceTypes(baseURL(r)))
+	case "Schemas":
+		s.serveDiscovery(w, r, id, schemas(baseURL(r)))
+	default:
+		writeError(w, http.StatusNotFound, "", "resource not found")
+	}
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, id int)
+
+func (s *Service) serveResource(w http.ResponseWriter, r *http.Request, rawID string,
+	list, create func(w http.ResponseWriter, r *http.Request), get, replace, patch, del handlerFunc) {
+	if rawID == "" {
+		switch r.Method {
+		case http.MethodGet:
+			list(w, r)
+		case http.MethodPost:
+			create(w, r)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "", "method not allowed")
+		}
+		return
+	}
+	id, err := strconv.Atoi(rawID)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusNotFound, "", fmt.Sprintf("resource %s not found", rawID))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		get(w, r, id)
+	case http.MethodPut:
+		replace(w, r, id)
+	case http.MethodPatch:
+		patch(w, r, id)
+	case http.MethodDelete:
+		del(w, r, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "", "method not allowed")
+	}
+}
+
+func (s *Service) serveStatic(w http.ResponseWriter, r *http.Request, found bool, v interface{}) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "", "method not allowed")
+		return
+	}
+	if !found {
+		writeError(w, http.StatusNotFound, "", "resource not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, v)
+}
+
+func (s *Service) serveDiscovery(w http.ResponseWriter, r *http.Request, id string, resources []map[string]interface{}) {
+	if id == "" {
+		s.serveStatic(w, r, true, &listResponse{
+			Schemas:      []string{schemaListResponse},
+			TotalResults: len(resources),
+			StartIndex:   1,
+			ItemsPerPage: len(resources),
+			Resources:    resources,
+		})
+		return
+	}
+	for _, res := range resources {
+		if res["id"] == id {
+			s.serveStatic(w, r, true, res)
+			return
+		}
+	}
+	s.serveStatic(w, r, false, nil)
+}
+
+// writeUsecaseError 将业务错误转换为 SCIM 错误
+func (s *Service) writeUsecaseError(w http.ResponseWriter, err error, resource string) {
+	switch err {
+	case biz.ErrResourceNotFound:
+		writeError(w, http.StatusNotFound, "", resource+" not found")
+	case biz.ErrResourceAlreadyExists:
+		writeError(w, http.StatusConflict, scimTypeUniqueness, resource+" already exists")
+	case biz.ErrEmailAlreadyExists:
+		writeError(w, http.StatusConflict, scimTypeUniqueness, err.Error())
+	case biz.ErrExternalUser, biz.ErrHumanOnly:
+		writeError(w, http.StatusBadRequest, scimTypeMutability, err.Error())
+	case biz.ErrWeakPassword, biz.ErrInvalidUsername:
+		writeError(w, http.StatusBadRequest, scimTypeInvalidValue, err.Error())
+	default:
+		s.log.Errorf("scim request failed: %v", err)
+		writeError(w, http.StatusInternalServerError, "", "internal server error")
+	}
+}
+
+type listResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created"`
+	LastModified string `json:"lastModified"`
+	Location     string `json:"location"`
+}
+
+func newMeta(resourceType, location string, created, modified time.Time) *meta {
+	return &meta{
+		ResourceType: resourceType,
+		Created:      created.UTC().Format(time.RFC3339),
+		LastModified: modified.UTC().Format(time.RFC3339),
+		Location:     location,
+	}
+}
+
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+func decodePatch(r *http.Request) (*patchRequest, error) {
+	var req patchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	if !contains(req.Schemas, schemaPatchOp) {
+		return nil, fmt.Errorf("schemas must contain %s", schemaPatchOp)
+	}
+	for i, op := range req.Operations {
+		req.Operations[i].Op = strings.ToLower(op.Op)
+		switch req.Operations[i].Op {
+		case "add", "remove", "replace":
+		default:
+			return nil, fmt.Errorf("invalid patch operation %q", op.Op)
+		}
+	}
+	return &req, nil
+}
+
+// pagination 解析 startIndex 和 count，返回从 0 开始的 offset
+func pagination(r *http.Request) (startIndex, count int) {
+	startIndex, count = 1, defaultCount
+	if v, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && v > 1 {
+		startIndex = v
+	}
+	if v, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil {
+		count = v
+	}
+	if count < 0 {
+		count = 0
+	}
+	if count > maxCount {
+		count = maxCount
+	}
+	return startIndex, count
+}
+
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if p := r.Header.Get("X-Forwarded-Proto"); p == "http" || p == "https" {
+		scheme = p
+	}
+	return scheme + "://" + r.Host + strings.TrimSuffix(PathPrefix, "/")
+}
+
+func writeError(w http.ResponseWriter, status int, scimType, detail string) {
+	writeJSON(w, status, map[string]interface{}{
+		"schemas":  []string{schemaError},
+		"status":   strconv.Itoa(status),
+		"scimType": scimType,
+		"detail":   detail,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// parseBool 兼容部分 IdP 在 PATCH 中以字符串传递布尔值，例如 "False"
+func parseBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return false, fmt.Errorf("invalid boolean %s", raw)
+	}
+	return strconv.ParseBool(strings.ToLower(s))
+}
@@ -0,0 +1,335 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"usm/internal/biz"
+	"usm/internal/biz/repo"
+)
+
+type user struct {
+	Schemas    []string     `json:"schemas"`
+	ID         string       `json:"id,omitempty"`
+	ExternalID string       `json:"externalId,omitempty"`
+	UserName   string       `json:"userName"`
+	Active     *bool        `json:"active,omitempty"`
+	Password   string       `json:"password,omitempty"`
+	Emails     []multiValue `json:"emails,omitempty"`
+	Groups     []memberRef  `json:"groups,omitempty"`
+	Meta       *meta        `json:"meta,omitempty"`
+}
+
+type multiValue struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type memberRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// primaryEmail 返回 primary 邮箱，没有时返回第一个
+func (u *user) primaryEmail() string {
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// toBiz 转换为 repo.User，active 未指定时保持 cur 的状态
+func (u *user) toBiz(cur *repo.User) *repo.User {
+	m := &repo.User{
+		Username:   u.UserName,
+		Email:      u.primaryEmail(),
+		Password:   u.Password,
+		ExternalID: u.ExternalID,
+		Kind:       repo.UserKindHuman,
+	}
+	if cur != nil {
+		m.ID = cur.ID
+		m.Disabled = cur.Disabled
+	}
+	if u.Active != nil {
+		m.Disabled = !*u.Active
+	}
+	return m
+}
+
+func (s *Service) userFromBiz(ctx context.Context, u *repo.User, base string) (*user, error) {
+	active := !u.Disabled
+	res := &user{
+		Schemas:    []string{schemaUser},
+		ID:         strconv.Itoa(u.ID),
+		ExternalID: u.ExternalID,
+		UserName:   u.Username,
+		Active:     &active,
+		Meta:       newMeta("User", fmt.Sprintf("%s/Users/%d", base, u.ID), u.CreateTime, u.UpdateTime),
+	}
+	if u.Email != "" {
+		res.Emails = []multiValue{{Value: u.Email, Type: "work", Primary: true}}
+	}
+	groups, err := s.groupuc.ListUserGroups(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range groups {
+		res.Groups = append(res.Groups, memberRef{
+			Value:   strconv.Itoa(g.ID),
+			Display: g.DisplayName,
+			Ref:     fmt.Sprintf("%s/Groups/%d", base, g.ID),
+		})
+	}
+	return res, nil
+}
+
+func (s *Service) listUsers(w http.ResponseWriter, r *http.Request) {
+	filter := &repo.UserFilter{Kind: repo.UserKindHuman}
+	cmps, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidFilter, err.Error())
+		return
+	}
+	for _, c := range cmps {
+		v, ok := c.Value.(string)
+		if !ok {
+			writeError(w, http.StatusBadRequest, scimTypeInvalidFilter, "attribute "+c.Attr+" must be compared with a string")
+			return
+		}
+		switch c.Attr {
+		case "username":
+			filter.Username = v
+		case "externalid":
+			filter.ExternalID = v
+		case "emails", "emails.value":
+			filter.Email = v
+		default:
+			writeError(w, http.StatusBadRequest, scimTypeInvalidFilter, "filtering on attribute "+c.Attr+" is not supported")
+			return
+		}
+	}
+	startIndex, count := pagination(r)
+	total, err := s.acctuc.CountUsers(r.Context(), filter)
+	if err != nil {
+		s.writeUsecaseError(w, err, "user")
+		return
+	}
+	resources := []*user{}
+	if count > 0 {
+		us, err := s.acctuc.ListUsers(r.Context(), filter, startIndex-1, count)
+		if err != nil {
+			s.writeUsecaseError(w, err, "user")
+			return
+		}
+		base := baseURL(r)
+		for _, u := range us {
+			su, err := s.userFromBiz(r.Context(), u, base)
+			if err != nil {
+				s.writeUsecaseError(w, err, "user")
+				return
+			}
+			resources = append(resources, su)
+		}
+	}
+	writeJSON(w, http.StatusOK, &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (s *Service) createUser(w http.ResponseWriter, r *http.Request) {
+	var req user
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidSyntax, err.Error())
+		return
+	}
+	if req.UserName == "" {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidValue, "userName is required")
+		return
+	}
+	s.log.Infof("scim create user %s, externalId=%s", req.UserName, req.ExternalID)
+	u, err := s.acctuc.CreateUser(r.Context(), req.toBiz(nil))
+	if err != nil {
+		s.writeUsecaseError(w, err, "user")
+		return
+	}
+	s.writeUser(w, r, u, http.StatusCreated)
+}
+
+func (s *Service) getUser(w http.ResponseWriter, r *http.Request, id int) {
+	u, err := s.humanUser(r.Context(), id)
+	if err != nil {
+		s.writeUsecaseError(w, err, "user")
+		return
+	}
+	s.writeUser(w, r, u, http.StatusOK)
+}
+
+func (s *Service) replaceUser(w http.ResponseWriter, r *http.Request, id int) {
+	var req user
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidSyntax, err.Error())
+		return
+	}
+	cur, err := s.humanUser(r.Context(), id)
+	if err != nil {
+		s.writeUsecaseError(w, err, "user")
+		return
+	}
+	s.saveUser(w, r, cur, &req)
+}
+
+func (s *Service) patchUser(w http.ResponseWriter, r *http.Request, id int) {
+	req, err := decodePatch(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidSyntax, err.Error())
+		return
+	}
+	cur, err := s.humanUser(r.Context(), id)
+	if err != nil {
+		s.writeUsecaseError(w, err, "user")
+		return
+	}
+	active := !cur.Disabled
+	u := &user{
+		ExternalID: cur.ExternalID,
+		UserName:   cur.Username,
+		Active:     &active,
+	}
+	if cur.Email != "" {
+		u.Emails = []multiValue{{Value: cur.Email, Primary: true}}
+	}
+	for _, op := range req.Operations {
+		if err := applyUserPatch(u, op); err != nil {
+			writeError(w, http.StatusBadRequest, scimTypeInvalidPath, err.Error())
+			return
+		}
+	}
+	s.saveUser(w, r, cur, u)
+}
+
+func (s *Service) deleteUser(w http.ResponseWriter, r *http.Request, id int) {
+	if _, err := s.humanUser(r.Context(), id); err != nil {
+		s.writeUsecaseError(w, err, "user")
+		return
+	}
+	s.log.Infof("scim delete user %d", id)
+	if err := s.acctuc.DeleteUser(r.Context(), id); err != nil {
+		s.writeUsecaseError(w, err, "user")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Service) saveUser(w http.ResponseWriter, r *http.Request, cur *repo.User, u *user) {
+	if u.UserName == "" {
+		writeError(w, http.StatusBadRequest, scimTypeInvalidValue, "userName is required")
+		return
+	}
+	s.log.Infof("scim replace user %d, userName=%s", cur.ID, u.UserName)
+	updated, err := s.acctuc.ReplaceUser(r.Context(), u.toBiz(cur))
+	if err != nil {
+		s.writeUsecaseError(w, err, "user")
+		return
+	}
+	s.writeUser(w, r, updated, http.StatusOK)
+}
+
+func (s *Service) writeUser(w http.ResponseWriter, r *http.Request, u *repo.User, status int) {
+	su, err := s.userFromBiz(r.Context(), u, baseURL(r))
+	if err != nil {
+		s.writeUsecaseError(w, err, "user")
+		return
+	}
+	w.Header().Set("Location", su.Meta.Location)
+	writeJSON(w, status, su)
+}
+
+// humanUser 服务账号不通过 SCIM 管理，对 SCIM 客户端不可见
+func (s *Service) humanUser(ctx context.Context, id int) (*repo.User, error) {
+	u, err := s.acctuc.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u.IsServiceAccount() {
+		return nil, biz.ErrResourceNotFound
+	}
+	return u, nil
+}
+
+func applyUserPatch(u *user, op patchOperation) error {
+	path := normalizeAttr(op.Path)
+	if path == "" {
+		if op.Op == "remove" {
+			return fmt.Errorf("remove operation requires a path")
+		}
+		var attrs map[string]json.RawMessage
+		if err := json.Unmarshal(op.Value, &attrs); err != nil {
+			return fmt.Errorf("value of a patch operation without path must be an object")
+		}
+		for k, v := range attrs {
+			if err := setUserAttr(u, normalizeAttr(k), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if op.Op == "remove" {
+		switch {
+		case path == "externalid":
+			u.ExternalID = ""
+		case path == "emails" || strings.HasPrefix(path, "emails[") || path == "emails.value":
+			u.Emails = nil
+		case path == "username" || path == "active":
+			return fmt.Errorf("attribute %s can not be removed", op.Path)
+		}
+		return nil
+	}
+	return setUserAttr(u, path, op.Value)
+}
+
+// setUserAttr 设置单个属性，不支持的属性（例如 name、title）被忽略
+func setUserAttr(u *user, attr string, raw json.RawMessage) error {
+	switch {
+	case attr == "username":
+		return json.Unmarshal(raw, &u.UserName)
+	case attr == "externalid":
+		return json.Unmarshal(raw, &u.ExternalID)
+	case attr == "password":
+		return json.Unmarshal(raw, &u.Password)
+	case attr == "active":
+		b, err := parseBool(raw)
+		if err != nil {
+			return err
+		}
+		u.Active = &b
+	case attr == "emails":
+		var emails []multiValue
+		if err := json.Unmarshal(raw, &emails); err != nil {
+			return err
+		}
+		u.Emails = emails
+	case strings.HasPrefix(attr, "emails[") || attr == "emails.value":
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return err
+		}
+		u.Emails = []multiValue{{Value: v, Type: "work", Primary: true}}
+	}
+	return nil
+}
@@ -2,8 +2,10 @@ package service
 
 import (
 	"usm/internal/service/account"
+	"usm/internal/service/oauth"
+	"usm/internal/service/scim"
 
 	"github.com/google/wire"
 )
 
-var ProviderSet = wire.NewSet(account.NewService)
+var ProviderSet = wire.NewSet(account.NewService, oauth.NewService, scim.NewService)
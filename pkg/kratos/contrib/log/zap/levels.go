@@ -0,0 +1,137 @@
+package zap
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// ModuleKey 日志中模块名的 key，例如 log.With(logger, zap.ModuleKey, "data")，可以为模块单独设置级别
+const ModuleKey = "module"
+
+// Levels 日志级别，可以在运行时修改。没有单独设置级别的模块使用默认级别
+type Levels struct {
+	level   zap.AtomicLevel
+	mu      sync.RWMutex
+	modules map[string]zap.AtomicLevel
+}
+
+func newLevels(level zapcore.Level) *Levels {
+	return &Levels{
+		level:   zap.NewAtomicLevelAt(level),
+		modules: make(map[string]zap.AtomicLevel),
+	}
+}
+
+// Enabled 判断模块是否输出 level 级别的日志，module 为空时使用默认级别
+func (ls *Levels) Enabled(module string, level zapcore.Level) bool {
+	if module != "" {
+		ls.mu.RLock()
+		l, ok := ls.modules[module]
+		ls.mu.RUnlock()
+		if ok {
+			return l.Enabled(level)
+		}
+	}
+	return ls.level.Enabled(level)
+}
+
+// SetLevel 设置默认级别：debug、info、warn、error
+func (ls *Levels) SetLevel(level string) error {
+	l, err := parseLevel(level)
+	if err != nil {
+		return err
+	}
+	ls.level.SetLevel(l)
+	return nil
+}
+
+// SetModuleLevels 替换所有模块的级别，不在 modules 中的模块恢复使用默认级别
+func (ls *Levels) SetModuleLevels(modules map[string]string) error {
+	res := make(map[string]zap.AtomicLevel, len(modules))
+	for m, level := range modules {
+		l, err := parseLevel(level)
+		if err != nil {
+			return fmt.Errorf("module %s: %w", m, err)
+		}
+		res[m] = zap.NewAtomicLevelAt(l)
+	}
+	ls.mu.Lock()
+	ls.modules = res
+	ls.mu.Unlock()
+	return nil
+}
+
+// Get 返回默认级别和各模块的级别
+func (ls *Levels) Get() (string, map[string]string) {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	modules := make(map[string]string, len(ls.modules))
+	for m, l := range ls.modules {
+		modules[m] = l.String()
+	}
+	return ls.level.String(), modules
+}
+
+type levelsPayload struct {
+	Level   string            `json:"level,omitempty"`
+	Modules map[string]string `json:"modules"`
+}
+
+// ServeHTTP GET 返回当前级别；PUT 修改级别，body 为 {"level":"info","modules":{"data":"debug"}}，
+// level 为空时不修改默认级别，没有 modules 时不修改模块级别
+func (ls *Levels) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var req struct {
+			Level   string            `json:"level"`
+			Modules map[string]string `json:"modules"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeLevelsError(w, fmt.Sprintf("invalid request body: %v", err))
+			return
+		}
+		// 先校验再修改，避免只修改了一部分
+		if req.Level != "" {
+			if _, err := parseLevel(req.Level); err != nil {
+				writeLevelsError(w, err.Error())
+				return
+			}
+		}
+		if req.Modules != nil {
+			if err := ls.SetModuleLevels(req.Modules); err != nil {
+				writeLevelsError(w, err.Error())
+				return
+			}
+		}
+		if req.Level != "" {
+			_ = ls.SetLevel(req.Level)
+		}
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	level, modules := ls.Get()
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(levelsPayload{Level: level, Modules: modules})
+}
+
+func writeLevelsError(w http.ResponseWriter, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": msg})
+}
+
+func parseLevel(level string) (zapcore.Level, error) {
+	var l zapcore.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return l, fmt.Errorf("invalid log level %q", level)
+	}
+	return l, nil
+}
@@ -0,0 +1,85 @@
+package zap
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogger_ModuleLevels(t *testing.T) {
+	l, buf := newTestLogger(t, &Config{Encoding: EncodingJSON, Level: "info", ModuleLevels: map[string]string{"data": "debug"}})
+	log.NewHelper(log.With(l, ModuleKey, "data")).Debug("sql")
+	log.NewHelper(log.With(l, ModuleKey, "account")).Debug("account")
+	log.NewHelper(l).Debug("default")
+	assert.Contains(t, buf.String(), `"msg":"sql"`)
+	assert.NotContains(t, buf.String(), `"msg":"account"`)
+	assert.NotContains(t, buf.String(), `"msg":"default"`)
+
+	buf.Reset()
+	assert.NoError(t, l.Levels().SetLevel("error"))
+	assert.NoError(t, l.Levels().SetModuleLevels(nil))
+	log.NewHelper(log.With(l, ModuleKey, "data")).Info("sql")
+	log.NewHelper(l).Warn("default")
+	assert.Empty(t, buf.String())
+}
+
+func TestLevels_ServeHTTP(t *testing.T) {
+	ls := newLevels(zapcore.InfoLevel)
+	tests := []struct {
+		name     string
+		method   string
+		body     string
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "should get levels",
+			method:   http.MethodGet,
+			wantCode: http.StatusOK,
+			wantBody: `{"level":"info","modules":{}}`,
+		},
+		{
+			name:     "should set default and module levels",
+			method:   http.MethodPut,
+			body:     `{"level":"warn","modules":{"data":"debug"}}`,
+			wantCode: http.StatusOK,
+			wantBody: `{"level":"warn","modules":{"data":"debug"}}`,
+		},
+		{
+			name:     "should keep module levels if modules is omitted",
+			method:   http.MethodPut,
+			body:     `{"level":"info"}`,
+			wantCode: http.StatusOK,
+			wantBody: `{"level":"info","modules":{"data":"debug"}}`,
+		},
+		{
+			name:     "should reject invalid level without changing anything",
+			method:   http.MethodPut,
+			body:     `{"level":"verbose","modules":{}}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "should reject other methods",
+			method:   http.MethodPost,
+			wantCode: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ls.ServeHTTP(w, httptest.NewRequest(tt.method, "/admin/log/levels", strings.NewReader(tt.body)))
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+	level, modules := ls.Get()
+	assert.Equal(t, "info", level)
+	assert.Equal(t, map[string]string{"data": "debug"}, modules)
+}
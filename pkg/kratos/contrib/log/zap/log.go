@@ -1,42 +1,88 @@
-// zap 实现 kratos 的 log.Logger 接口，支持日志轮转、syslog、dev 模式
+// zap 实现 kratos 的 log.Logger 接口，支持 stdout、stderr、轮转的日志文件、syslog 和 io.Writer 多个输出，以及 console 和 json 两种格式
 package zap
 
 import (
 	"fmt"
-	"log/syslog"
+	"io"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/metrics"
 	"go.uber.org/zap"
 	"go.uber.org/zap/buffer"
 	"go.uber.org/zap/zapcore"
-	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 var _ log.Logger = (*Logger)(nil)
 
 const timeLayout = "2006-01-02 15:04:05.999999"
 
+const (
+	EncodingConsole = "console"
+	EncodingJSON    = "json"
+)
+
+// Logger 可以通过 Reload 在运行时替换配置，级别对象 Levels 保持不变
 type Logger struct {
-	file  *lumberjack.Logger
-	sugar *zap.SugaredLogger
+	levels *Levels
+	state  atomic.Value // *state
+}
+
+// state 由配置创建，Reload 时整体替换
+type state struct {
+	closers []io.Closer
+	log     *zap.Logger
+	redact  *redactor
+	limiter *limiter
+	dropped metrics.Counter
 }
 
 type Config struct {
-	Dev               bool          // 是否为开发模式，开发模式将日志输出到 stdout 中
-	LogFilePath       string        // 日志文件存储路径
-	LogFileMaxSize    int           // 单个日志文件最大大小，单位为 MB, 默认: 10MB
-	LogFileMaxBackups int           // 日志轮转支持的最多归档数量，默认为：10 个
-	Prefix            string        // 日志前缀
-	AddStacktrace     bool          // 是否打印 stacktrace
-	StacktraceLevel   zapcore.Level // stacktrace 级别
+	Dev               bool              // 是否为开发模式，没有配置 Outputs 时开发模式将日志输出到 stdout 中，否则输出到日志文件和 syslog
+	LogFilePath       string            // 日志文件存储路径，也是 file 输出的默认路径
+	LogFileMaxSize    int               // 单个日志文件最大大小，单位为 MB, 默认: 10MB
+	LogFileMaxBackups int               // 日志轮转支持的最多归档数量，默认为：1 个
+	LogFileMaxAge     int               // 归档保留的天数，为空时不按时间删除
+	LogFileCompress   bool              // 是否使用 gzip 压缩归档
+	LogFileLocalTime  bool              // 归档文件名使用本地时间，默认为 UTC
+	Outputs           []Output          // 日志输出，为空时按 Dev 使用默认输出
+	Prefix            string            // 日志前缀
+	AddStacktrace     bool              // 是否打印 stacktrace
+	StacktraceLevel   zapcore.Level     // stacktrace 级别
+	Encoding          string            // 日志格式：console、json，默认 console
+	Level             string            // 默认级别：debug、info、warn、error，为空时开发模式为 debug，否则为 info
+	ModuleLevels      map[string]string // 按模块设置的级别，模块名为日志中 module 字段的值
+	RedactKeys        []string          // 值需要隐藏的字段名，不区分大小写的子串匹配，为空时使用 DefaultRedactKeys
+	KeepEmails        bool              // 不隐藏消息和字段值中的邮箱地址
+	Sampling          *Sampling         // 采样，为空时不采样
+	RateLimit         *RateLimit        // 按消息限流，为空时不限流
+	Dropped           metrics.Counter   // 采样和限流丢弃的日志数，标签为丢弃原因和级别
 }
 
 func NewLogger(conf *Config) (*Logger, error) {
 	if conf == nil {
 		conf = &Config{}
 	}
+	setDefaults(conf)
+	l := &Logger{levels: newLevels(zapConfig(conf).Level.Level())}
+	if err := l.build(conf); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Reload 按新的配置重新创建输出并替换，配置错误时返回错误并保持原来的配置。
+// Level 为空时保持当前的默认级别，模块级别替换为 ModuleLevels
+func (l *Logger) Reload(conf *Config) error {
+	setDefaults(conf)
+	return l.build(conf)
+}
+
+func setDefaults(conf *Config) {
 	if conf.LogFilePath == "" {
 		conf.LogFilePath = "./default.log"
 	}
@@ -46,91 +92,211 @@ func NewLogger(conf *Config) (*Logger, error) {
 	if conf.LogFileMaxBackups <= 0 {
 		conf.LogFileMaxBackups = 1
 	}
-	l := &Logger{}
-	if err := l.init(conf); err != nil {
-		return nil, err
+	if conf.Encoding == "" {
+		conf.Encoding = EncodingConsole
 	}
-	return l, nil
 }
 
+func zapConfig(conf *Config) zap.Config {
+	if conf.Dev {
+		return zap.NewDevelopmentConfig()
+	}
+	return zap.NewProductionConfig()
+}
+
+func (l *Logger) load() *state {
+	return l.state.Load().(*state)
+}
+
+// Log 中 msg 对应的值作为日志消息，其他 keyvals 作为结构化字段输出
 func (l *Logger) Log(level log.Level, keyvals ...interface{}) error {
+	s := l.load()
 	if len(keyvals) == 0 || len(keyvals)%2 != 0 {
-		l.sugar.Warn(fmt.Sprint("Keyvalues must appear in pairs: ", keyvals))
+		s.log.Warn(s.redact.mask(fmt.Sprint("Keyvalues must appear in pairs: ", keyvals)))
+		return nil
+	}
+	// 先按级别、限流和采样过滤，通过后再隐藏敏感数据并构建字段，被过滤的日志不产生这部分开销
+	var msg, module string
+	for i := 0; i < len(keyvals); i += 2 {
+		switch keyvals[i] {
+		case log.DefaultMessageKey:
+			msg = fmt.Sprint(keyvals[i+1])
+		case ModuleKey:
+			module = fmt.Sprint(keyvals[i+1])
+		}
+	}
+	zl := zapLevel(level)
+	if !l.levels.Enabled(module, zl) {
+		return nil
+	}
+	// 限流和采样按调用位置区分，Infof 等格式化后的消息各不相同，同一处调用视为同一个消息模板
+	site := callSite()
+	if s.limiter != nil && !s.limiter.allow(zl, site) {
+		s.drop(DropReasonRateLimit, zl)
+		return nil
+	}
+	ce := s.log.Check(zl, siteKey(site))
+	if ce == nil {
 		return nil
 	}
+	ce.Entry.Message = s.redact.mask(msg)
+	fields := make([]zap.Field, 0, len(keyvals)/2)
+	for i := 0; i < len(keyvals); i += 2 {
+		key, ok := keyvals[i].(string)
+		if !ok {
+			key = fmt.Sprint(keyvals[i])
+		}
+		if key == log.DefaultMessageKey {
+			continue
+		}
+		fields = append(fields, zap.Any(key, s.redact.value(key, keyvals[i+1])))
+	}
+	ce.Write(fields...)
+	return nil
+}
+
+// callSiteSkip 跳过 runtime.Callers、Log 和 kratos 的 logger、Helper，与输出中的 caller 相同
+const callSiteSkip = 4
+
+func callSite() uintptr {
+	var pcs [1]uintptr
+	if runtime.Callers(callSiteSkip, pcs[:]) == 0 {
+		return 0
+	}
+	return pcs[0]
+}
+
+// siteKey 作为采样器使用的消息，写入前替换为实际的消息
+func siteKey(site uintptr) string {
+	return strconv.FormatUint(uint64(site), 16)
+}
+
+func zapLevel(level log.Level) zapcore.Level {
 	switch level {
 	case log.LevelDebug:
-		l.sugar.Debug(keyvals...)
-	case log.LevelInfo:
-		l.sugar.Info(keyvals...)
+		return zapcore.DebugLevel
 	case log.LevelWarn:
-		l.sugar.Warn(keyvals...)
+		return zapcore.WarnLevel
 	case log.LevelError:
-		l.sugar.Error(keyvals...)
+		return zapcore.ErrorLevel
 	case log.LevelFatal:
-		l.sugar.Fatal(keyvals...)
+		return zapcore.FatalLevel
 	}
-	return nil
+	return zapcore.InfoLevel
 }
 
-func (l *Logger) init(conf *Config) error {
-	var (
-		zapConf zap.Config
-		wss     []zapcore.WriteSyncer
-	)
-	if conf.Dev {
-		zapConf = zap.NewDevelopmentConfig()
-		ws, _, err := zap.Open(zapConf.OutputPaths...)
-		if err != nil {
+// build 创建新的 state，成功后再修改级别并替换，避免只应用了一部分配置
+func (l *Logger) build(conf *Config) error {
+	if conf.Level != "" {
+		if _, err := parseLevel(conf.Level); err != nil {
 			return err
 		}
-		wss = append(wss, ws)
-	} else {
-		zapConf = zap.NewProductionConfig()
-		l.file = &lumberjack.Logger{
-			Filename:   conf.LogFilePath,
-			MaxSize:    conf.LogFileMaxSize,
-			MaxBackups: conf.LogFileMaxBackups,
-		}
-		ws := zapcore.AddSync(l.file)
-		wss = append(wss, ws)
-		syslogWriter, err := syslog.New(syslog.LOG_ERR, "")
-		if err == nil {
-			wss = append(wss, zapcore.AddSync(syslogWriter))
+	}
+	for m, level := range conf.ModuleLevels {
+		if _, err := parseLevel(level); err != nil {
+			return fmt.Errorf("module %s: %w", m, err)
 		}
 	}
-	zapConf.EncoderConfig.EncodeTime = func(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
-		enc.AppendString(t.Format(timeLayout))
+	if conf.Sampling != nil && conf.Sampling.Initial <= 0 {
+		return fmt.Errorf("log sampling initial must be positive")
 	}
-	zapConf.EncoderConfig.EncodeLevel = func(level zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
-		var sb strings.Builder
-		if conf.Prefix != "" {
-			sb.WriteString(fmt.Sprintf("[%s] ", conf.Prefix))
-		}
-		sb.WriteString(fmt.Sprintf("[%s]", level.CapitalString()))
-		enc.AppendString(sb.String())
+	if conf.RateLimit != nil && conf.RateLimit.Burst <= 0 {
+		return fmt.Errorf("log rate limit burst must be positive")
 	}
-	zapConf.EncoderConfig.EncodeCaller = func(caller zapcore.EntryCaller, enc zapcore.PrimitiveArrayEncoder) {
-		enc.AppendString(fmt.Sprintf("%s:", caller.TrimmedPath()))
+	zapConf := zapConfig(conf)
+	s := &state{redact: newRedactor(conf), dropped: conf.Dropped}
+	outputs := conf.Outputs
+	if len(outputs) == 0 {
+		outputs = defaultOutputs(conf)
 	}
-	enc := zapcore.NewConsoleEncoder(zapConf.EncoderConfig)
+	// 模块级别由 Log 判断，core 只按输出的级别过滤
 	var zapCore []zapcore.Core
-	for _, ws := range wss {
-		zapCore = append(zapCore, zapcore.NewCore(&encoderWrapper{Encoder: enc}, ws, zapConf.Level))
+	for i, o := range outputs {
+		core, closer, err := newCore(conf, zapConf.EncoderConfig, o)
+		if err != nil {
+			if o.bestEffort {
+				continue
+			}
+			s.close()
+			return fmt.Errorf("log output %d (%s): %w", i, o.Type, err)
+		}
+		if closer != nil {
+			s.closers = append(s.closers, closer)
+		}
+		zapCore = append(zapCore, core)
 	}
-	base := zap.New(zapcore.NewTee(zapCore...))
+	if conf.RateLimit != nil {
+		s.limiter = newLimiter(conf.RateLimit)
+	}
+	core := zapcore.NewTee(zapCore...)
+	if conf.Sampling != nil {
+		core = s.newSampler(core, conf.Sampling)
+	}
+	base := zap.New(core)
 	if conf.AddStacktrace {
 		base = base.WithOptions(zap.AddStacktrace(conf.StacktraceLevel))
 	}
-	base = base.WithOptions(zap.AddCaller(), zap.AddCallerSkip(2))
-	l.sugar = base.Sugar()
+	// 跳过 Log 和 kratos 的 logger、Helper，caller 为调用 Helper 的代码
+	base = base.WithOptions(zap.AddCaller(), zap.AddCallerSkip(3))
+	if conf.Prefix != "" {
+		base = base.Named(conf.Prefix)
+	}
+	s.log = base
+
+	_ = l.levels.SetModuleLevels(conf.ModuleLevels)
+	if conf.Level != "" {
+		_ = l.levels.SetLevel(conf.Level)
+	}
+	old, _ := l.state.Load().(*state)
+	l.state.Store(s)
+	if old != nil {
+		_ = old.log.Sync()
+		_ = old.close()
+	}
 	return nil
 }
 
+func newEncoder(conf *Config, encoding string, ec zapcore.EncoderConfig) (zapcore.Encoder, error) {
+	switch encoding {
+	case EncodingJSON:
+		ec.TimeKey = "ts"
+		ec.EncodeTime = zapcore.ISO8601TimeEncoder
+		ec.EncodeLevel = zapcore.LowercaseLevelEncoder
+		ec.EncodeCaller = zapcore.ShortCallerEncoder
+		ec.EncodeDuration = zapcore.StringDurationEncoder
+		return zapcore.NewJSONEncoder(ec), nil
+	case EncodingConsole:
+		// 前缀已在级别前输出
+		ec.NameKey = ""
+		ec.EncodeTime = func(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
+			enc.AppendString(t.Format(timeLayout))
+		}
+		ec.EncodeLevel = func(level zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
+			var sb strings.Builder
+			if conf.Prefix != "" {
+				sb.WriteString(fmt.Sprintf("[%s] ", conf.Prefix))
+			}
+			sb.WriteString(fmt.Sprintf("[%s]", level.CapitalString()))
+			enc.AppendString(sb.String())
+		}
+		ec.EncodeCaller = func(caller zapcore.EntryCaller, enc zapcore.PrimitiveArrayEncoder) {
+			enc.AppendString(fmt.Sprintf("%s:", caller.TrimmedPath()))
+		}
+		ec.EncodeDuration = zapcore.StringDurationEncoder
+		return &encoderWrapper{Encoder: zapcore.NewConsoleEncoder(ec)}, nil
+	}
+	return nil, fmt.Errorf("unknown log encoding %q", encoding)
+}
+
+// encoderWrapper 将 console 格式中的 tab 替换为空格
 type encoderWrapper struct {
 	zapcore.Encoder
 }
 
+func (ew *encoderWrapper) Clone() zapcore.Encoder {
+	return &encoderWrapper{Encoder: ew.Encoder.Clone()}
+}
+
 func (ew *encoderWrapper) EncodeEntry(entry zapcore.Entry, fields []zapcore.Field) (*buffer.Buffer, error) {
 	buf, err := ew.Encoder.EncodeEntry(entry, fields)
 	if err != nil {
@@ -143,10 +309,26 @@ func (ew *encoderWrapper) EncodeEntry(entry zapcore.Entry, fields []zapcore.Fiel
 	return buf, nil
 }
 
+// Levels 返回日志级别，用于在运行时修改
+func (l *Logger) Levels() *Levels {
+	return l.levels
+}
+
 func (l *Logger) Sync() error {
-	err := l.sugar.Sync()
-	if l.file != nil {
-		err = l.file.Close()
+	s := l.load()
+	err := s.log.Sync()
+	if cerr := s.close(); cerr != nil {
+		err = cerr
+	}
+	return err
+}
+
+func (s *state) close() error {
+	var err error
+	for _, c := range s.closers {
+		if cerr := c.Close(); cerr != nil {
+			err = cerr
+		}
 	}
 	return err
 }
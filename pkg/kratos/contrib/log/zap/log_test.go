@@ -0,0 +1,83 @@
+package zap
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type traceKey struct{}
+
+func newTestLogger(t *testing.T, conf *Config) (*Logger, *bytes.Buffer) {
+	var buf bytes.Buffer
+	conf.Outputs = []Output{{Type: OutputWriter, Writer: &buf}}
+	l, err := NewLogger(conf)
+	require.NoError(t, err)
+	return l, &buf
+}
+
+func TestLogger_JSON(t *testing.T) {
+	l, buf := newTestLogger(t, &Config{Encoding: EncodingJSON, Prefix: "USM"})
+	valuer := func(ctx context.Context) interface{} { return ctx.Value(traceKey{}) }
+	ctx := context.WithValue(context.Background(), traceKey{}, "abc")
+	h := log.NewHelper(log.WithContext(ctx, log.With(l, "trace_id", log.Valuer(valuer))))
+	h.Infow("msg", "create user", "user_id", 1, "tags", []string{"a", "b"})
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "info", entry["level"])
+	assert.Equal(t, "USM", entry["logger"])
+	assert.Equal(t, "create user", entry["msg"])
+	assert.Equal(t, "abc", entry["trace_id"])
+	assert.Equal(t, float64(1), entry["user_id"])
+	assert.Equal(t, []interface{}{"a", "b"}, entry["tags"])
+	assert.Contains(t, entry["caller"], "log_test.go")
+}
+
+func TestLogger_Console(t *testing.T) {
+	l, buf := newTestLogger(t, &Config{Encoding: EncodingConsole, Prefix: "USM"})
+	log.NewHelper(l).Warnf("disk %s", "full")
+	line := buf.String()
+	assert.NotContains(t, line, "\t")
+	assert.Contains(t, line, "[USM] [WARN]")
+	assert.True(t, strings.HasSuffix(line, "disk full\n"), line)
+}
+
+func TestLogger_UnknownEncoding(t *testing.T) {
+	_, err := NewLogger(&Config{Dev: true, Encoding: "xml"})
+	assert.Error(t, err)
+}
+
+func TestLogger_Reload(t *testing.T) {
+	l, first := newTestLogger(t, &Config{Encoding: EncodingJSON, Level: "warn"})
+	levels := l.Levels()
+	h := log.NewHelper(l)
+
+	var second bytes.Buffer
+	require.NoError(t, l.Reload(&Config{
+		Encoding:     EncodingConsole,
+		ModuleLevels: map[string]string{"data": "debug"},
+		Outputs:      []Output{{Type: OutputWriter, Writer: &second}},
+	}))
+	assert.Same(t, levels, l.Levels())
+	h.Info("ignored")
+	log.NewHelper(log.With(l, ModuleKey, "data")).Debug("query")
+	assert.Empty(t, first.String())
+	assert.Contains(t, second.String(), "[DEBUG]")
+	assert.NotContains(t, second.String(), "ignored")
+
+	// 配置错误时保持原来的输出和级别
+	assert.Error(t, l.Reload(&Config{Level: "info", Outputs: []Output{{Type: "kafka"}}}))
+	assert.Error(t, l.Reload(&Config{Level: "verbose", Outputs: []Output{{Type: OutputStdout}}}))
+	h.Warn("still here")
+	assert.Contains(t, second.String(), "still here")
+	level, modules := levels.Get()
+	assert.Equal(t, "warn", level)
+	assert.Equal(t, map[string]string{"data": "debug"}, modules)
+}
@@ -0,0 +1,183 @@
+package zap
+
+import (
+	"fmt"
+	"io"
+	"log/syslog"
+	"os"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	OutputStdout = "stdout"
+	OutputStderr = "stderr"
+	OutputFile   = "file"
+	OutputSyslog = "syslog"
+	OutputWriter = "writer"
+)
+
+// Output 日志输出，每个输出可以单独设置级别和格式
+type Output struct {
+	Type     string // 输出类型：stdout、stderr、file、syslog、writer
+	Level    string // 输出的最低级别，为空时输出所有通过模块级别过滤的日志
+	Encoding string // 日志格式：console、json，为空时使用 Config.Encoding
+
+	File   FileOutput
+	Syslog SyslogOutput
+	Writer io.Writer // Type 为 writer 时日志写入 Writer，用于在代码中接入其他日志系统
+
+	bestEffort bool // 打开失败时忽略该输出
+}
+
+// FileOutput 按大小轮转的日志文件
+type FileOutput struct {
+	Path       string // 文件路径，为空时使用 Config.LogFilePath
+	MaxSize    int    // 单个文件最大大小，单位为 MB，为空时使用 Config.LogFileMaxSize
+	MaxBackups int    // 保留的归档数量，为空时使用 Config.LogFileMaxBackups
+	MaxAge     int    // 归档保留的天数，为空时使用 Config.LogFileMaxAge
+	Compress   bool   // 是否使用 gzip 压缩归档，Config.LogFileCompress 为 true 时总是压缩
+	LocalTime  bool   // 归档文件名使用本地时间，Config.LogFileLocalTime 为 true 时总是使用本地时间
+}
+
+// SyslogOutput 系统日志，日志级别对应 syslog 的严重程度
+type SyslogOutput struct {
+	Network  string // 网络类型：udp、tcp、unix 等，为空时连接本机 syslog
+	Address  string // syslog 地址，例如 localhost:514
+	Facility string // 设施：kern、user、daemon、auth、local0 ~ local7 等，默认 user
+	Tag      string // 标签，为空时使用程序名
+}
+
+var syslogFacilities = map[string]syslog.Priority{
+	"kern": syslog.LOG_KERN, "user": syslog.LOG_USER, "mail": syslog.LOG_MAIL, "daemon": syslog.LOG_DAEMON,
+	"auth": syslog.LOG_AUTH, "syslog": syslog.LOG_SYSLOG, "lpr": syslog.LOG_LPR, "news": syslog.LOG_NEWS,
+	"uucp": syslog.LOG_UUCP, "cron": syslog.LOG_CRON, "authpriv": syslog.LOG_AUTHPRIV, "ftp": syslog.LOG_FTP,
+	"local0": syslog.LOG_LOCAL0, "local1": syslog.LOG_LOCAL1, "local2": syslog.LOG_LOCAL2, "local3": syslog.LOG_LOCAL3,
+	"local4": syslog.LOG_LOCAL4, "local5": syslog.LOG_LOCAL5, "local6": syslog.LOG_LOCAL6, "local7": syslog.LOG_LOCAL7,
+}
+
+// defaultOutputs 没有配置 Outputs 时的输出：开发模式为 stdout，否则为日志文件和本机 syslog
+func defaultOutputs(conf *Config) []Output {
+	if conf.Dev {
+		return []Output{{Type: OutputStdout}}
+	}
+	return []Output{
+		{Type: OutputFile},
+		{Type: OutputSyslog, Level: "error", bestEffort: true},
+	}
+}
+
+// newCore 打开输出，返回的 io.Closer 在 Sync 时关闭
+func newCore(conf *Config, ec zapcore.EncoderConfig, o Output) (zapcore.Core, io.Closer, error) {
+	level := zapcore.DebugLevel
+	if o.Level != "" {
+		var err error
+		if level, err = parseLevel(o.Level); err != nil {
+			return nil, nil, err
+		}
+	}
+	encoding := o.Encoding
+	if encoding == "" {
+		encoding = conf.Encoding
+	}
+	enc, err := newEncoder(conf, encoding, ec)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch o.Type {
+	case OutputStdout:
+		return zapcore.NewCore(enc, zapcore.Lock(os.Stdout), level), nil, nil
+	case OutputStderr:
+		return zapcore.NewCore(enc, zapcore.Lock(os.Stderr), level), nil, nil
+	case OutputWriter:
+		if o.Writer == nil {
+			return nil, nil, fmt.Errorf("writer is required")
+		}
+		return zapcore.NewCore(enc, zapcore.AddSync(o.Writer), level), nil, nil
+	case OutputFile:
+		f := &lumberjack.Logger{
+			Filename:   o.File.Path,
+			MaxSize:    o.File.MaxSize,
+			MaxBackups: o.File.MaxBackups,
+			MaxAge:     o.File.MaxAge,
+			Compress:   o.File.Compress || conf.LogFileCompress,
+			LocalTime:  o.File.LocalTime || conf.LogFileLocalTime,
+		}
+		if f.Filename == "" {
+			f.Filename = conf.LogFilePath
+		}
+		if f.MaxSize <= 0 {
+			f.MaxSize = conf.LogFileMaxSize
+		}
+		if f.MaxBackups <= 0 {
+			f.MaxBackups = conf.LogFileMaxBackups
+		}
+		if f.MaxAge <= 0 {
+			f.MaxAge = conf.LogFileMaxAge
+		}
+		return zapcore.NewCore(enc, zapcore.AddSync(f), level), f, nil
+	case OutputSyslog:
+		facility := syslog.LOG_USER
+		if o.Syslog.Facility != "" {
+			p, ok := syslogFacilities[strings.ToLower(o.Syslog.Facility)]
+			if !ok {
+				return nil, nil, fmt.Errorf("unknown syslog facility %q", o.Syslog.Facility)
+			}
+			facility = p
+		}
+		w, err := syslog.Dial(o.Syslog.Network, o.Syslog.Address, facility|syslog.LOG_INFO, o.Syslog.Tag)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &syslogCore{LevelEnabler: level, enc: enc, w: w}, w, nil
+	}
+	return nil, nil, fmt.Errorf("unknown output type %q", o.Type)
+}
+
+// syslogCore 按日志级别写入对应严重程度的 syslog
+type syslogCore struct {
+	zapcore.LevelEnabler
+	enc zapcore.Encoder
+	w   *syslog.Writer
+}
+
+func (c *syslogCore) With(fields []zapcore.Field) zapcore.Core {
+	enc := c.enc.Clone()
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+	return &syslogCore{LevelEnabler: c.LevelEnabler, enc: enc, w: c.w}
+}
+
+func (c *syslogCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return ce.AddCore(entry, c)
+	}
+	return ce
+}
+
+func (c *syslogCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	buf, err := c.enc.EncodeEntry(entry, fields)
+	if err != nil {
+		return err
+	}
+	msg := strings.TrimSuffix(buf.String(), "\n")
+	buf.Free()
+	switch entry.Level {
+	case zapcore.DebugLevel:
+		return c.w.Debug(msg)
+	case zapcore.InfoLevel:
+		return c.w.Info(msg)
+	case zapcore.WarnLevel:
+		return c.w.Warning(msg)
+	case zapcore.ErrorLevel:
+		return c.w.Err(msg)
+	}
+	return c.w.Crit(msg)
+}
+
+func (c *syslogCore) Sync() error {
+	return nil
+}
@@ -0,0 +1,131 @@
+package zap
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+func TestLogger_Outputs(t *testing.T) {
+	var console, errs bytes.Buffer
+	path := filepath.Join(t.TempDir(), "usm.log")
+	l, err := NewLogger(&Config{
+		Prefix: "USM",
+		Outputs: []Output{
+			{Type: OutputWriter, Writer: &console},
+			{Type: OutputWriter, Writer: &errs, Level: "error", Encoding: EncodingJSON},
+			{Type: OutputFile, Encoding: EncodingJSON, File: FileOutput{Path: path, MaxAge: 7, Compress: true}},
+		},
+	})
+	require.NoError(t, err)
+	h := log.NewHelper(l)
+	h.Info("started")
+	h.Errorw("msg", "failed", "user_id", 1)
+	require.NoError(t, l.Sync())
+
+	assert.Contains(t, console.String(), "[USM] [INFO]")
+	assert.Contains(t, console.String(), "[USM] [ERROR]")
+
+	lines := strings.Split(strings.TrimSpace(errs.String()), "\n")
+	require.Len(t, lines, 1)
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.Equal(t, "failed", entry["msg"])
+	assert.Equal(t, "USM", entry["logger"])
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(b), "\n"))
+}
+
+func TestLogger_SyslogOutput(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+	l, err := NewLogger(&Config{
+		Encoding: EncodingJSON,
+		Outputs: []Output{{
+			Type:   OutputSyslog,
+			Level:  "warn",
+			Syslog: SyslogOutput{Network: "udp", Address: conn.LocalAddr().String(), Facility: "local0", Tag: "usm"},
+		}},
+	})
+	require.NoError(t, err)
+	defer l.Sync()
+	h := log.NewHelper(l)
+	h.Info("ignored")
+	h.Error("failed")
+
+	buf := make([]byte, 1024)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(3*time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	msg := string(buf[:n])
+	// local0(16)*8 + err(3)
+	assert.True(t, strings.HasPrefix(msg, "<131>"), msg)
+	assert.Contains(t, msg, "usm")
+	assert.Contains(t, msg, `"msg":"failed"`)
+}
+
+func TestLogger_InvalidOutput(t *testing.T) {
+	tests := []struct {
+		name   string
+		output Output
+	}{
+		{name: "unknown type", output: Output{Type: "kafka"}},
+		{name: "unknown level", output: Output{Type: OutputStdout, Level: "verbose"}},
+		{name: "unknown encoding", output: Output{Type: OutputStdout, Encoding: "xml"}},
+		{name: "missing writer", output: Output{Type: OutputWriter}},
+		{name: "unknown facility", output: Output{Type: OutputSyslog, Syslog: SyslogOutput{Facility: "local9"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewLogger(&Config{Outputs: []Output{tt.output}})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewCore_FileDefaults(t *testing.T) {
+	conf := &Config{LogFilePath: filepath.Join(t.TempDir(), "usm.log"), LogFileMaxAge: 7, LogFileCompress: true, LogFileLocalTime: true}
+	setDefaults(conf)
+	tests := []struct {
+		name string
+		file FileOutput
+		want FileOutput
+	}{
+		{
+			name: "defaults from config",
+			want: FileOutput{Path: conf.LogFilePath, MaxSize: 10, MaxBackups: 1, MaxAge: 7, Compress: true, LocalTime: true},
+		},
+		{
+			name: "output overrides",
+			file: FileOutput{Path: "other.log", MaxSize: 5, MaxBackups: 3, MaxAge: 30},
+			want: FileOutput{Path: "other.log", MaxSize: 5, MaxBackups: 3, MaxAge: 30, Compress: true, LocalTime: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, closer, err := newCore(conf, zapConfig(conf).EncoderConfig, Output{Type: OutputFile, File: tt.file})
+			require.NoError(t, err)
+			defer closer.Close()
+			f := closer.(*lumberjack.Logger)
+			assert.Equal(t, tt.want.Path, f.Filename)
+			assert.Equal(t, tt.want.MaxSize, f.MaxSize)
+			assert.Equal(t, tt.want.MaxBackups, f.MaxBackups)
+			assert.Equal(t, tt.want.MaxAge, f.MaxAge)
+			assert.Equal(t, tt.want.Compress, f.Compress)
+			assert.Equal(t, tt.want.LocalTime, f.LocalTime)
+		})
+	}
+}
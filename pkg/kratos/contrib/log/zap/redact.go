@@ -0,0 +1,100 @@
+package zap
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultRedactKeys 默认隐藏值的字段名，不区分大小写的子串匹配
+var DefaultRedactKeys = []string{"password", "passwd", "secret", "token", "authorization", "private_key", "credential", "hash"}
+
+const redacted = "***"
+
+var (
+	emailPattern = regexp.MustCompile(`([A-Za-z0-9._%+-])[A-Za-z0-9._%+-]*@([A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,})`)
+	// 按顺序替换：认证头、API Key（usm_<prefix>_<secret>，保留 prefix 便于排查）、JWT
+	tokenPatterns = []struct {
+		re   *regexp.Regexp
+		repl string
+	}{
+		{regexp.MustCompile(`(?i)\b(bearer|basic)\s+[A-Za-z0-9._~+/=-]+`), "$1 " + redacted},
+		{regexp.MustCompile(`\busm_([0-9a-f]+)_[0-9a-f]+`), "usm_${1}_" + redacted},
+		{regexp.MustCompile(`\beyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`), "eyJ" + redacted},
+	}
+)
+
+// redactor 隐藏日志中的敏感信息：字段名匹配 keys 的值整体隐藏，消息和字符串值中的令牌和邮箱部分隐藏
+type redactor struct {
+	keys       []string
+	keepEmails bool
+	// 字符串中的 key=value 或 key: value，key 匹配 keys 时隐藏 value
+	pairs *regexp.Regexp
+}
+
+func newRedactor(conf *Config) *redactor {
+	keys := conf.RedactKeys
+	if len(keys) == 0 {
+		keys = DefaultRedactKeys
+	}
+	r := &redactor{keepEmails: conf.KeepEmails}
+	quoted := make([]string, 0, len(keys))
+	for _, k := range keys {
+		r.keys = append(r.keys, strings.ToLower(k))
+		quoted = append(quoted, regexp.QuoteMeta(k))
+	}
+	r.pairs = regexp.MustCompile(`(?i)([\w.-]*(?:` + strings.Join(quoted, "|") + `)[\w.-]*"?\s*[=:]\s*)("[^"]*"|[^\s,;&]+)`)
+	return r
+}
+
+func (r *redactor) sensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, k := range r.keys {
+		if strings.Contains(key, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// value 返回字段值脱敏后的结果，字符串、error 和 fmt.Stringer 转换为字符串后处理，其他类型原样返回
+func (r *redactor) value(key string, v interface{}) interface{} {
+	if v == nil {
+		return v
+	}
+	if r.sensitiveKey(key) {
+		return redacted
+	}
+	switch s := v.(type) {
+	case time.Duration, time.Time:
+		return v
+	case string:
+		return r.mask(s)
+	case []byte:
+		return r.mask(string(s))
+	case []string:
+		res := make([]string, len(s))
+		for i := range s {
+			res[i] = r.mask(s[i])
+		}
+		return res
+	case error:
+		return r.mask(s.Error())
+	case fmt.Stringer:
+		return r.mask(s.String())
+	}
+	return v
+}
+
+// mask 隐藏字符串中敏感 key 的值、令牌和邮箱，邮箱保留首字母和域名，例如 a***@example.com
+func (r *redactor) mask(s string) string {
+	s = r.pairs.ReplaceAllString(s, "${1}"+redacted)
+	for _, p := range tokenPatterns {
+		s = p.re.ReplaceAllString(s, p.repl)
+	}
+	if !r.keepEmails {
+		s = emailPattern.ReplaceAllString(s, "${1}"+redacted+"@${2}")
+	}
+	return s
+}
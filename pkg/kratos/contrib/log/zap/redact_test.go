@@ -0,0 +1,128 @@
+package zap
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogger_Redact(t *testing.T) {
+	tests := []struct {
+		name    string
+		conf    *Config
+		keyvals []interface{}
+		want    map[string]interface{}
+	}{
+		{
+			name:    "should redact sensitive keys",
+			conf:    &Config{},
+			keyvals: []interface{}{"msg", "login", "password", "p@ss", "access_token", "abc", "Authorization", "Basic YWxpY2U6cA==", "user_id", 1},
+			want:    map[string]interface{}{"msg": "login", "password": "***", "access_token": "***", "Authorization": "***", "user_id": float64(1)},
+		},
+		{
+			name:    "should mask message",
+			conf:    &Config{},
+			keyvals: []interface{}{"msg", "connect failed: password=xyz host=db, secret: \"a b\""},
+			want:    map[string]interface{}{"msg": "connect failed: password=*** host=db, secret: ***"},
+		},
+		{
+			name:    "should mask tokens in values",
+			conf:    &Config{},
+			keyvals: []interface{}{"msg", "request", "header", "Bearer eyJhbGciOi.eyJzdWIi.sig", "key", "usm_ab12_0123abcd", "error", errors.New("invalid jwt eyJhbGciOi.eyJzdWIi.sig")},
+			want:    map[string]interface{}{"msg": "request", "header": "Bearer ***", "key": "usm_ab12_***", "error": "invalid jwt eyJ***"},
+		},
+		{
+			name:    "should mask emails",
+			conf:    &Config{},
+			keyvals: []interface{}{"msg", "invite alice@example.com", "emails", []string{"bob@example.org"}},
+			want:    map[string]interface{}{"msg": "invite a***@example.com", "emails": []interface{}{"b***@example.org"}},
+		},
+		{
+			name:    "should keep emails",
+			conf:    &Config{KeepEmails: true},
+			keyvals: []interface{}{"msg", "invite alice@example.com"},
+			want:    map[string]interface{}{"msg": "invite alice@example.com"},
+		},
+		{
+			name:    "should use custom keys",
+			conf:    &Config{RedactKeys: []string{"ssn"}},
+			keyvals: []interface{}{"msg", "m", "user_ssn", "123", "password", "p"},
+			want:    map[string]interface{}{"msg": "m", "user_ssn": "***", "password": "p"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.conf.Encoding = EncodingJSON
+			l, buf := newTestLogger(t, tt.conf)
+			require.NoError(t, l.Log(log.LevelInfo, tt.keyvals...))
+
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+			for k, v := range tt.want {
+				assert.Equal(t, v, entry[k], k)
+			}
+		})
+	}
+}
+
+// countingStringer 记录 String 的调用次数，用于确认被过滤的日志没有执行隐藏
+type countingStringer struct {
+	calls int
+}
+
+func (s *countingStringer) String() string {
+	s.calls++
+	return "value"
+}
+
+func TestLogger_RedactAfterFilter(t *testing.T) {
+	tests := []struct {
+		name string
+		conf *Config
+		log  func(h *log.Helper, v *countingStringer)
+		want int
+	}{
+		{
+			name: "level",
+			conf: &Config{Level: "info"},
+			log: func(h *log.Helper, v *countingStringer) {
+				h.Debugw("msg", "filtered", "v", v)
+				h.Infow("msg", "written", "v", v)
+			},
+			want: 1,
+		},
+		{
+			name: "rate limit",
+			conf: &Config{RateLimit: &RateLimit{Interval: time.Minute, Burst: 1}},
+			log: func(h *log.Helper, v *countingStringer) {
+				for i := 0; i < 2; i++ {
+					h.Infow("msg", "repeated", "v", v)
+				}
+			},
+			want: 1,
+		},
+		{
+			name: "sampling",
+			conf: &Config{Sampling: &Sampling{Tick: time.Minute, Initial: 1}},
+			log: func(h *log.Helper, v *countingStringer) {
+				for i := 0; i < 2; i++ {
+					h.Infow("msg", "repeated", "v", v)
+				}
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, _ := newTestLogger(t, tt.conf)
+			v := &countingStringer{}
+			tt.log(log.NewHelper(l), v)
+			assert.Equal(t, tt.want, v.calls)
+		})
+	}
+}
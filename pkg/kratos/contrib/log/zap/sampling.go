@@ -0,0 +1,90 @@
+package zap
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	DropReasonSampling  = "sampling"
+	DropReasonRateLimit = "rate_limit"
+)
+
+// Sampling 采样，每个 Tick 内相同级别和消息模板（即调用位置）的日志先输出 Initial 条，之后每 Thereafter 条输出一条
+type Sampling struct {
+	Tick       time.Duration // 采样周期，默认 1s
+	Initial    int
+	Thereafter int // 为 0 时超过 Initial 的日志全部丢弃
+}
+
+// RateLimit 按消息模板限流，每个 Interval 内相同级别和调用位置的日志最多输出 Burst 条
+type RateLimit struct {
+	Interval time.Duration // 限流周期，默认 1s
+	Burst    int
+}
+
+func (s *state) newSampler(core zapcore.Core, conf *Sampling) zapcore.Core {
+	tick := conf.Tick
+	if tick <= 0 {
+		tick = time.Second
+	}
+	return zapcore.NewSamplerWithOptions(core, tick, conf.Initial, conf.Thereafter,
+		zapcore.SamplerHook(func(entry zapcore.Entry, dec zapcore.SamplingDecision) {
+			if dec&zapcore.LogDropped != 0 {
+				s.drop(DropReasonSampling, entry.Level)
+			}
+		}))
+}
+
+func (s *state) drop(reason string, level zapcore.Level) {
+	if s.dropped != nil {
+		s.dropped.With(reason, level.String()).Inc()
+	}
+}
+
+type limiterKey struct {
+	level zapcore.Level
+	site  uintptr
+}
+
+// limiter 固定窗口计数，每个窗口开始时清空计数，内存占用不超过一个窗口内不同调用位置的数量
+type limiter struct {
+	interval time.Duration
+	burst    int
+
+	mu     sync.Mutex
+	start  time.Time
+	counts map[limiterKey]int
+	now    func() time.Time
+}
+
+func newLimiter(r *RateLimit) *limiter {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	return &limiter{
+		interval: interval,
+		burst:    r.Burst,
+		counts:   make(map[limiterKey]int),
+		now:      time.Now,
+	}
+}
+
+func (lm *limiter) allow(level zapcore.Level, site uintptr) bool {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	now := lm.now()
+	if now.Sub(lm.start) >= lm.interval {
+		lm.start = now
+		lm.counts = make(map[limiterKey]int, len(lm.counts))
+	}
+	k := limiterKey{level: level, site: site}
+	if lm.counts[k] >= lm.burst {
+		return false
+	}
+	lm.counts[k]++
+	return true
+}
@@ -0,0 +1,112 @@
+package zap
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCounter 按标签记录计数
+type testCounter struct {
+	counts map[string]float64
+	lvs    []string
+}
+
+func (c *testCounter) With(lvs ...string) metrics.Counter {
+	return &testCounter{counts: c.counts, lvs: lvs}
+}
+
+func (c *testCounter) Inc() {
+	c.Add(1)
+}
+
+func (c *testCounter) Add(delta float64) {
+	c.counts[strings.Join(c.lvs, ",")] += delta
+}
+
+func TestLogger_Sampling(t *testing.T) {
+	dropped := &testCounter{counts: make(map[string]float64)}
+	l, buf := newTestLogger(t, &Config{
+		Encoding: EncodingJSON,
+		Sampling: &Sampling{Tick: time.Minute, Initial: 2, Thereafter: 3},
+		Dropped:  dropped,
+	})
+	h := log.NewHelper(l)
+	for i := 0; i < 8; i++ {
+		h.Warn("authentication failed")
+	}
+	h.Info("other")
+
+	// 第 1、2 条和之后每 3 条中的 1 条：1、2、5、8
+	assert.Equal(t, 4, strings.Count(buf.String(), "authentication failed"))
+	assert.Contains(t, buf.String(), "other")
+	assert.Equal(t, map[string]float64{"sampling,warn": 4}, dropped.counts)
+}
+
+func TestLogger_RateLimit(t *testing.T) {
+	dropped := &testCounter{counts: make(map[string]float64)}
+	l, buf := newTestLogger(t, &Config{
+		Encoding:  EncodingJSON,
+		RateLimit: &RateLimit{Interval: time.Minute, Burst: 2},
+		Dropped:   dropped,
+	})
+	now := time.Now()
+	l.load().limiter.now = func() time.Time { return now }
+	h := log.NewHelper(l)
+	for i := 0; i < 5; i++ {
+		h.Warn("authentication failed")
+		h.Error("authentication failed")
+	}
+	h.Info("other")
+	assert.Equal(t, 4, strings.Count(buf.String(), "authentication failed"))
+	assert.Contains(t, buf.String(), "other")
+	assert.Equal(t, map[string]float64{"rate_limit,warn": 3, "rate_limit,error": 3}, dropped.counts)
+
+	// 下一个周期重新计数
+	now = now.Add(time.Minute)
+	h.Warn("authentication failed")
+	assert.Equal(t, 5, strings.Count(buf.String(), "authentication failed"))
+}
+
+func TestLogger_InvalidSampling(t *testing.T) {
+	tests := []struct {
+		name string
+		conf *Config
+	}{
+		{name: "sampling without initial", conf: &Config{Sampling: &Sampling{Thereafter: 10}}},
+		{name: "rate limit without burst", conf: &Config{RateLimit: &RateLimit{Interval: time.Second}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.conf.Outputs = []Output{{Type: OutputStdout}}
+			_, err := NewLogger(tt.conf)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestLogger_RateLimitFormatted(t *testing.T) {
+	dropped := &testCounter{counts: make(map[string]float64)}
+	l, buf := newTestLogger(t, &Config{
+		Encoding:  EncodingJSON,
+		RateLimit: &RateLimit{Interval: time.Minute, Burst: 2},
+		Sampling:  &Sampling{Tick: time.Minute, Initial: 2},
+		Dropped:   dropped,
+	})
+	h := log.NewHelper(l)
+	// 撞库时每次的用户名不同，格式化后的消息各不相同，仍然按同一个模板限流
+	for i := 0; i < 100; i++ {
+		h.Infof("user %s authenticate", fmt.Sprintf("user%d", i))
+		h.Infow("msg", "authenticate", "username", fmt.Sprintf("user%d", i))
+	}
+	assert.Equal(t, 2, strings.Count(buf.String(), `"msg":"user `))
+	assert.Contains(t, buf.String(), `"msg":"user user0 authenticate"`)
+	assert.Equal(t, 2, strings.Count(buf.String(), `"msg":"authenticate"`))
+	assert.Equal(t, map[string]float64{"rate_limit,info": 196}, dropped.counts)
+}
@@ -0,0 +1,76 @@
+// prometheus 实现 kratos 的 metrics 接口，With 传入的标签值按创建向量时的标签名顺序对应
+package prometheus
+
+import (
+	"github.com/go-kratos/kratos/v2/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	_ metrics.Counter  = (*counter)(nil)
+	_ metrics.Gauge    = (*gauge)(nil)
+	_ metrics.Observer = (*histogram)(nil)
+)
+
+type counter struct {
+	cv  *prometheus.CounterVec
+	lvs []string
+}
+
+func NewCounter(cv *prometheus.CounterVec) metrics.Counter {
+	return &counter{cv: cv}
+}
+
+func (c *counter) With(lvs ...string) metrics.Counter {
+	return &counter{cv: c.cv, lvs: lvs}
+}
+
+func (c *counter) Inc() {
+	c.cv.WithLabelValues(c.lvs...).Inc()
+}
+
+func (c *counter) Add(delta float64) {
+	c.cv.WithLabelValues(c.lvs...).Add(delta)
+}
+
+type gauge struct {
+	gv  *prometheus.GaugeVec
+	lvs []string
+}
+
+func NewGauge(gv *prometheus.GaugeVec) metrics.Gauge {
+	return &gauge{gv: gv}
+}
+
+func (g *gauge) With(lvs ...string) metrics.Gauge {
+	return &gauge{gv: g.gv, lvs: lvs}
+}
+
+func (g *gauge) Set(value float64) {
+	g.gv.WithLabelValues(g.lvs...).Set(value)
+}
+
+func (g *gauge) Add(delta float64) {
+	g.gv.WithLabelValues(g.lvs...).Add(delta)
+}
+
+func (g *gauge) Sub(delta float64) {
+	g.gv.WithLabelValues(g.lvs...).Sub(delta)
+}
+
+type histogram struct {
+	hv  *prometheus.HistogramVec
+	lvs []string
+}
+
+func NewHistogram(hv *prometheus.HistogramVec) metrics.Observer {
+	return &histogram{hv: hv}
+}
+
+func (h *histogram) With(lvs ...string) metrics.Observer {
+	return &histogram{hv: h.hv, lvs: lvs}
+}
+
+func (h *histogram) Observe(value float64) {
+	h.hv.WithLabelValues(h.lvs...).Observe(value)
+}
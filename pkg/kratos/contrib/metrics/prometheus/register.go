@@ -0,0 +1,44 @@
+package prometheus
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Register 注册 c，已注册过相同的指标时返回已注册的收集器，同一个 Registry 上多次创建时共用同一组指标
+func Register(reg prometheus.Registerer, c prometheus.Collector) (prometheus.Collector, error) {
+	if err := reg.Register(c); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			return are.ExistingCollector, nil
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func RegisterCounterVec(reg prometheus.Registerer, opts prometheus.CounterOpts, labels ...string) (*prometheus.CounterVec, error) {
+	c, err := Register(reg, prometheus.NewCounterVec(opts, labels))
+	if err != nil {
+		return nil, err
+	}
+	cv, ok := c.(*prometheus.CounterVec)
+	if !ok {
+		return nil, fmt.Errorf("metric %s is already registered as %T", prometheus.BuildFQName(opts.Namespace, opts.Subsystem, opts.Name), c)
+	}
+	return cv, nil
+}
+
+func RegisterHistogramVec(reg prometheus.Registerer, opts prometheus.HistogramOpts, labels ...string) (*prometheus.HistogramVec, error) {
+	c, err := Register(reg, prometheus.NewHistogramVec(opts, labels))
+	if err != nil {
+		return nil, err
+	}
+	hv, ok := c.(*prometheus.HistogramVec)
+	if !ok {
+		return nil, fmt.Errorf("metric %s is already registered as %T", prometheus.BuildFQName(opts.Namespace, opts.Subsystem, opts.Name), c)
+	}
+	return hv, nil
+}
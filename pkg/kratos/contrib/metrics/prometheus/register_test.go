@@ -0,0 +1,29 @@
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	opts := prometheus.CounterOpts{Namespace: "usm", Name: "test_total", Help: "test"}
+	first, err := RegisterCounterVec(reg, opts, "kind")
+	require.NoError(t, err)
+	second, err := RegisterCounterVec(reg, opts, "kind")
+	require.NoError(t, err)
+	assert.Same(t, first, second, "already registered collector must be reused")
+
+	second.WithLabelValues("a").Inc()
+	assert.Equal(t, float64(1), testutil.ToFloat64(first.WithLabelValues("a")))
+
+	// 同名不同标签的指标和已注册的不一致
+	_, err = RegisterCounterVec(reg, opts, "other")
+	assert.Error(t, err)
+	_, err = RegisterHistogramVec(reg, prometheus.HistogramOpts{Namespace: "usm", Name: "test_total", Help: "test"}, "kind")
+	assert.Error(t, err)
+}
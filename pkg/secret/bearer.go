@@ -0,0 +1,24 @@
+package secret
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+// BearerAuthorized 检查请求的 Authorization 头是否携带 tokens 中的一个 Bearer Token，
+// 与每个 token 都进行常量时间比较，不因提前匹配泄露耗时
+func BearerAuthorized(r *http.Request, tokens []string) bool {
+	auth := r.Header.Get("Authorization")
+	if len(auth) <= 7 || !strings.EqualFold(auth[:7], "bearer ") {
+		return false
+	}
+	token := []byte(strings.TrimSpace(auth[7:]))
+	ok := false
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare(token, []byte(t)) == 1 {
+			ok = true
+		}
+	}
+	return ok
+}
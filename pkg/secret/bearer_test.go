@@ -0,0 +1,35 @@
+package secret
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBearerAuthorized(t *testing.T) {
+	tests := []struct {
+		name   string
+		auth   string
+		tokens []string
+		want   bool
+	}{
+		{name: "match", auth: "Bearer t2", tokens: []string{"t1", "t2"}, want: true},
+		{name: "case insensitive scheme", auth: "bearer  t1 ", tokens: []string{"t1"}, want: true},
+		{name: "wrong token", auth: "Bearer t3", tokens: []string{"t1", "t2"}},
+		{name: "basic", auth: "Basic dDE6", tokens: []string{"t1"}},
+		{name: "empty token", auth: "Bearer ", tokens: []string{""}},
+		{name: "missing", tokens: []string{"t1"}},
+		{name: "no tokens", auth: "Bearer t1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+			assert.Equal(t, tt.want, BearerAuthorized(r, tt.tokens))
+		})
+	}
+}
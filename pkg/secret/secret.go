@@ -0,0 +1,119 @@
+package secret
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Secret 从文件或环境变量读取的密钥。从文件读取时监听文件所在的目录，
+// 文件被替换后重新读取，包括 Kubernetes 更新 secret 时替换 ..data 链接的情况
+type Secret struct {
+	log   *log.Helper
+	file  string
+	value atomic.Value
+
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+// New 按 file、env 的顺序读取密钥，都为空时使用 value。
+// 文件不存在或环境变量未设置时返回错误，不会退回到 value
+func New(file, env, value string, logger log.Logger) (*Secret, error) {
+	s := &Secret{log: log.NewHelper(logger), file: file}
+	switch {
+	case file != "":
+		v, err := readFile(file)
+		if err != nil {
+			return nil, err
+		}
+		s.value.Store(v)
+		if err := s.watch(); err != nil {
+			return nil, fmt.Errorf("watch secret file %s: %v", file, err)
+		}
+	case env != "":
+		v, ok := os.LookupEnv(env)
+		if !ok {
+			return nil, fmt.Errorf("secret env %s is not set", env)
+		}
+		s.value.Store(v)
+	default:
+		s.value.Store(value)
+	}
+	return s, nil
+}
+
+func (s *Secret) Value() string {
+	return s.value.Load().(string)
+}
+
+// Close 停止监听文件
+func (s *Secret) Close() error {
+	if s == nil || s.watcher == nil {
+		return nil
+	}
+	err := s.watcher.Close()
+	<-s.done
+	return err
+}
+
+func (s *Secret) watch() error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := w.Add(filepath.Dir(s.file)); err != nil {
+		w.Close()
+		return err
+	}
+	s.watcher = w
+	s.done = make(chan struct{})
+	go s.run()
+	return nil
+}
+
+func (s *Secret) run() {
+	defer close(s.done)
+	for {
+		select {
+		case _, ok := <-s.watcher.Events:
+			if !ok {
+				return
+			}
+			s.reload()
+		case err, ok := <-s.watcher.Errors:
+			if !ok {
+				return
+			}
+			s.log.Errorf("watch secret file %s: %v", s.file, err)
+		}
+	}
+}
+
+func (s *Secret) reload() {
+	v, err := readFile(s.file)
+	if err != nil {
+		// 替换文件的过程中文件可能短暂不存在，保留原来的值
+		s.log.Warnf("reload secret file: %v", err)
+		return
+	}
+	if v == s.Value() {
+		return
+	}
+	s.value.Store(v)
+	s.log.Infof("secret file %s reloaded", s.file)
+}
+
+func readFile(path string) (string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read secret file: %v", err)
+	}
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
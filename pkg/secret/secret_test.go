@@ -0,0 +1,74 @@
+package secret
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(path, []byte("from-file\n"), 0o600))
+	t.Setenv("TEST_SECRET", "from-env")
+
+	tests := []struct {
+		name    string
+		file    string
+		env     string
+		want    string
+		wantErr bool
+	}{
+		{name: "file", file: path, env: "TEST_SECRET", want: "from-file"},
+		{name: "env", env: "TEST_SECRET", want: "from-env"},
+		{name: "value", want: "plain"},
+		{name: "missing file", file: filepath.Join(dir, "missing"), wantErr: true},
+		{name: "missing env", env: "TEST_SECRET_MISSING", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(tt.file, tt.env, "plain", log.DefaultLogger)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer s.Close()
+			assert.Equal(t, tt.want, s.Value())
+		})
+	}
+}
+
+func TestSecret_Reload(t *testing.T) {
+	// 模拟 Kubernetes 挂载的 secret：文件通过 ..data 链接指向带时间戳的目录，更新时替换链接
+	dir := t.TempDir()
+	writeVersion := func(name, value string) {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, name), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name, "password"), []byte(value), 0o600))
+		tmp := filepath.Join(dir, "..data_tmp")
+		require.NoError(t, os.Symlink(name, tmp))
+		require.NoError(t, os.Rename(tmp, filepath.Join(dir, "..data")))
+	}
+	writeVersion("..v1", "old")
+	path := filepath.Join(dir, "password")
+	require.NoError(t, os.Symlink(filepath.Join("..data", "password"), path))
+
+	s, err := New(path, "", "", log.DefaultLogger)
+	require.NoError(t, err)
+	defer s.Close()
+	assert.Equal(t, "old", s.Value())
+
+	writeVersion("..v2", "new")
+	assert.Eventually(t, func() bool { return s.Value() == "new" }, 3*time.Second, 20*time.Millisecond)
+
+	// 文件被删除时保留原来的值
+	require.NoError(t, os.Remove(path))
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, "new", s.Value())
+	assert.NoError(t, s.Close())
+}